@@ -1,12 +1,15 @@
 package aws
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type AWSExternalID interface {
 	Get() (*api.AWSExternalID, error)
+	GetContext(ctx context.Context) (*api.AWSExternalID, error)
 }
 
 type awsexternalid struct {
@@ -28,3 +31,13 @@ func (c *awsexternalid) Get() (*api.AWSExternalID, error) {
 		Parse(externalID)
 	return externalID, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *awsexternalid) withContext(ctx context.Context) *awsexternalid {
+	return &awsexternalid{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *awsexternalid) GetContext(ctx context.Context) (*api.AWSExternalID, error) {
+	return c.withContext(ctx).Get()
+}
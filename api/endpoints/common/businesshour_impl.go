@@ -1,16 +1,23 @@
 package common
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type BusinessHourService interface {
 	Get(businessHourID string) (*api.BusinessHour, error)
+	GetContext(ctx context.Context, businessHourID string) (*api.BusinessHour, error)
 	Create(businessHour *api.BusinessHour) (*api.BusinessHour, error)
+	CreateContext(ctx context.Context, businessHour *api.BusinessHour) (*api.BusinessHour, error)
 	Update(businessHour *api.BusinessHour) (*api.BusinessHour, error)
+	UpdateContext(ctx context.Context, businessHour *api.BusinessHour) (*api.BusinessHour, error)
 	Delete(businessHourID string) error
+	DeleteContext(ctx context.Context, businessHourID string) error
 	List() ([]*api.BusinessHour, error)
+	ListContext(ctx context.Context) ([]*api.BusinessHour, error)
 }
 
 type BusinessHour struct {
@@ -84,3 +91,33 @@ func (b *BusinessHour) List() ([]*api.BusinessHour, error) {
 
 	return businessHourList, err
 }
+
+// withContext returns a copy of b whose requests are bound to ctx.
+func (b *BusinessHour) withContext(ctx context.Context) *BusinessHour {
+	return &BusinessHour{client: b.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (b *BusinessHour) GetContext(ctx context.Context, businessHourID string) (*api.BusinessHour, error) {
+	return b.withContext(ctx).Get(businessHourID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (b *BusinessHour) CreateContext(ctx context.Context, businessHour *api.BusinessHour) (*api.BusinessHour, error) {
+	return b.withContext(ctx).Create(businessHour)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (b *BusinessHour) UpdateContext(ctx context.Context, businessHour *api.BusinessHour) (*api.BusinessHour, error) {
+	return b.withContext(ctx).Update(businessHour)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (b *BusinessHour) DeleteContext(ctx context.Context, businessHourID string) error {
+	return b.withContext(ctx).Delete(businessHourID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (b *BusinessHour) ListContext(ctx context.Context) ([]*api.BusinessHour, error) {
+	return b.withContext(ctx).List()
+}
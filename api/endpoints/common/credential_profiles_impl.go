@@ -1,16 +1,23 @@
 package common
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type CredentialProfile interface {
 	Get(credentialProfileID string) (*api.CredentialProfile, error)
+	GetContext(ctx context.Context, credentialProfileID string) (*api.CredentialProfile, error)
 	Create(credentialProfile *api.CredentialProfile) (*api.CredentialProfile, error)
+	CreateContext(ctx context.Context, credentialProfile *api.CredentialProfile) (*api.CredentialProfile, error)
 	Update(credentialProfile *api.CredentialProfile) (*api.CredentialProfile, error)
+	UpdateContext(ctx context.Context, credentialProfile *api.CredentialProfile) (*api.CredentialProfile, error)
 	Delete(credentialProfileID string) error
+	DeleteContext(ctx context.Context, credentialProfileID string) error
 	ListWebCredentials() ([]*api.CredentialProfile, error)
+	ListWebCredentialsContext(ctx context.Context) ([]*api.CredentialProfile, error)
 }
 
 type credentialprofile struct {
@@ -81,3 +88,33 @@ func (c *credentialprofile) ListWebCredentials() ([]*api.CredentialProfile, erro
 
 	return credentialProfiles, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *credentialprofile) withContext(ctx context.Context) *credentialprofile {
+	return &credentialprofile{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *credentialprofile) GetContext(ctx context.Context, credentialProfileID string) (*api.CredentialProfile, error) {
+	return c.withContext(ctx).Get(credentialProfileID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *credentialprofile) CreateContext(ctx context.Context, credentialProfile *api.CredentialProfile) (*api.CredentialProfile, error) {
+	return c.withContext(ctx).Create(credentialProfile)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *credentialprofile) UpdateContext(ctx context.Context, credentialProfile *api.CredentialProfile) (*api.CredentialProfile, error) {
+	return c.withContext(ctx).Update(credentialProfile)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *credentialprofile) DeleteContext(ctx context.Context, credentialProfileID string) error {
+	return c.withContext(ctx).Delete(credentialProfileID)
+}
+
+// ListWebCredentialsContext is like ListWebCredentials but binds the request to ctx.
+func (c *credentialprofile) ListWebCredentialsContext(ctx context.Context) ([]*api.CredentialProfile, error) {
+	return c.withContext(ctx).ListWebCredentials()
+}
@@ -1,12 +1,15 @@
 package common
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type DeviceKey interface {
 	Get() (*api.DeviceKey, error)
+	GetContext(ctx context.Context) (*api.DeviceKey, error)
 }
 
 type devicekey struct {
@@ -28,3 +31,13 @@ func (c *devicekey) Get() (*api.DeviceKey, error) {
 		Parse(externalID)
 	return externalID, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *devicekey) withContext(ctx context.Context) *devicekey {
+	return &devicekey{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *devicekey) GetContext(ctx context.Context) (*api.DeviceKey, error) {
+	return c.withContext(ctx).Get()
+}
@@ -1,16 +1,23 @@
 package common
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type OAuth2Provider interface {
 	Get(providerID string) (*api.OAuth2Provider, error)
+	GetContext(ctx context.Context, providerID string) (*api.OAuth2Provider, error)
 	Create(provider *api.OAuth2Provider) (*api.OAuth2Provider, error)
+	CreateContext(ctx context.Context, provider *api.OAuth2Provider) (*api.OAuth2Provider, error)
 	Update(provider *api.OAuth2Provider) (*api.OAuth2Provider, error)
+	UpdateContext(ctx context.Context, provider *api.OAuth2Provider) (*api.OAuth2Provider, error)
 	Delete(providerID string) error
+	DeleteContext(ctx context.Context, providerID string) error
 	List() ([]*api.OAuth2Provider, error)
+	ListContext(ctx context.Context) ([]*api.OAuth2Provider, error)
 }
 
 type oauth2provider struct {
@@ -81,3 +88,33 @@ func (c *oauth2provider) List() ([]*api.OAuth2Provider, error) {
 
 	return providers, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *oauth2provider) withContext(ctx context.Context) *oauth2provider {
+	return &oauth2provider{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *oauth2provider) GetContext(ctx context.Context, providerID string) (*api.OAuth2Provider, error) {
+	return c.withContext(ctx).Get(providerID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *oauth2provider) CreateContext(ctx context.Context, provider *api.OAuth2Provider) (*api.OAuth2Provider, error) {
+	return c.withContext(ctx).Create(provider)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *oauth2provider) UpdateContext(ctx context.Context, provider *api.OAuth2Provider) (*api.OAuth2Provider, error) {
+	return c.withContext(ctx).Update(provider)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *oauth2provider) DeleteContext(ctx context.Context, providerID string) error {
+	return c.withContext(ctx).Delete(providerID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *oauth2provider) ListContext(ctx context.Context) ([]*api.OAuth2Provider, error) {
+	return c.withContext(ctx).List()
+}
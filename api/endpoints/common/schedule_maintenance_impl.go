@@ -1,16 +1,23 @@
 package common
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type ScheduleMaintenance interface {
 	Get(schedulemaintenanceID string) (*api.ScheduleMaintenance, error)
+	GetContext(ctx context.Context, schedulemaintenanceID string) (*api.ScheduleMaintenance, error)
 	Create(schedulemaintenance *api.ScheduleMaintenance) (*api.ScheduleMaintenance, error)
+	CreateContext(ctx context.Context, schedulemaintenance *api.ScheduleMaintenance) (*api.ScheduleMaintenance, error)
 	Update(schedulemaintenance *api.ScheduleMaintenance) (*api.ScheduleMaintenance, error)
+	UpdateContext(ctx context.Context, schedulemaintenance *api.ScheduleMaintenance) (*api.ScheduleMaintenance, error)
 	Delete(schedulemaintenanceID string) error
+	DeleteContext(ctx context.Context, schedulemaintenanceID string) error
 	List() ([]*api.ScheduleMaintenance, error)
+	ListContext(ctx context.Context) ([]*api.ScheduleMaintenance, error)
 }
 
 type schedulemaintenance struct {
@@ -81,3 +88,33 @@ func (c *schedulemaintenance) List() ([]*api.ScheduleMaintenance, error) {
 
 	return scheduleMaintenanceList, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *schedulemaintenance) withContext(ctx context.Context) *schedulemaintenance {
+	return &schedulemaintenance{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *schedulemaintenance) GetContext(ctx context.Context, schedulemaintenanceID string) (*api.ScheduleMaintenance, error) {
+	return c.withContext(ctx).Get(schedulemaintenanceID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *schedulemaintenance) CreateContext(ctx context.Context, schedulemaintenance *api.ScheduleMaintenance) (*api.ScheduleMaintenance, error) {
+	return c.withContext(ctx).Create(schedulemaintenance)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *schedulemaintenance) UpdateContext(ctx context.Context, schedulemaintenance *api.ScheduleMaintenance) (*api.ScheduleMaintenance, error) {
+	return c.withContext(ctx).Update(schedulemaintenance)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *schedulemaintenance) DeleteContext(ctx context.Context, schedulemaintenanceID string) error {
+	return c.withContext(ctx).Delete(schedulemaintenanceID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *schedulemaintenance) ListContext(ctx context.Context) ([]*api.ScheduleMaintenance, error) {
+	return c.withContext(ctx).List()
+}
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
@@ -8,11 +10,17 @@ import (
 // ScheduleReport defines operations for Site24x7 scheduled reports.
 type ScheduleReport interface {
 	Get(reportID string) (*api.ScheduleReport, error)
+	GetContext(ctx context.Context, reportID string) (*api.ScheduleReport, error)
 	Create(scheduleReport *api.ScheduleReport) (*api.ScheduleReport, error)
+	CreateContext(ctx context.Context, scheduleReport *api.ScheduleReport) (*api.ScheduleReport, error)
 	Update(scheduleReport *api.ScheduleReport) (*api.ScheduleReport, error)
+	UpdateContext(ctx context.Context, scheduleReport *api.ScheduleReport) (*api.ScheduleReport, error)
 	Delete(reportID string) error
+	DeleteContext(ctx context.Context, reportID string) error
 	UpdateRaw(reportID string, payload interface{}) (*api.ScheduleReport, error)
+	UpdateRawContext(ctx context.Context, reportID string, payload interface{}) (*api.ScheduleReport, error)
 	List() ([]*api.ScheduleReport, error)
+	ListContext(ctx context.Context) ([]*api.ScheduleReport, error)
 }
 
 type schedulereport struct {
@@ -97,3 +105,38 @@ func (c *schedulereport) List() ([]*api.ScheduleReport, error) {
 
 	return scheduleReportList, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *schedulereport) withContext(ctx context.Context) *schedulereport {
+	return &schedulereport{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *schedulereport) GetContext(ctx context.Context, reportID string) (*api.ScheduleReport, error) {
+	return c.withContext(ctx).Get(reportID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *schedulereport) CreateContext(ctx context.Context, scheduleReport *api.ScheduleReport) (*api.ScheduleReport, error) {
+	return c.withContext(ctx).Create(scheduleReport)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *schedulereport) UpdateContext(ctx context.Context, scheduleReport *api.ScheduleReport) (*api.ScheduleReport, error) {
+	return c.withContext(ctx).Update(scheduleReport)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *schedulereport) DeleteContext(ctx context.Context, reportID string) error {
+	return c.withContext(ctx).Delete(reportID)
+}
+
+// UpdateRawContext is like UpdateRaw but binds the request to ctx.
+func (c *schedulereport) UpdateRawContext(ctx context.Context, reportID string, payload interface{}) (*api.ScheduleReport, error) {
+	return c.withContext(ctx).UpdateRaw(reportID, payload)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *schedulereport) ListContext(ctx context.Context) ([]*api.ScheduleReport, error) {
+	return c.withContext(ctx).List()
+}
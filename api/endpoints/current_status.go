@@ -1,15 +1,21 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type CurrentStatus interface {
 	Get(monitorID string) (*api.MonitorStatus, error)
+	GetContext(ctx context.Context, monitorID string) (*api.MonitorStatus, error)
 	ListGroup(groupID string) (*api.MonitorsStatus, error)
+	ListGroupContext(ctx context.Context, groupID string) (*api.MonitorsStatus, error)
 	ListType(monitorType string) (*api.MonitorsStatus, error)
+	ListTypeContext(ctx context.Context, monitorType string) (*api.MonitorsStatus, error)
 	List(options *api.CurrentStatusListOptions) (*api.MonitorsStatus, error)
+	ListContext(ctx context.Context, options *api.CurrentStatusListOptions) (*api.MonitorsStatus, error)
 }
 
 type currentStatus struct {
@@ -69,3 +75,28 @@ func (c *currentStatus) List(options *api.CurrentStatusListOptions) (*api.Monito
 
 	return status, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *currentStatus) withContext(ctx context.Context) *currentStatus {
+	return &currentStatus{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *currentStatus) GetContext(ctx context.Context, monitorID string) (*api.MonitorStatus, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// ListGroupContext is like ListGroup but binds the request to ctx.
+func (c *currentStatus) ListGroupContext(ctx context.Context, groupID string) (*api.MonitorsStatus, error) {
+	return c.withContext(ctx).ListGroup(groupID)
+}
+
+// ListTypeContext is like ListType but binds the request to ctx.
+func (c *currentStatus) ListTypeContext(ctx context.Context, monitorType string) (*api.MonitorsStatus, error) {
+	return c.withContext(ctx).ListType(monitorType)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *currentStatus) ListContext(ctx context.Context, options *api.CurrentStatusListOptions) (*api.MonitorsStatus, error) {
+	return c.withContext(ctx).List(options)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *AmazonMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *AmazonMonitors) GetContext(ctx context.Context, monitorID string) (*api.AmazonMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *AmazonMonitors) CreateContext(ctx context.Context, monitor *api.AmazonMonitor) (*api.AmazonMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *AmazonMonitors) UpdateContext(ctx context.Context, monitor *api.AmazonMonitor) (*api.AmazonMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *AmazonMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *AmazonMonitors) ListContext(ctx context.Context) ([]*api.AmazonMonitor, error) {
+	return e.List()
+}
+
+func (e *AmazonMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *AmazonMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *AzureMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *AzureMonitors) GetContext(ctx context.Context, monitorID string) (*api.AzureMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *AzureMonitors) CreateContext(ctx context.Context, monitor *api.AzureMonitor) (*api.AzureMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *AzureMonitors) UpdateContext(ctx context.Context, monitor *api.AzureMonitor) (*api.AzureMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *AzureMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *AzureMonitors) ListContext(ctx context.Context) ([]*api.AzureMonitor, error) {
+	return e.List()
+}
+
+func (e *AzureMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *AzureMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/common"
 	"github.com/stretchr/testify/mock"
@@ -55,3 +57,23 @@ func (b *BusinessHour) List() ([]*api.BusinessHour, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (b *BusinessHour) GetContext(ctx context.Context, businessHourID string) (*api.BusinessHour, error) {
+	return b.Get(businessHourID)
+}
+
+func (b *BusinessHour) CreateContext(ctx context.Context, businessHour *api.BusinessHour) (*api.BusinessHour, error) {
+	return b.Create(businessHour)
+}
+
+func (b *BusinessHour) UpdateContext(ctx context.Context, businessHour *api.BusinessHour) (*api.BusinessHour, error) {
+	return b.Update(businessHour)
+}
+
+func (b *BusinessHour) DeleteContext(ctx context.Context, businessHourID string) error {
+	return b.Delete(businessHourID)
+}
+
+func (b *BusinessHour) ListContext(ctx context.Context) ([]*api.BusinessHour, error) {
+	return b.List()
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/integration"
 	"github.com/stretchr/testify/mock"
@@ -35,3 +37,15 @@ func (s *ConnectwiseIntegration) Update(connectwiseIntegration *api.ConnectwiseI
 	}
 	return nil, args.Error(1)
 }
+
+func (s *ConnectwiseIntegration) GetContext(ctx context.Context, connectwiseIntegrationID string) (*api.ConnectwiseIntegration, error) {
+	return s.Get(connectwiseIntegrationID)
+}
+
+func (s *ConnectwiseIntegration) CreateContext(ctx context.Context, connectwiseIntegration *api.ConnectwiseIntegration) (*api.ConnectwiseIntegration, error) {
+	return s.Create(connectwiseIntegration)
+}
+
+func (s *ConnectwiseIntegration) UpdateContext(ctx context.Context, connectwiseIntegration *api.ConnectwiseIntegration) (*api.ConnectwiseIntegration, error) {
+	return s.Update(connectwiseIntegration)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/common"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (c *CredentialProfile) ListWebCredentials() ([]*api.CredentialProfile, erro
 	}
 	return nil, args.Error(1)
 }
+
+func (e *CredentialProfile) GetContext(ctx context.Context, credentialProfileID string) (*api.CredentialProfile, error) {
+	return e.Get(credentialProfileID)
+}
+
+func (e *CredentialProfile) CreateContext(ctx context.Context, credentialProfile *api.CredentialProfile) (*api.CredentialProfile, error) {
+	return e.Create(credentialProfile)
+}
+
+func (e *CredentialProfile) UpdateContext(ctx context.Context, credentialProfile *api.CredentialProfile) (*api.CredentialProfile, error) {
+	return e.Update(credentialProfile)
+}
+
+func (e *CredentialProfile) DeleteContext(ctx context.Context, credentialProfileID string) error {
+	return e.Delete(credentialProfileID)
+}
+
+func (e *CredentialProfile) ListWebCredentialsContext(ctx context.Context) ([]*api.CredentialProfile, error) {
+	return e.ListWebCredentials()
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *CronMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *CronMonitors) GetContext(ctx context.Context, monitorID string) (*api.CronMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *CronMonitors) CreateContext(ctx context.Context, monitor *api.CronMonitor) (*api.CronMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *CronMonitors) UpdateContext(ctx context.Context, monitor *api.CronMonitor) (*api.CronMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *CronMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *CronMonitors) ListContext(ctx context.Context) ([]*api.CronMonitor, error) {
+	return e.List()
+}
+
+func (e *CronMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *CronMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
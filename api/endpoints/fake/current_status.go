@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -43,3 +45,19 @@ func (e *CurrentStatus) List(options *api.CurrentStatusListOptions) (*api.Monito
 	}
 	return nil, args.Error(1)
 }
+
+func (e *CurrentStatus) GetContext(ctx context.Context, monitorID string) (*api.MonitorStatus, error) {
+	return e.Get(monitorID)
+}
+
+func (e *CurrentStatus) ListGroupContext(ctx context.Context, groupID string) (*api.MonitorsStatus, error) {
+	return e.ListGroup(groupID)
+}
+
+func (e *CurrentStatus) ListTypeContext(ctx context.Context, monitorType string) (*api.MonitorsStatus, error) {
+	return e.ListType(monitorType)
+}
+
+func (e *CurrentStatus) ListContext(ctx context.Context, options *api.CurrentStatusListOptions) (*api.MonitorsStatus, error) {
+	return e.List(options)
+}
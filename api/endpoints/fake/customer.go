@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/msp"
 	"github.com/stretchr/testify/mock"
@@ -54,3 +56,23 @@ func (c *Customer) List() ([]*api.Customer, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (c *Customer) GetContext(ctx context.Context, customerID string) (*api.Customer, error) {
+	return c.Get(customerID)
+}
+
+func (c *Customer) CreateContext(ctx context.Context, customer *api.Customer) (*api.Customer, error) {
+	return c.Create(customer)
+}
+
+func (c *Customer) UpdateContext(ctx context.Context, customer *api.Customer) (*api.Customer, error) {
+	return c.Update(customer)
+}
+
+func (c *Customer) DeleteContext(ctx context.Context, customerID string) error {
+	return c.Delete(customerID)
+}
+
+func (c *Customer) ListContext(ctx context.Context) ([]*api.Customer, error) {
+	return c.List()
+}
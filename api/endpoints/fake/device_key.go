@@ -0,0 +1,27 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/common"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ common.DeviceKey = &DeviceKey{}
+
+type DeviceKey struct {
+	mock.Mock
+}
+
+func (e *DeviceKey) Get() (*api.DeviceKey, error) {
+	args := e.Called()
+	if obj, ok := args.Get(0).(*api.DeviceKey); ok {
+		return obj, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (e *DeviceKey) GetContext(ctx context.Context) (*api.DeviceKey, error) {
+	return e.Get()
+}
@@ -1,12 +1,14 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
 )
 
-var _ monitors.WebsiteMonitors = &WebsiteMonitors{}
+var _ monitors.DNSServerMonitors = &DNSServerMonitors{}
 
 type DNSServerMonitors struct {
 	mock.Mock
@@ -58,3 +60,31 @@ func (e *DNSServerMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *DNSServerMonitors) GetContext(ctx context.Context, monitorID string) (*api.DNSServerMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *DNSServerMonitors) CreateContext(ctx context.Context, monitor *api.DNSServerMonitor) (*api.DNSServerMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *DNSServerMonitors) UpdateContext(ctx context.Context, monitor *api.DNSServerMonitor) (*api.DNSServerMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *DNSServerMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *DNSServerMonitors) ListContext(ctx context.Context) ([]*api.DNSServerMonitor, error) {
+	return e.List()
+}
+
+func (e *DNSServerMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *DNSServerMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
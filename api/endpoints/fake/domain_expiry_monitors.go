@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -59,3 +61,31 @@ func (e *DomainExpiryMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *DomainExpiryMonitors) GetContext(ctx context.Context, monitorID string) (*api.DomainExpiryMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *DomainExpiryMonitors) CreateContext(ctx context.Context, monitor *api.DomainExpiryMonitor) (*api.DomainExpiryMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *DomainExpiryMonitors) UpdateContext(ctx context.Context, monitor *api.DomainExpiryMonitor) (*api.DomainExpiryMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *DomainExpiryMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *DomainExpiryMonitors) ListContext(ctx context.Context) ([]*api.DomainExpiryMonitor, error) {
+	return e.List()
+}
+
+func (e *DomainExpiryMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *DomainExpiryMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
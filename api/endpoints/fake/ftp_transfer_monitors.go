@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *FTPTransferMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *FTPTransferMonitors) GetContext(ctx context.Context, monitorID string) (*api.FTPTransferMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *FTPTransferMonitors) CreateContext(ctx context.Context, monitor *api.FTPTransferMonitor) (*api.FTPTransferMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *FTPTransferMonitors) UpdateContext(ctx context.Context, monitor *api.FTPTransferMonitor) (*api.FTPTransferMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *FTPTransferMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *FTPTransferMonitors) ListContext(ctx context.Context) ([]*api.FTPTransferMonitor, error) {
+	return e.List()
+}
+
+func (e *FTPTransferMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *FTPTransferMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *HeartbeatMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *HeartbeatMonitors) GetContext(ctx context.Context, monitorID string) (*api.HeartbeatMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *HeartbeatMonitors) CreateContext(ctx context.Context, monitor *api.HeartbeatMonitor) (*api.HeartbeatMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *HeartbeatMonitors) UpdateContext(ctx context.Context, monitor *api.HeartbeatMonitor) (*api.HeartbeatMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *HeartbeatMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *HeartbeatMonitors) ListContext(ctx context.Context) ([]*api.HeartbeatMonitor, error) {
+	return e.List()
+}
+
+func (e *HeartbeatMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *HeartbeatMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
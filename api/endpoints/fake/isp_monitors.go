@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *ISPMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *ISPMonitors) GetContext(ctx context.Context, monitorID string) (*api.ISPMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *ISPMonitors) CreateContext(ctx context.Context, monitor *api.ISPMonitor) (*api.ISPMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *ISPMonitors) UpdateContext(ctx context.Context, monitor *api.ISPMonitor) (*api.ISPMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *ISPMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *ISPMonitors) ListContext(ctx context.Context) ([]*api.ISPMonitor, error) {
+	return e.List()
+}
+
+func (e *ISPMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *ISPMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (e *URLActions) List() ([]*api.URLAction, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *URLActions) GetContext(ctx context.Context, actionID string) (*api.URLAction, error) {
+	return e.Get(actionID)
+}
+
+func (e *URLActions) CreateContext(ctx context.Context, automation *api.URLAction) (*api.URLAction, error) {
+	return e.Create(automation)
+}
+
+func (e *URLActions) UpdateContext(ctx context.Context, automation *api.URLAction) (*api.URLAction, error) {
+	return e.Update(automation)
+}
+
+func (e *URLActions) DeleteContext(ctx context.Context, actionID string) error {
+	return e.Delete(actionID)
+}
+
+func (e *URLActions) ListContext(ctx context.Context) ([]*api.URLAction, error) {
+	return e.List()
+}
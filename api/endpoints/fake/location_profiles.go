@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (e *LocationProfiles) List() ([]*api.LocationProfile, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *LocationProfiles) GetContext(ctx context.Context, profileID string) (*api.LocationProfile, error) {
+	return e.Get(profileID)
+}
+
+func (e *LocationProfiles) CreateContext(ctx context.Context, profile *api.LocationProfile) (*api.LocationProfile, error) {
+	return e.Create(profile)
+}
+
+func (e *LocationProfiles) UpdateContext(ctx context.Context, profile *api.LocationProfile) (*api.LocationProfile, error) {
+	return e.Update(profile)
+}
+
+func (e *LocationProfiles) DeleteContext(ctx context.Context, profileID string) error {
+	return e.Delete(profileID)
+}
+
+func (e *LocationProfiles) ListContext(ctx context.Context) ([]*api.LocationProfile, error) {
+	return e.List()
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -19,3 +21,7 @@ func (e *LocationTemplate) Get() (*api.LocationTemplate, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *LocationTemplate) GetContext(ctx context.Context) (*api.LocationTemplate, error) {
+	return e.Get()
+}
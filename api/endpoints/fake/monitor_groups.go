@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (e *MonitorGroups) List() ([]*api.MonitorGroup, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *MonitorGroups) GetContext(ctx context.Context, groupID string) (*api.MonitorGroup, error) {
+	return e.Get(groupID)
+}
+
+func (e *MonitorGroups) CreateContext(ctx context.Context, group *api.MonitorGroup) (*api.MonitorGroup, error) {
+	return e.Create(group)
+}
+
+func (e *MonitorGroups) UpdateContext(ctx context.Context, group *api.MonitorGroup) (*api.MonitorGroup, error) {
+	return e.Update(group)
+}
+
+func (e *MonitorGroups) DeleteContext(ctx context.Context, groupID string) error {
+	return e.Delete(groupID)
+}
+
+func (e *MonitorGroups) ListContext(ctx context.Context) ([]*api.MonitorGroup, error) {
+	return e.List()
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -19,3 +21,7 @@ func (e *MSP) List() ([]*api.MSPCustomer, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *MSP) ListContext(ctx context.Context) ([]*api.MSPCustomer, error) {
+	return e.List()
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (e *NotificationProfiles) List() ([]*api.NotificationProfile, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *NotificationProfiles) GetContext(ctx context.Context, profileID string) (*api.NotificationProfile, error) {
+	return e.Get(profileID)
+}
+
+func (e *NotificationProfiles) CreateContext(ctx context.Context, profile *api.NotificationProfile) (*api.NotificationProfile, error) {
+	return e.Create(profile)
+}
+
+func (e *NotificationProfiles) UpdateContext(ctx context.Context, profile *api.NotificationProfile) (*api.NotificationProfile, error) {
+	return e.Update(profile)
+}
+
+func (e *NotificationProfiles) DeleteContext(ctx context.Context, profileID string) error {
+	return e.Delete(profileID)
+}
+
+func (e *NotificationProfiles) ListContext(ctx context.Context) ([]*api.NotificationProfile, error) {
+	return e.List()
+}
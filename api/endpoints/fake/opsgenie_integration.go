@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/integration"
 	"github.com/stretchr/testify/mock"
@@ -35,3 +37,15 @@ func (o *OpsgenieIntegration) Update(opsgenieIntegration *api.OpsgenieIntegratio
 	}
 	return nil, args.Error(1)
 }
+
+func (o *OpsgenieIntegration) GetContext(ctx context.Context, opsgenieIntegrationID string) (*api.OpsgenieIntegration, error) {
+	return o.Get(opsgenieIntegrationID)
+}
+
+func (o *OpsgenieIntegration) CreateContext(ctx context.Context, opsgenieIntegration *api.OpsgenieIntegration) (*api.OpsgenieIntegration, error) {
+	return o.Create(opsgenieIntegration)
+}
+
+func (o *OpsgenieIntegration) UpdateContext(ctx context.Context, opsgenieIntegration *api.OpsgenieIntegration) (*api.OpsgenieIntegration, error) {
+	return o.Update(opsgenieIntegration)
+}
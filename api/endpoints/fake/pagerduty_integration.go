@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/integration"
 	"github.com/stretchr/testify/mock"
@@ -35,3 +37,15 @@ func (s *PagerDutyIntegration) Update(pagerDutyIntegration *api.PagerDutyIntegra
 	}
 	return nil, args.Error(1)
 }
+
+func (s *PagerDutyIntegration) GetContext(ctx context.Context, pagerDutyIntegrationID string) (*api.PagerDutyIntegration, error) {
+	return s.Get(pagerDutyIntegrationID)
+}
+
+func (s *PagerDutyIntegration) CreateContext(ctx context.Context, pagerDutyIntegration *api.PagerDutyIntegration) (*api.PagerDutyIntegration, error) {
+	return s.Create(pagerDutyIntegration)
+}
+
+func (s *PagerDutyIntegration) UpdateContext(ctx context.Context, pagerDutyIntegration *api.PagerDutyIntegration) (*api.PagerDutyIntegration, error) {
+	return s.Update(pagerDutyIntegration)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -59,3 +61,31 @@ func (e *PINGMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *PINGMonitors) GetContext(ctx context.Context, monitorID string) (*api.PINGMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *PINGMonitors) CreateContext(ctx context.Context, monitor *api.PINGMonitor) (*api.PINGMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *PINGMonitors) UpdateContext(ctx context.Context, monitor *api.PINGMonitor) (*api.PINGMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *PINGMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *PINGMonitors) ListContext(ctx context.Context) ([]*api.PINGMonitor, error) {
+	return e.List()
+}
+
+func (e *PINGMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *PINGMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -59,3 +61,31 @@ func (e *PortMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *PortMonitors) GetContext(ctx context.Context, monitorID string) (*api.PortMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *PortMonitors) CreateContext(ctx context.Context, monitor *api.PortMonitor) (*api.PortMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *PortMonitors) UpdateContext(ctx context.Context, monitor *api.PortMonitor) (*api.PortMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *PortMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *PortMonitors) ListContext(ctx context.Context) ([]*api.PortMonitor, error) {
+	return e.List()
+}
+
+func (e *PortMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *PortMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
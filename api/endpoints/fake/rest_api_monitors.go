@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *RestApiMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *RestApiMonitors) GetContext(ctx context.Context, monitorID string) (*api.RestApiMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *RestApiMonitors) CreateContext(ctx context.Context, monitor *api.RestApiMonitor) (*api.RestApiMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *RestApiMonitors) UpdateContext(ctx context.Context, monitor *api.RestApiMonitor) (*api.RestApiMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *RestApiMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *RestApiMonitors) ListContext(ctx context.Context) ([]*api.RestApiMonitor, error) {
+	return e.List()
+}
+
+func (e *RestApiMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *RestApiMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -66,3 +68,35 @@ func (e *RestApiTransactionMonitor) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *RestApiTransactionMonitor) GetContext(ctx context.Context, monitorID string) (*api.RestApiTransactionMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *RestApiTransactionMonitor) GetStepsContext(ctx context.Context, monitorID string) (*[]api.Steps, error) {
+	return e.GetSteps(monitorID)
+}
+
+func (e *RestApiTransactionMonitor) CreateContext(ctx context.Context, monitor *api.RestApiTransactionMonitor) (*api.RestApiTransactionMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *RestApiTransactionMonitor) UpdateContext(ctx context.Context, monitor *api.RestApiTransactionMonitor) (*api.RestApiTransactionMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *RestApiTransactionMonitor) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *RestApiTransactionMonitor) ListContext(ctx context.Context) ([]*api.RestApiTransactionMonitor, error) {
+	return e.List()
+}
+
+func (e *RestApiTransactionMonitor) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *RestApiTransactionMonitor) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/common"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (e *ScheduleMaintenance) List() ([]*api.ScheduleMaintenance, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (w *ScheduleMaintenance) GetContext(ctx context.Context, schedulemaintenanceID string) (*api.ScheduleMaintenance, error) {
+	return w.Get(schedulemaintenanceID)
+}
+
+func (w *ScheduleMaintenance) CreateContext(ctx context.Context, schedulemaintenance *api.ScheduleMaintenance) (*api.ScheduleMaintenance, error) {
+	return w.Create(schedulemaintenance)
+}
+
+func (w *ScheduleMaintenance) UpdateContext(ctx context.Context, schedulemaintenance *api.ScheduleMaintenance) (*api.ScheduleMaintenance, error) {
+	return w.Update(schedulemaintenance)
+}
+
+func (w *ScheduleMaintenance) DeleteContext(ctx context.Context, schedulemaintenanceID string) error {
+	return w.Delete(schedulemaintenanceID)
+}
+
+func (w *ScheduleMaintenance) ListContext(ctx context.Context) ([]*api.ScheduleMaintenance, error) {
+	return w.List()
+}
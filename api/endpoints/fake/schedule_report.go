@@ -1,10 +1,15 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/common"
 	"github.com/stretchr/testify/mock"
 )
 
+var _ common.ScheduleReport = &ScheduleReport{}
+
 type ScheduleReport struct {
 	mock.Mock
 }
@@ -55,3 +60,27 @@ func (f *ScheduleReport) List() ([]*api.ScheduleReport, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (f *ScheduleReport) GetContext(ctx context.Context, reportID string) (*api.ScheduleReport, error) {
+	return f.Get(reportID)
+}
+
+func (f *ScheduleReport) CreateContext(ctx context.Context, scheduleReport *api.ScheduleReport) (*api.ScheduleReport, error) {
+	return f.Create(scheduleReport)
+}
+
+func (f *ScheduleReport) UpdateContext(ctx context.Context, scheduleReport *api.ScheduleReport) (*api.ScheduleReport, error) {
+	return f.Update(scheduleReport)
+}
+
+func (f *ScheduleReport) DeleteContext(ctx context.Context, reportID string) error {
+	return f.Delete(reportID)
+}
+
+func (f *ScheduleReport) UpdateRawContext(ctx context.Context, reportID string, payload interface{}) (*api.ScheduleReport, error) {
+	return f.UpdateRaw(reportID, payload)
+}
+
+func (f *ScheduleReport) ListContext(ctx context.Context) ([]*api.ScheduleReport, error) {
+	return f.List()
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *ServerMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *ServerMonitors) GetContext(ctx context.Context, monitorID string) (*api.ServerMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *ServerMonitors) CreateContext(ctx context.Context, monitor *api.ServerMonitor) (*api.ServerMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *ServerMonitors) UpdateContext(ctx context.Context, monitor *api.ServerMonitor) (*api.ServerMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *ServerMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *ServerMonitors) ListContext(ctx context.Context) ([]*api.ServerMonitor, error) {
+	return e.List()
+}
+
+func (e *ServerMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *ServerMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
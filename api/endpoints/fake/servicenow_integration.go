@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/integration"
 	"github.com/stretchr/testify/mock"
@@ -35,3 +37,15 @@ func (s *ServiceNowIntegration) Update(serviceNowIntegration *api.ServiceNowInte
 	}
 	return nil, args.Error(1)
 }
+
+func (s *ServiceNowIntegration) GetContext(ctx context.Context, serviceNowIntegrationID string) (*api.ServiceNowIntegration, error) {
+	return s.Get(serviceNowIntegrationID)
+}
+
+func (s *ServiceNowIntegration) CreateContext(ctx context.Context, serviceNowIntegration *api.ServiceNowIntegration) (*api.ServiceNowIntegration, error) {
+	return s.Create(serviceNowIntegration)
+}
+
+func (s *ServiceNowIntegration) UpdateContext(ctx context.Context, serviceNowIntegration *api.ServiceNowIntegration) (*api.ServiceNowIntegration, error) {
+	return s.Update(serviceNowIntegration)
+}
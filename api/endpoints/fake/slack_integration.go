@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/integration"
 	"github.com/stretchr/testify/mock"
@@ -35,3 +37,15 @@ func (s *SlackIntegration) Update(slackIntegration *api.SlackIntegration) (*api.
 	}
 	return nil, args.Error(1)
 }
+
+func (s *SlackIntegration) GetContext(ctx context.Context, slackIntegrationID string) (*api.SlackIntegration, error) {
+	return s.Get(slackIntegrationID)
+}
+
+func (s *SlackIntegration) CreateContext(ctx context.Context, slackIntegration *api.SlackIntegration) (*api.SlackIntegration, error) {
+	return s.Create(slackIntegration)
+}
+
+func (s *SlackIntegration) UpdateContext(ctx context.Context, slackIntegration *api.SlackIntegration) (*api.SlackIntegration, error) {
+	return s.Update(slackIntegration)
+}
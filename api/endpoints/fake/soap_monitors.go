@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -59,3 +61,31 @@ func (e *SOAPMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *SOAPMonitors) GetContext(ctx context.Context, monitorID string) (*api.SOAPMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *SOAPMonitors) CreateContext(ctx context.Context, monitor *api.SOAPMonitor) (*api.SOAPMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *SOAPMonitors) UpdateContext(ctx context.Context, monitor *api.SOAPMonitor) (*api.SOAPMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *SOAPMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *SOAPMonitors) ListContext(ctx context.Context) ([]*api.SOAPMonitor, error) {
+	return e.List()
+}
+
+func (e *SOAPMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *SOAPMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *SSLMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *SSLMonitors) GetContext(ctx context.Context, monitorID string) (*api.SSLMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *SSLMonitors) CreateContext(ctx context.Context, monitor *api.SSLMonitor) (*api.SSLMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *SSLMonitors) UpdateContext(ctx context.Context, monitor *api.SSLMonitor) (*api.SSLMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *SSLMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *SSLMonitors) ListContext(ctx context.Context) ([]*api.SSLMonitor, error) {
+	return e.List()
+}
+
+func (e *SSLMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *SSLMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
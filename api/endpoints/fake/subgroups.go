@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (e *Subgroups) List() ([]*api.Subgroup, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *Subgroups) GetContext(ctx context.Context, groupID string) (*api.Subgroup, error) {
+	return e.Get(groupID)
+}
+
+func (e *Subgroups) CreateContext(ctx context.Context, group *api.Subgroup) (*api.Subgroup, error) {
+	return e.Create(group)
+}
+
+func (e *Subgroups) UpdateContext(ctx context.Context, group *api.Subgroup) (*api.Subgroup, error) {
+	return e.Update(group)
+}
+
+func (e *Subgroups) DeleteContext(ctx context.Context, groupID string) error {
+	return e.Delete(groupID)
+}
+
+func (e *Subgroups) ListContext(ctx context.Context) ([]*api.Subgroup, error) {
+	return e.List()
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (e *Tags) List() ([]*api.Tag, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *Tags) GetContext(ctx context.Context, tagID string) (*api.Tag, error) {
+	return e.Get(tagID)
+}
+
+func (e *Tags) CreateContext(ctx context.Context, tag *api.Tag) (*api.Tag, error) {
+	return e.Create(tag)
+}
+
+func (e *Tags) UpdateContext(ctx context.Context, tag *api.Tag) (*api.Tag, error) {
+	return e.Update(tag)
+}
+
+func (e *Tags) DeleteContext(ctx context.Context, tagID string) error {
+	return e.Delete(tagID)
+}
+
+func (e *Tags) ListContext(ctx context.Context) ([]*api.Tag, error) {
+	return e.List()
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/integration"
 	"github.com/stretchr/testify/mock"
@@ -35,3 +37,15 @@ func (s *TelegramIntegration) Update(telegramIntegration *api.TelegramIntegratio
 	}
 	return nil, args.Error(1)
 }
+
+func (s *TelegramIntegration) GetContext(ctx context.Context, telegramIntegrationID string) (*api.TelegramIntegration, error) {
+	return s.Get(telegramIntegrationID)
+}
+
+func (s *TelegramIntegration) CreateContext(ctx context.Context, telegramIntegration *api.TelegramIntegration) (*api.TelegramIntegration, error) {
+	return s.Create(telegramIntegration)
+}
+
+func (s *TelegramIntegration) UpdateContext(ctx context.Context, telegramIntegration *api.TelegramIntegration) (*api.TelegramIntegration, error) {
+	return s.Update(telegramIntegration)
+}
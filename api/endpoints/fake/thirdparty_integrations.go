@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/integration"
 	"github.com/stretchr/testify/mock"
@@ -34,3 +36,19 @@ func (t *ThirdPartyIntegrations) Suspend(integrationID string) error {
 	args := t.Called(integrationID)
 	return args.Error(0)
 }
+
+func (t *ThirdPartyIntegrations) ListContext(ctx context.Context) ([]*api.ThirdPartyIntegrations, error) {
+	return t.List()
+}
+
+func (t *ThirdPartyIntegrations) DeleteContext(ctx context.Context, integrationID string) error {
+	return t.Delete(integrationID)
+}
+
+func (t *ThirdPartyIntegrations) ActivateContext(ctx context.Context, integrationID string) error {
+	return t.Activate(integrationID)
+}
+
+func (t *ThirdPartyIntegrations) SuspendContext(ctx context.Context, integrationID string) error {
+	return t.Suspend(integrationID)
+}
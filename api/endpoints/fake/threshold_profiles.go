@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (e *ThresholdProfiles) List() ([]*api.ThresholdProfile, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *ThresholdProfiles) GetContext(ctx context.Context, profileID string) (*api.ThresholdProfile, error) {
+	return e.Get(profileID)
+}
+
+func (e *ThresholdProfiles) CreateContext(ctx context.Context, profile *api.ThresholdProfile) (*api.ThresholdProfile, error) {
+	return e.Create(profile)
+}
+
+func (e *ThresholdProfiles) UpdateContext(ctx context.Context, profile *api.ThresholdProfile) (*api.ThresholdProfile, error) {
+	return e.Update(profile)
+}
+
+func (e *ThresholdProfiles) DeleteContext(ctx context.Context, profileID string) error {
+	return e.Delete(profileID)
+}
+
+func (e *ThresholdProfiles) ListContext(ctx context.Context) ([]*api.ThresholdProfile, error) {
+	return e.List()
+}
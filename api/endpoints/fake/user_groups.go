@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (e *UserGroups) List() ([]*api.UserGroup, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *UserGroups) GetContext(ctx context.Context, groupID string) (*api.UserGroup, error) {
+	return e.Get(groupID)
+}
+
+func (e *UserGroups) CreateContext(ctx context.Context, group *api.UserGroup) (*api.UserGroup, error) {
+	return e.Create(group)
+}
+
+func (e *UserGroups) UpdateContext(ctx context.Context, group *api.UserGroup) (*api.UserGroup, error) {
+	return e.Update(group)
+}
+
+func (e *UserGroups) DeleteContext(ctx context.Context, groupID string) error {
+	return e.Delete(groupID)
+}
+
+func (e *UserGroups) ListContext(ctx context.Context) ([]*api.UserGroup, error) {
+	return e.List()
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/stretchr/testify/mock"
@@ -48,3 +50,23 @@ func (e *Users) List() ([]*api.User, error) {
 	}
 	return nil, args.Error(1)
 }
+
+func (e *Users) GetContext(ctx context.Context, groupID string) (*api.User, error) {
+	return e.Get(groupID)
+}
+
+func (e *Users) CreateContext(ctx context.Context, group *api.User) (*api.User, error) {
+	return e.Create(group)
+}
+
+func (e *Users) UpdateContext(ctx context.Context, group *api.User) (*api.User, error) {
+	return e.Update(group)
+}
+
+func (e *Users) DeleteContext(ctx context.Context, groupID string) error {
+	return e.Delete(groupID)
+}
+
+func (e *Users) ListContext(ctx context.Context) ([]*api.User, error) {
+	return e.List()
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *WebPageSpeedMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *WebPageSpeedMonitors) GetContext(ctx context.Context, monitorID string) (*api.WebPageSpeedMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *WebPageSpeedMonitors) CreateContext(ctx context.Context, monitor *api.WebPageSpeedMonitor) (*api.WebPageSpeedMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *WebPageSpeedMonitors) UpdateContext(ctx context.Context, monitor *api.WebPageSpeedMonitor) (*api.WebPageSpeedMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *WebPageSpeedMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *WebPageSpeedMonitors) ListContext(ctx context.Context) ([]*api.WebPageSpeedMonitor, error) {
+	return e.List()
+}
+
+func (e *WebPageSpeedMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *WebPageSpeedMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
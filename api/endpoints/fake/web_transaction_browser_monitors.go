@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -60,3 +62,31 @@ func (e *WebTransactionBrowserMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *WebTransactionBrowserMonitors) GetContext(ctx context.Context, monitorID string) (*api.WebTransactionBrowserMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *WebTransactionBrowserMonitors) CreateContext(ctx context.Context, monitor *api.WebTransactionBrowserMonitor) (*api.WebTransactionBrowserMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *WebTransactionBrowserMonitors) UpdateContext(ctx context.Context, monitor *api.WebTransactionBrowserMonitor) (*api.WebTransactionBrowserMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *WebTransactionBrowserMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *WebTransactionBrowserMonitors) ListContext(ctx context.Context) ([]*api.WebTransactionBrowserMonitor, error) {
+	return e.List()
+}
+
+func (e *WebTransactionBrowserMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *WebTransactionBrowserMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
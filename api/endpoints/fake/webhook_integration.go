@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/integration"
 	"github.com/stretchr/testify/mock"
@@ -35,3 +37,15 @@ func (w *WebhookIntegration) Update(webhookIntegration *api.WebhookIntegration)
 	}
 	return nil, args.Error(1)
 }
+
+func (w *WebhookIntegration) GetContext(ctx context.Context, webhookIntegrationID string) (*api.WebhookIntegration, error) {
+	return w.Get(webhookIntegrationID)
+}
+
+func (w *WebhookIntegration) CreateContext(ctx context.Context, webhookIntegration *api.WebhookIntegration) (*api.WebhookIntegration, error) {
+	return w.Create(webhookIntegration)
+}
+
+func (w *WebhookIntegration) UpdateContext(ctx context.Context, webhookIntegration *api.WebhookIntegration) (*api.WebhookIntegration, error) {
+	return w.Update(webhookIntegration)
+}
@@ -1,6 +1,8 @@
 package fake
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
 	"github.com/stretchr/testify/mock"
@@ -58,3 +60,31 @@ func (e *WebsiteMonitors) Suspend(monitorID string) error {
 	args := e.Called(monitorID)
 	return args.Error(0)
 }
+
+func (e *WebsiteMonitors) GetContext(ctx context.Context, monitorID string) (*api.WebsiteMonitor, error) {
+	return e.Get(monitorID)
+}
+
+func (e *WebsiteMonitors) CreateContext(ctx context.Context, monitor *api.WebsiteMonitor) (*api.WebsiteMonitor, error) {
+	return e.Create(monitor)
+}
+
+func (e *WebsiteMonitors) UpdateContext(ctx context.Context, monitor *api.WebsiteMonitor) (*api.WebsiteMonitor, error) {
+	return e.Update(monitor)
+}
+
+func (e *WebsiteMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return e.Delete(monitorID)
+}
+
+func (e *WebsiteMonitors) ListContext(ctx context.Context) ([]*api.WebsiteMonitor, error) {
+	return e.List()
+}
+
+func (e *WebsiteMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return e.Activate(monitorID)
+}
+
+func (e *WebsiteMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return e.Suspend(monitorID)
+}
@@ -1,14 +1,19 @@
 package integration
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type ConnectwiseIntegration interface {
 	Get(connectwiseIntegrationID string) (*api.ConnectwiseIntegration, error)
+	GetContext(ctx context.Context, connectwiseIntegrationID string) (*api.ConnectwiseIntegration, error)
 	Create(connectwiseIntegration *api.ConnectwiseIntegration) (*api.ConnectwiseIntegration, error)
+	CreateContext(ctx context.Context, connectwiseIntegration *api.ConnectwiseIntegration) (*api.ConnectwiseIntegration, error)
 	Update(connectwiseIntegration *api.ConnectwiseIntegration) (*api.ConnectwiseIntegration, error)
+	UpdateContext(ctx context.Context, connectwiseIntegration *api.ConnectwiseIntegration) (*api.ConnectwiseIntegration, error)
 }
 
 type connectwise struct {
@@ -59,3 +64,23 @@ func (s *connectwise) Update(connectwiseIntegration *api.ConnectwiseIntegration)
 
 	return updatedConnectwiseIntegration, err
 }
+
+// withContext returns a copy of s whose requests are bound to ctx.
+func (s *connectwise) withContext(ctx context.Context) *connectwise {
+	return &connectwise{client: s.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (s *connectwise) GetContext(ctx context.Context, connectwiseIntegrationID string) (*api.ConnectwiseIntegration, error) {
+	return s.withContext(ctx).Get(connectwiseIntegrationID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (s *connectwise) CreateContext(ctx context.Context, connectwiseIntegration *api.ConnectwiseIntegration) (*api.ConnectwiseIntegration, error) {
+	return s.withContext(ctx).Create(connectwiseIntegration)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (s *connectwise) UpdateContext(ctx context.Context, connectwiseIntegration *api.ConnectwiseIntegration) (*api.ConnectwiseIntegration, error) {
+	return s.withContext(ctx).Update(connectwiseIntegration)
+}
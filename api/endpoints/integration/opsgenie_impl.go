@@ -1,14 +1,19 @@
 package integration
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type OpsgenieIntegration interface {
 	Get(opsgenieIntegrationID string) (*api.OpsgenieIntegration, error)
+	GetContext(ctx context.Context, opsgenieIntegrationID string) (*api.OpsgenieIntegration, error)
 	Create(opsgenieIntegration *api.OpsgenieIntegration) (*api.OpsgenieIntegration, error)
+	CreateContext(ctx context.Context, opsgenieIntegration *api.OpsgenieIntegration) (*api.OpsgenieIntegration, error)
 	Update(opsgenieIntegration *api.OpsgenieIntegration) (*api.OpsgenieIntegration, error)
+	UpdateContext(ctx context.Context, opsgenieIntegration *api.OpsgenieIntegration) (*api.OpsgenieIntegration, error)
 }
 
 type opsgenie struct {
@@ -59,3 +64,23 @@ func (o *opsgenie) Update(opsgenieIntegration *api.OpsgenieIntegration) (*api.Op
 
 	return updatedOpsgenieIntegration, err
 }
+
+// withContext returns a copy of o whose requests are bound to ctx.
+func (o *opsgenie) withContext(ctx context.Context) *opsgenie {
+	return &opsgenie{client: o.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (o *opsgenie) GetContext(ctx context.Context, opsgenieIntegrationID string) (*api.OpsgenieIntegration, error) {
+	return o.withContext(ctx).Get(opsgenieIntegrationID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (o *opsgenie) CreateContext(ctx context.Context, opsgenieIntegration *api.OpsgenieIntegration) (*api.OpsgenieIntegration, error) {
+	return o.withContext(ctx).Create(opsgenieIntegration)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (o *opsgenie) UpdateContext(ctx context.Context, opsgenieIntegration *api.OpsgenieIntegration) (*api.OpsgenieIntegration, error) {
+	return o.withContext(ctx).Update(opsgenieIntegration)
+}
@@ -1,14 +1,19 @@
 package integration
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type PagerDutyIntegration interface {
 	Get(pagerDutyIntegrationID string) (*api.PagerDutyIntegration, error)
+	GetContext(ctx context.Context, pagerDutyIntegrationID string) (*api.PagerDutyIntegration, error)
 	Create(pagerDutyIntegration *api.PagerDutyIntegration) (*api.PagerDutyIntegration, error)
+	CreateContext(ctx context.Context, pagerDutyIntegration *api.PagerDutyIntegration) (*api.PagerDutyIntegration, error)
 	Update(pagerDutyIntegration *api.PagerDutyIntegration) (*api.PagerDutyIntegration, error)
+	UpdateContext(ctx context.Context, pagerDutyIntegration *api.PagerDutyIntegration) (*api.PagerDutyIntegration, error)
 }
 
 type pagerDuty struct {
@@ -59,3 +64,23 @@ func (s *pagerDuty) Update(pagerDutyIntegration *api.PagerDutyIntegration) (*api
 
 	return updatedPagerDutyIntegration, err
 }
+
+// withContext returns a copy of s whose requests are bound to ctx.
+func (s *pagerDuty) withContext(ctx context.Context) *pagerDuty {
+	return &pagerDuty{client: s.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (s *pagerDuty) GetContext(ctx context.Context, pagerDutyIntegrationID string) (*api.PagerDutyIntegration, error) {
+	return s.withContext(ctx).Get(pagerDutyIntegrationID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (s *pagerDuty) CreateContext(ctx context.Context, pagerDutyIntegration *api.PagerDutyIntegration) (*api.PagerDutyIntegration, error) {
+	return s.withContext(ctx).Create(pagerDutyIntegration)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (s *pagerDuty) UpdateContext(ctx context.Context, pagerDutyIntegration *api.PagerDutyIntegration) (*api.PagerDutyIntegration, error) {
+	return s.withContext(ctx).Update(pagerDutyIntegration)
+}
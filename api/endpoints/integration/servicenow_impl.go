@@ -1,14 +1,19 @@
 package integration
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type ServiceNowIntegration interface {
 	Get(serviceNowIntegrationID string) (*api.ServiceNowIntegration, error)
+	GetContext(ctx context.Context, serviceNowIntegrationID string) (*api.ServiceNowIntegration, error)
 	Create(serviceNowIntegration *api.ServiceNowIntegration) (*api.ServiceNowIntegration, error)
+	CreateContext(ctx context.Context, serviceNowIntegration *api.ServiceNowIntegration) (*api.ServiceNowIntegration, error)
 	Update(serviceNowIntegration *api.ServiceNowIntegration) (*api.ServiceNowIntegration, error)
+	UpdateContext(ctx context.Context, serviceNowIntegration *api.ServiceNowIntegration) (*api.ServiceNowIntegration, error)
 }
 
 type serviceNow struct {
@@ -59,3 +64,23 @@ func (s *serviceNow) Update(serviceNowIntegration *api.ServiceNowIntegration) (*
 
 	return updatedServiceNowIntegration, err
 }
+
+// withContext returns a copy of s whose requests are bound to ctx.
+func (s *serviceNow) withContext(ctx context.Context) *serviceNow {
+	return &serviceNow{client: s.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (s *serviceNow) GetContext(ctx context.Context, serviceNowIntegrationID string) (*api.ServiceNowIntegration, error) {
+	return s.withContext(ctx).Get(serviceNowIntegrationID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (s *serviceNow) CreateContext(ctx context.Context, serviceNowIntegration *api.ServiceNowIntegration) (*api.ServiceNowIntegration, error) {
+	return s.withContext(ctx).Create(serviceNowIntegration)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (s *serviceNow) UpdateContext(ctx context.Context, serviceNowIntegration *api.ServiceNowIntegration) (*api.ServiceNowIntegration, error) {
+	return s.withContext(ctx).Update(serviceNowIntegration)
+}
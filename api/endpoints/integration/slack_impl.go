@@ -1,14 +1,19 @@
 package integration
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type SlackIntegration interface {
 	Get(slackIntegrationID string) (*api.SlackIntegration, error)
+	GetContext(ctx context.Context, slackIntegrationID string) (*api.SlackIntegration, error)
 	Create(slackIntegration *api.SlackIntegration) (*api.SlackIntegration, error)
+	CreateContext(ctx context.Context, slackIntegration *api.SlackIntegration) (*api.SlackIntegration, error)
 	Update(slackIntegration *api.SlackIntegration) (*api.SlackIntegration, error)
+	UpdateContext(ctx context.Context, slackIntegration *api.SlackIntegration) (*api.SlackIntegration, error)
 }
 
 type slack struct {
@@ -59,3 +64,23 @@ func (s *slack) Update(slackIntegration *api.SlackIntegration) (*api.SlackIntegr
 
 	return updatedSlackIntegration, err
 }
+
+// withContext returns a copy of s whose requests are bound to ctx.
+func (s *slack) withContext(ctx context.Context) *slack {
+	return &slack{client: s.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (s *slack) GetContext(ctx context.Context, slackIntegrationID string) (*api.SlackIntegration, error) {
+	return s.withContext(ctx).Get(slackIntegrationID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (s *slack) CreateContext(ctx context.Context, slackIntegration *api.SlackIntegration) (*api.SlackIntegration, error) {
+	return s.withContext(ctx).Create(slackIntegration)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (s *slack) UpdateContext(ctx context.Context, slackIntegration *api.SlackIntegration) (*api.SlackIntegration, error) {
+	return s.withContext(ctx).Update(slackIntegration)
+}
@@ -1,14 +1,19 @@
 package integration
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type TelegramIntegration interface {
 	Get(telegramIntegrationID string) (*api.TelegramIntegration, error)
+	GetContext(ctx context.Context, telegramIntegrationID string) (*api.TelegramIntegration, error)
 	Create(telegramIntegration *api.TelegramIntegration) (*api.TelegramIntegration, error)
+	CreateContext(ctx context.Context, telegramIntegration *api.TelegramIntegration) (*api.TelegramIntegration, error)
 	Update(telegramIntegration *api.TelegramIntegration) (*api.TelegramIntegration, error)
+	UpdateContext(ctx context.Context, telegramIntegration *api.TelegramIntegration) (*api.TelegramIntegration, error)
 }
 
 type telegram struct {
@@ -59,3 +64,23 @@ func (s *telegram) Update(telegramIntegration *api.TelegramIntegration) (*api.Te
 
 	return updatedTelegramIntegration, err
 }
+
+// withContext returns a copy of s whose requests are bound to ctx.
+func (s *telegram) withContext(ctx context.Context) *telegram {
+	return &telegram{client: s.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (s *telegram) GetContext(ctx context.Context, telegramIntegrationID string) (*api.TelegramIntegration, error) {
+	return s.withContext(ctx).Get(telegramIntegrationID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (s *telegram) CreateContext(ctx context.Context, telegramIntegration *api.TelegramIntegration) (*api.TelegramIntegration, error) {
+	return s.withContext(ctx).Create(telegramIntegration)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (s *telegram) UpdateContext(ctx context.Context, telegramIntegration *api.TelegramIntegration) (*api.TelegramIntegration, error) {
+	return s.withContext(ctx).Update(telegramIntegration)
+}
@@ -1,15 +1,21 @@
 package integration
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type ThirdpartyIntegrations interface {
 	List() ([]*api.ThirdPartyIntegrations, error)
+	ListContext(ctx context.Context) ([]*api.ThirdPartyIntegrations, error)
 	Delete(integrationID string) error
+	DeleteContext(ctx context.Context, integrationID string) error
 	Activate(integrationID string) error
+	ActivateContext(ctx context.Context, integrationID string) error
 	Suspend(integrationID string) error
+	SuspendContext(ctx context.Context, integrationID string) error
 }
 
 type thirdpartyIntegrations struct {
@@ -59,3 +65,28 @@ func (t *thirdpartyIntegrations) Suspend(integrationID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of t whose requests are bound to ctx.
+func (t *thirdpartyIntegrations) withContext(ctx context.Context) *thirdpartyIntegrations {
+	return &thirdpartyIntegrations{client: t.client.WithContext(ctx)}
+}
+
+// ListContext is like List but binds the request to ctx.
+func (t *thirdpartyIntegrations) ListContext(ctx context.Context) ([]*api.ThirdPartyIntegrations, error) {
+	return t.withContext(ctx).List()
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (t *thirdpartyIntegrations) DeleteContext(ctx context.Context, integrationID string) error {
+	return t.withContext(ctx).Delete(integrationID)
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (t *thirdpartyIntegrations) ActivateContext(ctx context.Context, integrationID string) error {
+	return t.withContext(ctx).Activate(integrationID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (t *thirdpartyIntegrations) SuspendContext(ctx context.Context, integrationID string) error {
+	return t.withContext(ctx).Suspend(integrationID)
+}
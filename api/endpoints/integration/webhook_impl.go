@@ -1,14 +1,19 @@
 package integration
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type WebhookIntegration interface {
 	Get(webhookIntegrationID string) (*api.WebhookIntegration, error)
+	GetContext(ctx context.Context, webhookIntegrationID string) (*api.WebhookIntegration, error)
 	Create(webhookIntegration *api.WebhookIntegration) (*api.WebhookIntegration, error)
+	CreateContext(ctx context.Context, webhookIntegration *api.WebhookIntegration) (*api.WebhookIntegration, error)
 	Update(webhookIntegration *api.WebhookIntegration) (*api.WebhookIntegration, error)
+	UpdateContext(ctx context.Context, webhookIntegration *api.WebhookIntegration) (*api.WebhookIntegration, error)
 }
 
 type webhook struct {
@@ -59,3 +64,23 @@ func (w *webhook) Update(webhookIntegration *api.WebhookIntegration) (*api.Webho
 
 	return updatedWebhookIntegration, err
 }
+
+// withContext returns a copy of w whose requests are bound to ctx.
+func (w *webhook) withContext(ctx context.Context) *webhook {
+	return &webhook{client: w.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (w *webhook) GetContext(ctx context.Context, webhookIntegrationID string) (*api.WebhookIntegration, error) {
+	return w.withContext(ctx).Get(webhookIntegrationID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (w *webhook) CreateContext(ctx context.Context, webhookIntegration *api.WebhookIntegration) (*api.WebhookIntegration, error) {
+	return w.withContext(ctx).Create(webhookIntegration)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (w *webhook) UpdateContext(ctx context.Context, webhookIntegration *api.WebhookIntegration) (*api.WebhookIntegration, error) {
+	return w.withContext(ctx).Update(webhookIntegration)
+}
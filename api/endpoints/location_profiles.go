@@ -1,6 +1,8 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/jinzhu/copier"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
@@ -8,10 +10,15 @@ import (
 
 type LocationProfiles interface {
 	Get(profileID string) (*api.LocationProfile, error)
+	GetContext(ctx context.Context, profileID string) (*api.LocationProfile, error)
 	Create(profile *api.LocationProfile) (*api.LocationProfile, error)
+	CreateContext(ctx context.Context, profile *api.LocationProfile) (*api.LocationProfile, error)
 	Update(profile *api.LocationProfile) (*api.LocationProfile, error)
+	UpdateContext(ctx context.Context, profile *api.LocationProfile) (*api.LocationProfile, error)
 	Delete(profileID string) error
+	DeleteContext(ctx context.Context, profileID string) error
 	List() ([]*api.LocationProfile, error)
+	ListContext(ctx context.Context) ([]*api.LocationProfile, error)
 }
 
 type locationProfiles struct {
@@ -76,17 +83,42 @@ func (c *locationProfiles) Delete(profileID string) error {
 }
 
 func (c *locationProfiles) List() ([]*api.LocationProfile, error) {
-	api.LocationProfilesLock.Lock()
-	defer api.LocationProfilesLock.Unlock()
-	var err error
-	if len(api.LocationProfiles) == 0 {
-		profiles := []*api.LocationProfile{}
-		err = c.client.
-			Get().
-			Resource("location_profiles").
-			Do().
-			Parse(&profiles)
-		api.LocationProfiles = profiles
-	}
-	return api.LocationProfiles, err
+	profiles := []*api.LocationProfile{}
+	err := c.client.
+		Get().
+		Resource("location_profiles").
+		Do().
+		Parse(&profiles)
+
+	return profiles, err
+}
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *locationProfiles) withContext(ctx context.Context) *locationProfiles {
+	return &locationProfiles{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *locationProfiles) GetContext(ctx context.Context, profileID string) (*api.LocationProfile, error) {
+	return c.withContext(ctx).Get(profileID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *locationProfiles) CreateContext(ctx context.Context, profile *api.LocationProfile) (*api.LocationProfile, error) {
+	return c.withContext(ctx).Create(profile)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *locationProfiles) UpdateContext(ctx context.Context, profile *api.LocationProfile) (*api.LocationProfile, error) {
+	return c.withContext(ctx).Update(profile)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *locationProfiles) DeleteContext(ctx context.Context, profileID string) error {
+	return c.withContext(ctx).Delete(profileID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *locationProfiles) ListContext(ctx context.Context) ([]*api.LocationProfile, error) {
+	return c.withContext(ctx).List()
 }
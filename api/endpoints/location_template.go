@@ -1,12 +1,15 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type LocationTemplate interface {
 	Get() (*api.LocationTemplate, error)
+	GetContext(ctx context.Context) (*api.LocationTemplate, error)
 }
 
 type locationTemplate struct {
@@ -29,3 +32,13 @@ func (c *locationTemplate) Get() (*api.LocationTemplate, error) {
 
 	return template, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *locationTemplate) withContext(ctx context.Context) *locationTemplate {
+	return &locationTemplate{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *locationTemplate) GetContext(ctx context.Context) (*api.LocationTemplate, error) {
+	return c.withContext(ctx).Get()
+}
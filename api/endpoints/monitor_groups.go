@@ -1,6 +1,8 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/jinzhu/copier"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
@@ -8,10 +10,15 @@ import (
 
 type MonitorGroups interface {
 	Get(groupID string) (*api.MonitorGroup, error)
+	GetContext(ctx context.Context, groupID string) (*api.MonitorGroup, error)
 	Create(group *api.MonitorGroup) (*api.MonitorGroup, error)
+	CreateContext(ctx context.Context, group *api.MonitorGroup) (*api.MonitorGroup, error)
 	Update(group *api.MonitorGroup) (*api.MonitorGroup, error)
+	UpdateContext(ctx context.Context, group *api.MonitorGroup) (*api.MonitorGroup, error)
 	Delete(groupID string) error
+	DeleteContext(ctx context.Context, groupID string) error
 	List() ([]*api.MonitorGroup, error)
+	ListContext(ctx context.Context) ([]*api.MonitorGroup, error)
 }
 
 type monitorGroups struct {
@@ -76,17 +83,42 @@ func (c *monitorGroups) Delete(groupID string) error {
 }
 
 func (c *monitorGroups) List() ([]*api.MonitorGroup, error) {
-	api.MonitorGroupsLock.Lock()
-	defer api.MonitorGroupsLock.Unlock()
-	var err error
-	if len(api.MonitorGroups) == 0 {
-		monitorGroups := []*api.MonitorGroup{}
-		err = c.client.
-			Get().
-			Resource("monitor_groups").
-			Do().
-			Parse(&monitorGroups)
-		api.MonitorGroups = monitorGroups
-	}
-	return api.MonitorGroups, err
+	monitorGroups := []*api.MonitorGroup{}
+	err := c.client.
+		Get().
+		Resource("monitor_groups").
+		Do().
+		Parse(&monitorGroups)
+
+	return monitorGroups, err
+}
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *monitorGroups) withContext(ctx context.Context) *monitorGroups {
+	return &monitorGroups{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *monitorGroups) GetContext(ctx context.Context, groupID string) (*api.MonitorGroup, error) {
+	return c.withContext(ctx).Get(groupID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *monitorGroups) CreateContext(ctx context.Context, group *api.MonitorGroup) (*api.MonitorGroup, error) {
+	return c.withContext(ctx).Create(group)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *monitorGroups) UpdateContext(ctx context.Context, group *api.MonitorGroup) (*api.MonitorGroup, error) {
+	return c.withContext(ctx).Update(group)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *monitorGroups) DeleteContext(ctx context.Context, groupID string) error {
+	return c.withContext(ctx).Delete(groupID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *monitorGroups) ListContext(ctx context.Context) ([]*api.MonitorGroup, error) {
+	return c.withContext(ctx).List()
 }
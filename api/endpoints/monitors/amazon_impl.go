@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type AmazonMonitors interface {
 	Get(monitorID string) (*api.AmazonMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.AmazonMonitor, error)
 	Create(monitor *api.AmazonMonitor) (*api.AmazonMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.AmazonMonitor) (*api.AmazonMonitor, error)
 	Update(monitor *api.AmazonMonitor) (*api.AmazonMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.AmazonMonitor) (*api.AmazonMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.AmazonMonitor, error)
+	ListContext(ctx context.Context) ([]*api.AmazonMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type amazonMonitors struct {
@@ -101,3 +110,43 @@ func (c *amazonMonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *amazonMonitors) withContext(ctx context.Context) *amazonMonitors {
+	return &amazonMonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *amazonMonitors) GetContext(ctx context.Context, monitorID string) (*api.AmazonMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *amazonMonitors) CreateContext(ctx context.Context, monitor *api.AmazonMonitor) (*api.AmazonMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *amazonMonitors) UpdateContext(ctx context.Context, monitor *api.AmazonMonitor) (*api.AmazonMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *amazonMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *amazonMonitors) ListContext(ctx context.Context) ([]*api.AmazonMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *amazonMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *amazonMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
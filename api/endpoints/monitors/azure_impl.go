@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type AzureMonitors interface {
 	Get(monitorID string) (*api.AzureMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.AzureMonitor, error)
 	Create(monitor *api.AzureMonitor) (*api.AzureMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.AzureMonitor) (*api.AzureMonitor, error)
 	Update(monitor *api.AzureMonitor) (*api.AzureMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.AzureMonitor) (*api.AzureMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.AzureMonitor, error)
+	ListContext(ctx context.Context) ([]*api.AzureMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type azureMonitors struct {
@@ -101,3 +110,43 @@ func (c *azureMonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *azureMonitors) withContext(ctx context.Context) *azureMonitors {
+	return &azureMonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *azureMonitors) GetContext(ctx context.Context, monitorID string) (*api.AzureMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *azureMonitors) CreateContext(ctx context.Context, monitor *api.AzureMonitor) (*api.AzureMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *azureMonitors) UpdateContext(ctx context.Context, monitor *api.AzureMonitor) (*api.AzureMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *azureMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *azureMonitors) ListContext(ctx context.Context) ([]*api.AzureMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *azureMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *azureMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type CronMonitors interface {
 	Get(monitorID string) (*api.CronMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.CronMonitor, error)
 	Create(monitor *api.CronMonitor) (*api.CronMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.CronMonitor) (*api.CronMonitor, error)
 	Update(monitor *api.CronMonitor) (*api.CronMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.CronMonitor) (*api.CronMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.CronMonitor, error)
+	ListContext(ctx context.Context) ([]*api.CronMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type cronMonitors struct {
@@ -101,3 +110,43 @@ func (c *cronMonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *cronMonitors) withContext(ctx context.Context) *cronMonitors {
+	return &cronMonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *cronMonitors) GetContext(ctx context.Context, monitorID string) (*api.CronMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *cronMonitors) CreateContext(ctx context.Context, monitor *api.CronMonitor) (*api.CronMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *cronMonitors) UpdateContext(ctx context.Context, monitor *api.CronMonitor) (*api.CronMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *cronMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *cronMonitors) ListContext(ctx context.Context) ([]*api.CronMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *cronMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *cronMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type DNSServerMonitors interface {
 	Get(monitorID string) (*api.DNSServerMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.DNSServerMonitor, error)
 	Create(monitor *api.DNSServerMonitor) (*api.DNSServerMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.DNSServerMonitor) (*api.DNSServerMonitor, error)
 	Update(monitor *api.DNSServerMonitor) (*api.DNSServerMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.DNSServerMonitor) (*api.DNSServerMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.DNSServerMonitor, error)
+	ListContext(ctx context.Context) ([]*api.DNSServerMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type dnsservermonitors struct {
@@ -100,3 +109,43 @@ func (c *dnsservermonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *dnsservermonitors) withContext(ctx context.Context) *dnsservermonitors {
+	return &dnsservermonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *dnsservermonitors) GetContext(ctx context.Context, monitorID string) (*api.DNSServerMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *dnsservermonitors) CreateContext(ctx context.Context, monitor *api.DNSServerMonitor) (*api.DNSServerMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *dnsservermonitors) UpdateContext(ctx context.Context, monitor *api.DNSServerMonitor) (*api.DNSServerMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *dnsservermonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *dnsservermonitors) ListContext(ctx context.Context) ([]*api.DNSServerMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *dnsservermonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *dnsservermonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
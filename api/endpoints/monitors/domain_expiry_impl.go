@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type DomainExpiryMonitors interface {
 	Get(monitorID string) (*api.DomainExpiryMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.DomainExpiryMonitor, error)
 	Create(monitor *api.DomainExpiryMonitor) (*api.DomainExpiryMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.DomainExpiryMonitor) (*api.DomainExpiryMonitor, error)
 	Update(monitor *api.DomainExpiryMonitor) (*api.DomainExpiryMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.DomainExpiryMonitor) (*api.DomainExpiryMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.DomainExpiryMonitor, error)
+	ListContext(ctx context.Context) ([]*api.DomainExpiryMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type domainExpiryMonitors struct {
@@ -102,3 +111,43 @@ func (c *domainExpiryMonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *domainExpiryMonitors) withContext(ctx context.Context) *domainExpiryMonitors {
+	return &domainExpiryMonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *domainExpiryMonitors) GetContext(ctx context.Context, monitorID string) (*api.DomainExpiryMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *domainExpiryMonitors) CreateContext(ctx context.Context, monitor *api.DomainExpiryMonitor) (*api.DomainExpiryMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *domainExpiryMonitors) UpdateContext(ctx context.Context, monitor *api.DomainExpiryMonitor) (*api.DomainExpiryMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *domainExpiryMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *domainExpiryMonitors) ListContext(ctx context.Context) ([]*api.DomainExpiryMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *domainExpiryMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *domainExpiryMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
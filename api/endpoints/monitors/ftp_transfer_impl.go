@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type FTPTransferMonitors interface {
 	Get(monitorID string) (*api.FTPTransferMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.FTPTransferMonitor, error)
 	Create(monitor *api.FTPTransferMonitor) (*api.FTPTransferMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.FTPTransferMonitor) (*api.FTPTransferMonitor, error)
 	Update(monitor *api.FTPTransferMonitor) (*api.FTPTransferMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.FTPTransferMonitor) (*api.FTPTransferMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.FTPTransferMonitor, error)
+	ListContext(ctx context.Context) ([]*api.FTPTransferMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type ftptransfermonitors struct {
@@ -101,3 +110,43 @@ func (c *ftptransfermonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *ftptransfermonitors) withContext(ctx context.Context) *ftptransfermonitors {
+	return &ftptransfermonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *ftptransfermonitors) GetContext(ctx context.Context, monitorID string) (*api.FTPTransferMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *ftptransfermonitors) CreateContext(ctx context.Context, monitor *api.FTPTransferMonitor) (*api.FTPTransferMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *ftptransfermonitors) UpdateContext(ctx context.Context, monitor *api.FTPTransferMonitor) (*api.FTPTransferMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *ftptransfermonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *ftptransfermonitors) ListContext(ctx context.Context) ([]*api.FTPTransferMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *ftptransfermonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *ftptransfermonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type GCPMonitors interface {
 	Get(monitorID string) (*api.GCPMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.GCPMonitor, error)
 	Create(monitor *api.GCPMonitor) (*api.GCPMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.GCPMonitor) (*api.GCPMonitor, error)
 	Update(monitor *api.GCPMonitor) (*api.GCPMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.GCPMonitor) (*api.GCPMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.GCPMonitor, error)
+	ListContext(ctx context.Context) ([]*api.GCPMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type gcpMonitors struct {
@@ -101,3 +110,43 @@ func (c *gcpMonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *gcpMonitors) withContext(ctx context.Context) *gcpMonitors {
+	return &gcpMonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *gcpMonitors) GetContext(ctx context.Context, monitorID string) (*api.GCPMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *gcpMonitors) CreateContext(ctx context.Context, monitor *api.GCPMonitor) (*api.GCPMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *gcpMonitors) UpdateContext(ctx context.Context, monitor *api.GCPMonitor) (*api.GCPMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *gcpMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *gcpMonitors) ListContext(ctx context.Context) ([]*api.GCPMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *gcpMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *gcpMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type HeartbeatMonitors interface {
 	Get(monitorID string) (*api.HeartbeatMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.HeartbeatMonitor, error)
 	Create(monitor *api.HeartbeatMonitor) (*api.HeartbeatMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.HeartbeatMonitor) (*api.HeartbeatMonitor, error)
 	Update(monitor *api.HeartbeatMonitor) (*api.HeartbeatMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.HeartbeatMonitor) (*api.HeartbeatMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.HeartbeatMonitor, error)
+	ListContext(ctx context.Context) ([]*api.HeartbeatMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type heartbeatmonitors struct {
@@ -101,3 +110,43 @@ func (c *heartbeatmonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *heartbeatmonitors) withContext(ctx context.Context) *heartbeatmonitors {
+	return &heartbeatmonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *heartbeatmonitors) GetContext(ctx context.Context, monitorID string) (*api.HeartbeatMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *heartbeatmonitors) CreateContext(ctx context.Context, monitor *api.HeartbeatMonitor) (*api.HeartbeatMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *heartbeatmonitors) UpdateContext(ctx context.Context, monitor *api.HeartbeatMonitor) (*api.HeartbeatMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *heartbeatmonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *heartbeatmonitors) ListContext(ctx context.Context) ([]*api.HeartbeatMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *heartbeatmonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *heartbeatmonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type ISPMonitors interface {
 	Get(monitorID string) (*api.ISPMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.ISPMonitor, error)
 	Create(monitor *api.ISPMonitor) (*api.ISPMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.ISPMonitor) (*api.ISPMonitor, error)
 	Update(monitor *api.ISPMonitor) (*api.ISPMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.ISPMonitor) (*api.ISPMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.ISPMonitor, error)
+	ListContext(ctx context.Context) ([]*api.ISPMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type ispmonitors struct {
@@ -101,3 +110,43 @@ func (c *ispmonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *ispmonitors) withContext(ctx context.Context) *ispmonitors {
+	return &ispmonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *ispmonitors) GetContext(ctx context.Context, monitorID string) (*api.ISPMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *ispmonitors) CreateContext(ctx context.Context, monitor *api.ISPMonitor) (*api.ISPMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *ispmonitors) UpdateContext(ctx context.Context, monitor *api.ISPMonitor) (*api.ISPMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *ispmonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *ispmonitors) ListContext(ctx context.Context) ([]*api.ISPMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *ispmonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *ispmonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type PINGMonitors interface {
 	Get(monitorID string) (*api.PINGMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.PINGMonitor, error)
 	Create(monitor *api.PINGMonitor) (*api.PINGMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.PINGMonitor) (*api.PINGMonitor, error)
 	Update(monitor *api.PINGMonitor) (*api.PINGMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.PINGMonitor) (*api.PINGMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.PINGMonitor, error)
+	ListContext(ctx context.Context) ([]*api.PINGMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type pingMonitors struct {
@@ -102,3 +111,43 @@ func (c *pingMonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *pingMonitors) withContext(ctx context.Context) *pingMonitors {
+	return &pingMonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *pingMonitors) GetContext(ctx context.Context, monitorID string) (*api.PINGMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *pingMonitors) CreateContext(ctx context.Context, monitor *api.PINGMonitor) (*api.PINGMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *pingMonitors) UpdateContext(ctx context.Context, monitor *api.PINGMonitor) (*api.PINGMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *pingMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *pingMonitors) ListContext(ctx context.Context) ([]*api.PINGMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *pingMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *pingMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type PortMonitors interface {
 	Get(monitorID string) (*api.PortMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.PortMonitor, error)
 	Create(monitor *api.PortMonitor) (*api.PortMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.PortMonitor) (*api.PortMonitor, error)
 	Update(monitor *api.PortMonitor) (*api.PortMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.PortMonitor) (*api.PortMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.PortMonitor, error)
+	ListContext(ctx context.Context) ([]*api.PortMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type portMonitors struct {
@@ -102,3 +111,43 @@ func (c *portMonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *portMonitors) withContext(ctx context.Context) *portMonitors {
+	return &portMonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *portMonitors) GetContext(ctx context.Context, monitorID string) (*api.PortMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *portMonitors) CreateContext(ctx context.Context, monitor *api.PortMonitor) (*api.PortMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *portMonitors) UpdateContext(ctx context.Context, monitor *api.PortMonitor) (*api.PortMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *portMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *portMonitors) ListContext(ctx context.Context) ([]*api.PortMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *portMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *portMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
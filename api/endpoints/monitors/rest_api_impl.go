@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type RestApiMonitors interface {
 	Get(monitorID string) (*api.RestApiMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.RestApiMonitor, error)
 	Create(monitor *api.RestApiMonitor) (*api.RestApiMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.RestApiMonitor) (*api.RestApiMonitor, error)
 	Update(monitor *api.RestApiMonitor) (*api.RestApiMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.RestApiMonitor) (*api.RestApiMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.RestApiMonitor, error)
+	ListContext(ctx context.Context) ([]*api.RestApiMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type restapimonitors struct {
@@ -101,3 +110,43 @@ func (c *restapimonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *restapimonitors) withContext(ctx context.Context) *restapimonitors {
+	return &restapimonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *restapimonitors) GetContext(ctx context.Context, monitorID string) (*api.RestApiMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *restapimonitors) CreateContext(ctx context.Context, monitor *api.RestApiMonitor) (*api.RestApiMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *restapimonitors) UpdateContext(ctx context.Context, monitor *api.RestApiMonitor) (*api.RestApiMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *restapimonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *restapimonitors) ListContext(ctx context.Context) ([]*api.RestApiMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *restapimonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *restapimonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
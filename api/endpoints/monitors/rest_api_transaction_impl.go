@@ -1,19 +1,29 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type RestApiTransactionMonitors interface {
 	Get(monitorID string) (*api.RestApiTransactionMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.RestApiTransactionMonitor, error)
 	GetSteps(monitorID string) (*[]api.Steps, error)
+	GetStepsContext(ctx context.Context, monitorID string) (*[]api.Steps, error)
 	Create(monitor *api.RestApiTransactionMonitor) (*api.RestApiTransactionMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.RestApiTransactionMonitor) (*api.RestApiTransactionMonitor, error)
 	Update(monitor *api.RestApiTransactionMonitor) (*api.RestApiTransactionMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.RestApiTransactionMonitor) (*api.RestApiTransactionMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.RestApiTransactionMonitor, error)
+	ListContext(ctx context.Context) ([]*api.RestApiTransactionMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type restapitransactions struct {
@@ -112,3 +122,48 @@ func (c *restapitransactions) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *restapitransactions) withContext(ctx context.Context) *restapitransactions {
+	return &restapitransactions{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *restapitransactions) GetContext(ctx context.Context, monitorID string) (*api.RestApiTransactionMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// GetStepsContext is like GetSteps but binds the request to ctx.
+func (c *restapitransactions) GetStepsContext(ctx context.Context, monitorID string) (*[]api.Steps, error) {
+	return c.withContext(ctx).GetSteps(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *restapitransactions) CreateContext(ctx context.Context, monitor *api.RestApiTransactionMonitor) (*api.RestApiTransactionMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *restapitransactions) UpdateContext(ctx context.Context, monitor *api.RestApiTransactionMonitor) (*api.RestApiTransactionMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *restapitransactions) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *restapitransactions) ListContext(ctx context.Context) ([]*api.RestApiTransactionMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *restapitransactions) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *restapitransactions) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type ServerMonitors interface {
 	Get(monitorID string) (*api.ServerMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.ServerMonitor, error)
 	Create(monitor *api.ServerMonitor) (*api.ServerMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.ServerMonitor) (*api.ServerMonitor, error)
 	Update(monitor *api.ServerMonitor) (*api.ServerMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.ServerMonitor) (*api.ServerMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.ServerMonitor, error)
+	ListContext(ctx context.Context) ([]*api.ServerMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type servermonitors struct {
@@ -101,3 +110,43 @@ func (c *servermonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *servermonitors) withContext(ctx context.Context) *servermonitors {
+	return &servermonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *servermonitors) GetContext(ctx context.Context, monitorID string) (*api.ServerMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *servermonitors) CreateContext(ctx context.Context, monitor *api.ServerMonitor) (*api.ServerMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *servermonitors) UpdateContext(ctx context.Context, monitor *api.ServerMonitor) (*api.ServerMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *servermonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *servermonitors) ListContext(ctx context.Context) ([]*api.ServerMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *servermonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *servermonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
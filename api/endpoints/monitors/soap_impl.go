@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type SOAPMonitors interface {
 	Get(monitorID string) (*api.SOAPMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.SOAPMonitor, error)
 	Create(monitor *api.SOAPMonitor) (*api.SOAPMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.SOAPMonitor) (*api.SOAPMonitor, error)
 	Update(monitor *api.SOAPMonitor) (*api.SOAPMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.SOAPMonitor) (*api.SOAPMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.SOAPMonitor, error)
+	ListContext(ctx context.Context) ([]*api.SOAPMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type soapMonitors struct {
@@ -102,3 +111,43 @@ func (c *soapMonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *soapMonitors) withContext(ctx context.Context) *soapMonitors {
+	return &soapMonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *soapMonitors) GetContext(ctx context.Context, monitorID string) (*api.SOAPMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *soapMonitors) CreateContext(ctx context.Context, monitor *api.SOAPMonitor) (*api.SOAPMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *soapMonitors) UpdateContext(ctx context.Context, monitor *api.SOAPMonitor) (*api.SOAPMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *soapMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *soapMonitors) ListContext(ctx context.Context) ([]*api.SOAPMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *soapMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *soapMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
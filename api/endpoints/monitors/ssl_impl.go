@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type SSLMonitors interface {
 	Get(monitorID string) (*api.SSLMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.SSLMonitor, error)
 	Create(monitor *api.SSLMonitor) (*api.SSLMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.SSLMonitor) (*api.SSLMonitor, error)
 	Update(monitor *api.SSLMonitor) (*api.SSLMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.SSLMonitor) (*api.SSLMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.SSLMonitor, error)
+	ListContext(ctx context.Context) ([]*api.SSLMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type sslmonitors struct {
@@ -101,3 +110,43 @@ func (c *sslmonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *sslmonitors) withContext(ctx context.Context) *sslmonitors {
+	return &sslmonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *sslmonitors) GetContext(ctx context.Context, monitorID string) (*api.SSLMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *sslmonitors) CreateContext(ctx context.Context, monitor *api.SSLMonitor) (*api.SSLMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *sslmonitors) UpdateContext(ctx context.Context, monitor *api.SSLMonitor) (*api.SSLMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *sslmonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *sslmonitors) ListContext(ctx context.Context) ([]*api.SSLMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *sslmonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *sslmonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type WebPageSpeedMonitors interface {
 	Get(monitorID string) (*api.WebPageSpeedMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.WebPageSpeedMonitor, error)
 	Create(monitor *api.WebPageSpeedMonitor) (*api.WebPageSpeedMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.WebPageSpeedMonitor) (*api.WebPageSpeedMonitor, error)
 	Update(monitor *api.WebPageSpeedMonitor) (*api.WebPageSpeedMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.WebPageSpeedMonitor) (*api.WebPageSpeedMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.WebPageSpeedMonitor, error)
+	ListContext(ctx context.Context) ([]*api.WebPageSpeedMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type webpagespeedmonitors struct {
@@ -101,3 +110,43 @@ func (c *webpagespeedmonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *webpagespeedmonitors) withContext(ctx context.Context) *webpagespeedmonitors {
+	return &webpagespeedmonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *webpagespeedmonitors) GetContext(ctx context.Context, monitorID string) (*api.WebPageSpeedMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *webpagespeedmonitors) CreateContext(ctx context.Context, monitor *api.WebPageSpeedMonitor) (*api.WebPageSpeedMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *webpagespeedmonitors) UpdateContext(ctx context.Context, monitor *api.WebPageSpeedMonitor) (*api.WebPageSpeedMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *webpagespeedmonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *webpagespeedmonitors) ListContext(ctx context.Context) ([]*api.WebPageSpeedMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *webpagespeedmonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *webpagespeedmonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type WebTransactionBrowserMonitors interface {
 	Get(monitorID string) (*api.WebTransactionBrowserMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.WebTransactionBrowserMonitor, error)
 	Create(monitor *api.WebTransactionBrowserMonitor) (*api.WebTransactionBrowserMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.WebTransactionBrowserMonitor) (*api.WebTransactionBrowserMonitor, error)
 	Update(monitor *api.WebTransactionBrowserMonitor) (*api.WebTransactionBrowserMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.WebTransactionBrowserMonitor) (*api.WebTransactionBrowserMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.WebTransactionBrowserMonitor, error)
+	ListContext(ctx context.Context) ([]*api.WebTransactionBrowserMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type webTransactionBrowserMonitors struct {
@@ -101,3 +110,43 @@ func (c *webTransactionBrowserMonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *webTransactionBrowserMonitors) withContext(ctx context.Context) *webTransactionBrowserMonitors {
+	return &webTransactionBrowserMonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *webTransactionBrowserMonitors) GetContext(ctx context.Context, monitorID string) (*api.WebTransactionBrowserMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *webTransactionBrowserMonitors) CreateContext(ctx context.Context, monitor *api.WebTransactionBrowserMonitor) (*api.WebTransactionBrowserMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *webTransactionBrowserMonitors) UpdateContext(ctx context.Context, monitor *api.WebTransactionBrowserMonitor) (*api.WebTransactionBrowserMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *webTransactionBrowserMonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *webTransactionBrowserMonitors) ListContext(ctx context.Context) ([]*api.WebTransactionBrowserMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *webTransactionBrowserMonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *webTransactionBrowserMonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,18 +1,27 @@
 package monitors
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type WebsiteMonitors interface {
 	Get(monitorID string) (*api.WebsiteMonitor, error)
+	GetContext(ctx context.Context, monitorID string) (*api.WebsiteMonitor, error)
 	Create(monitor *api.WebsiteMonitor) (*api.WebsiteMonitor, error)
+	CreateContext(ctx context.Context, monitor *api.WebsiteMonitor) (*api.WebsiteMonitor, error)
 	Update(monitor *api.WebsiteMonitor) (*api.WebsiteMonitor, error)
+	UpdateContext(ctx context.Context, monitor *api.WebsiteMonitor) (*api.WebsiteMonitor, error)
 	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
 	List() ([]*api.WebsiteMonitor, error)
+	ListContext(ctx context.Context) ([]*api.WebsiteMonitor, error)
 	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
 	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
 }
 
 type websitemonitors struct {
@@ -102,3 +111,43 @@ func (c *websitemonitors) Suspend(monitorID string) error {
 		Do().
 		Err()
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *websitemonitors) withContext(ctx context.Context) *websitemonitors {
+	return &websitemonitors{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *websitemonitors) GetContext(ctx context.Context, monitorID string) (*api.WebsiteMonitor, error) {
+	return c.withContext(ctx).Get(monitorID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *websitemonitors) CreateContext(ctx context.Context, monitor *api.WebsiteMonitor) (*api.WebsiteMonitor, error) {
+	return c.withContext(ctx).Create(monitor)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *websitemonitors) UpdateContext(ctx context.Context, monitor *api.WebsiteMonitor) (*api.WebsiteMonitor, error) {
+	return c.withContext(ctx).Update(monitor)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *websitemonitors) DeleteContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Delete(monitorID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *websitemonitors) ListContext(ctx context.Context) ([]*api.WebsiteMonitor, error) {
+	return c.withContext(ctx).List()
+}
+
+// ActivateContext is like Activate but binds the request to ctx.
+func (c *websitemonitors) ActivateContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Activate(monitorID)
+}
+
+// SuspendContext is like Suspend but binds the request to ctx.
+func (c *websitemonitors) SuspendContext(ctx context.Context, monitorID string) error {
+	return c.withContext(ctx).Suspend(monitorID)
+}
@@ -1,12 +1,15 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type MSP interface {
 	List() ([]*api.MSPCustomer, error)
+	ListContext(ctx context.Context) ([]*api.MSPCustomer, error)
 }
 
 type msp struct {
@@ -28,3 +31,13 @@ func (c *msp) List() ([]*api.MSPCustomer, error) {
 		Parse(&mspCustomers)
 	return mspCustomers, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *msp) withContext(ctx context.Context) *msp {
+	return &msp{client: c.client.WithContext(ctx)}
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *msp) ListContext(ctx context.Context) ([]*api.MSPCustomer, error) {
+	return c.withContext(ctx).List()
+}
@@ -1,6 +1,8 @@
 package msp
 
 import (
+	"context"
+
 	"github.com/jinzhu/copier"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
@@ -8,10 +10,15 @@ import (
 
 type Customers interface {
 	Get(customerID string) (*api.Customer, error)
+	GetContext(ctx context.Context, customerID string) (*api.Customer, error)
 	Create(customer *api.Customer) (*api.Customer, error)
+	CreateContext(ctx context.Context, customer *api.Customer) (*api.Customer, error)
 	Update(customer *api.Customer) (*api.Customer, error)
+	UpdateContext(ctx context.Context, customer *api.Customer) (*api.Customer, error)
 	Delete(customerID string) error
+	DeleteContext(ctx context.Context, customerID string) error
 	List() ([]*api.Customer, error)
+	ListContext(ctx context.Context) ([]*api.Customer, error)
 }
 
 type customers struct {
@@ -82,3 +89,33 @@ func (c *customers) List() ([]*api.Customer, error) {
 
 	return customers, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *customers) withContext(ctx context.Context) *customers {
+	return &customers{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *customers) GetContext(ctx context.Context, customerID string) (*api.Customer, error) {
+	return c.withContext(ctx).Get(customerID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *customers) CreateContext(ctx context.Context, customer *api.Customer) (*api.Customer, error) {
+	return c.withContext(ctx).Create(customer)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *customers) UpdateContext(ctx context.Context, customer *api.Customer) (*api.Customer, error) {
+	return c.withContext(ctx).Update(customer)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *customers) DeleteContext(ctx context.Context, customerID string) error {
+	return c.withContext(ctx).Delete(customerID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *customers) ListContext(ctx context.Context) ([]*api.Customer, error) {
+	return c.withContext(ctx).List()
+}
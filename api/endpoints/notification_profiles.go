@@ -1,16 +1,23 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type NotificationProfiles interface {
 	Get(profileID string) (*api.NotificationProfile, error)
+	GetContext(ctx context.Context, profileID string) (*api.NotificationProfile, error)
 	Create(profile *api.NotificationProfile) (*api.NotificationProfile, error)
+	CreateContext(ctx context.Context, profile *api.NotificationProfile) (*api.NotificationProfile, error)
 	Update(profile *api.NotificationProfile) (*api.NotificationProfile, error)
+	UpdateContext(ctx context.Context, profile *api.NotificationProfile) (*api.NotificationProfile, error)
 	Delete(profileID string) error
+	DeleteContext(ctx context.Context, profileID string) error
 	List() ([]*api.NotificationProfile, error)
+	ListContext(ctx context.Context) ([]*api.NotificationProfile, error)
 }
 
 type notificationProfiles struct {
@@ -72,17 +79,42 @@ func (c *notificationProfiles) Delete(profileID string) error {
 }
 
 func (c *notificationProfiles) List() ([]*api.NotificationProfile, error) {
-	api.NotificationProfilesLock.Lock()
-	defer api.NotificationProfilesLock.Unlock()
-	var err error
-	if len(api.NotificationProfiles) == 0 {
-		notificationProfiles := []*api.NotificationProfile{}
-		err = c.client.
-			Get().
-			Resource("notification_profiles").
-			Do().
-			Parse(&notificationProfiles)
-		api.NotificationProfiles = notificationProfiles
-	}
-	return api.NotificationProfiles, err
+	notificationProfiles := []*api.NotificationProfile{}
+	err := c.client.
+		Get().
+		Resource("notification_profiles").
+		Do().
+		Parse(&notificationProfiles)
+
+	return notificationProfiles, err
+}
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *notificationProfiles) withContext(ctx context.Context) *notificationProfiles {
+	return &notificationProfiles{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *notificationProfiles) GetContext(ctx context.Context, profileID string) (*api.NotificationProfile, error) {
+	return c.withContext(ctx).Get(profileID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *notificationProfiles) CreateContext(ctx context.Context, profile *api.NotificationProfile) (*api.NotificationProfile, error) {
+	return c.withContext(ctx).Create(profile)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *notificationProfiles) UpdateContext(ctx context.Context, profile *api.NotificationProfile) (*api.NotificationProfile, error) {
+	return c.withContext(ctx).Update(profile)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *notificationProfiles) DeleteContext(ctx context.Context, profileID string) error {
+	return c.withContext(ctx).Delete(profileID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *notificationProfiles) ListContext(ctx context.Context) ([]*api.NotificationProfile, error) {
+	return c.withContext(ctx).List()
 }
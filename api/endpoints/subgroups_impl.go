@@ -1,16 +1,23 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type Subgroups interface {
 	Get(groupID string) (*api.Subgroup, error)
+	GetContext(ctx context.Context, groupID string) (*api.Subgroup, error)
 	Create(group *api.Subgroup) (*api.Subgroup, error)
+	CreateContext(ctx context.Context, group *api.Subgroup) (*api.Subgroup, error)
 	Update(group *api.Subgroup) (*api.Subgroup, error)
+	UpdateContext(ctx context.Context, group *api.Subgroup) (*api.Subgroup, error)
 	Delete(groupID string) error
+	DeleteContext(ctx context.Context, groupID string) error
 	List() ([]*api.Subgroup, error)
+	ListContext(ctx context.Context) ([]*api.Subgroup, error)
 }
 
 type subgroups struct {
@@ -81,3 +88,33 @@ func (c *subgroups) List() ([]*api.Subgroup, error) {
 
 	return subgroups, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *subgroups) withContext(ctx context.Context) *subgroups {
+	return &subgroups{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *subgroups) GetContext(ctx context.Context, groupID string) (*api.Subgroup, error) {
+	return c.withContext(ctx).Get(groupID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *subgroups) CreateContext(ctx context.Context, group *api.Subgroup) (*api.Subgroup, error) {
+	return c.withContext(ctx).Create(group)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *subgroups) UpdateContext(ctx context.Context, group *api.Subgroup) (*api.Subgroup, error) {
+	return c.withContext(ctx).Update(group)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *subgroups) DeleteContext(ctx context.Context, groupID string) error {
+	return c.withContext(ctx).Delete(groupID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *subgroups) ListContext(ctx context.Context) ([]*api.Subgroup, error) {
+	return c.withContext(ctx).List()
+}
@@ -1,16 +1,23 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type Tags interface {
 	Get(tagID string) (*api.Tag, error)
+	GetContext(ctx context.Context, tagID string) (*api.Tag, error)
 	Create(tag *api.Tag) (*api.Tag, error)
+	CreateContext(ctx context.Context, tag *api.Tag) (*api.Tag, error)
 	Update(tag *api.Tag) (*api.Tag, error)
+	UpdateContext(ctx context.Context, tag *api.Tag) (*api.Tag, error)
 	Delete(tagID string) error
+	DeleteContext(ctx context.Context, tagID string) error
 	List() ([]*api.Tag, error)
+	ListContext(ctx context.Context) ([]*api.Tag, error)
 }
 
 type tags struct {
@@ -72,17 +79,42 @@ func (c *tags) Delete(tagID string) error {
 }
 
 func (c *tags) List() ([]*api.Tag, error) {
-	api.TagsListLock.Lock()
-	defer api.TagsListLock.Unlock()
-	var err error
-	if len(api.TagsList) == 0 {
-		tags := []*api.Tag{}
-		err = c.client.
-			Get().
-			Resource("tags").
-			Do().
-			Parse(&tags)
-		api.TagsList = tags
-	}
-	return api.TagsList, err
+	tags := []*api.Tag{}
+	err := c.client.
+		Get().
+		Resource("tags").
+		Do().
+		Parse(&tags)
+
+	return tags, err
+}
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *tags) withContext(ctx context.Context) *tags {
+	return &tags{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *tags) GetContext(ctx context.Context, tagID string) (*api.Tag, error) {
+	return c.withContext(ctx).Get(tagID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *tags) CreateContext(ctx context.Context, tag *api.Tag) (*api.Tag, error) {
+	return c.withContext(ctx).Create(tag)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *tags) UpdateContext(ctx context.Context, tag *api.Tag) (*api.Tag, error) {
+	return c.withContext(ctx).Update(tag)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *tags) DeleteContext(ctx context.Context, tagID string) error {
+	return c.withContext(ctx).Delete(tagID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *tags) ListContext(ctx context.Context) ([]*api.Tag, error) {
+	return c.withContext(ctx).List()
 }
@@ -1,9 +1,11 @@
 package endpoints
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 	"github.com/site24x7/terraform-provider-site24x7/validation"
 	"github.com/stretchr/testify/assert"
@@ -114,3 +116,36 @@ func TestTags(t *testing.T) {
 		},
 	})
 }
+
+// TestTagsReplay replays a cassette that was recorded against the API
+// emulator. It covers the record/replay harness, not the behaviour of the live
+// API.
+func TestTagsReplay(t *testing.T) {
+	c := validation.RecordedClient(t, "tags")
+
+	tag, err := NewTags(c).Create(&api.Tag{
+		TagName:  "terraform-recorded",
+		TagValue: "baz",
+		TagColor: "#B7DA9E",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, tag.TagID)
+
+	tag.TagValue = "qux"
+	_, err = NewTags(c).Update(tag)
+	require.NoError(t, err)
+
+	tag, err = NewTags(c).Get(tag.TagID)
+	require.NoError(t, err)
+	assert.Equal(t, "terraform-recorded", tag.TagName)
+	assert.Equal(t, "qux", tag.TagValue)
+
+	tags, err := NewTags(c).List()
+	require.NoError(t, err)
+	assert.Contains(t, tags, tag)
+
+	require.NoError(t, NewTags(c).Delete(tag.TagID))
+
+	_, err = NewTags(c).Get(tag.TagID)
+	assert.True(t, errors.Is(err, apierrors.ErrNotFound), "got %v", err)
+}
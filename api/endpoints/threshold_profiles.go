@@ -1,16 +1,23 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type ThresholdProfiles interface {
 	Get(profileID string) (*api.ThresholdProfile, error)
+	GetContext(ctx context.Context, profileID string) (*api.ThresholdProfile, error)
 	Create(profile *api.ThresholdProfile) (*api.ThresholdProfile, error)
+	CreateContext(ctx context.Context, profile *api.ThresholdProfile) (*api.ThresholdProfile, error)
 	Update(profile *api.ThresholdProfile) (*api.ThresholdProfile, error)
+	UpdateContext(ctx context.Context, profile *api.ThresholdProfile) (*api.ThresholdProfile, error)
 	Delete(profileID string) error
+	DeleteContext(ctx context.Context, profileID string) error
 	List() ([]*api.ThresholdProfile, error)
+	ListContext(ctx context.Context) ([]*api.ThresholdProfile, error)
 }
 
 type thresholdProfiles struct {
@@ -75,17 +82,42 @@ func (c *thresholdProfiles) Delete(profileID string) error {
 }
 
 func (c *thresholdProfiles) List() ([]*api.ThresholdProfile, error) {
-	api.ThresholdProfilesLock.Lock()
-	defer api.ThresholdProfilesLock.Unlock()
-	var err error
-	if len(api.ThresholdProfiles) == 0 {
-		thresholdProfiles := []*api.ThresholdProfile{}
-		err = c.client.
-			Get().
-			Resource("threshold_profiles").
-			Do().
-			Parse(&thresholdProfiles)
-		api.ThresholdProfiles = thresholdProfiles
-	}
-	return api.ThresholdProfiles, err
+	thresholdProfiles := []*api.ThresholdProfile{}
+	err := c.client.
+		Get().
+		Resource("threshold_profiles").
+		Do().
+		Parse(&thresholdProfiles)
+
+	return thresholdProfiles, err
+}
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *thresholdProfiles) withContext(ctx context.Context) *thresholdProfiles {
+	return &thresholdProfiles{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *thresholdProfiles) GetContext(ctx context.Context, profileID string) (*api.ThresholdProfile, error) {
+	return c.withContext(ctx).Get(profileID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *thresholdProfiles) CreateContext(ctx context.Context, profile *api.ThresholdProfile) (*api.ThresholdProfile, error) {
+	return c.withContext(ctx).Create(profile)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *thresholdProfiles) UpdateContext(ctx context.Context, profile *api.ThresholdProfile) (*api.ThresholdProfile, error) {
+	return c.withContext(ctx).Update(profile)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *thresholdProfiles) DeleteContext(ctx context.Context, profileID string) error {
+	return c.withContext(ctx).Delete(profileID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *thresholdProfiles) ListContext(ctx context.Context) ([]*api.ThresholdProfile, error) {
+	return c.withContext(ctx).List()
 }
@@ -1,16 +1,23 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 )
 
 type URLActions interface {
 	Get(actionID string) (*api.URLAction, error)
+	GetContext(ctx context.Context, actionID string) (*api.URLAction, error)
 	Create(automation *api.URLAction) (*api.URLAction, error)
+	CreateContext(ctx context.Context, automation *api.URLAction) (*api.URLAction, error)
 	Update(automation *api.URLAction) (*api.URLAction, error)
+	UpdateContext(ctx context.Context, automation *api.URLAction) (*api.URLAction, error)
 	Delete(actionID string) error
+	DeleteContext(ctx context.Context, actionID string) error
 	List() ([]*api.URLAction, error)
+	ListContext(ctx context.Context) ([]*api.URLAction, error)
 }
 
 type urlActions struct {
@@ -81,3 +88,33 @@ func (c *urlActions) List() ([]*api.URLAction, error) {
 
 	return urlAction, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *urlActions) withContext(ctx context.Context) *urlActions {
+	return &urlActions{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *urlActions) GetContext(ctx context.Context, actionID string) (*api.URLAction, error) {
+	return c.withContext(ctx).Get(actionID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *urlActions) CreateContext(ctx context.Context, automation *api.URLAction) (*api.URLAction, error) {
+	return c.withContext(ctx).Create(automation)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *urlActions) UpdateContext(ctx context.Context, automation *api.URLAction) (*api.URLAction, error) {
+	return c.withContext(ctx).Update(automation)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *urlActions) DeleteContext(ctx context.Context, actionID string) error {
+	return c.withContext(ctx).Delete(actionID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *urlActions) ListContext(ctx context.Context) ([]*api.URLAction, error) {
+	return c.withContext(ctx).List()
+}
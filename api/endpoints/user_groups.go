@@ -1,6 +1,8 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/jinzhu/copier"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
@@ -8,10 +10,15 @@ import (
 
 type UserGroups interface {
 	Get(groupID string) (*api.UserGroup, error)
+	GetContext(ctx context.Context, groupID string) (*api.UserGroup, error)
 	Create(group *api.UserGroup) (*api.UserGroup, error)
+	CreateContext(ctx context.Context, group *api.UserGroup) (*api.UserGroup, error)
 	Update(group *api.UserGroup) (*api.UserGroup, error)
+	UpdateContext(ctx context.Context, group *api.UserGroup) (*api.UserGroup, error)
 	Delete(groupID string) error
+	DeleteContext(ctx context.Context, groupID string) error
 	List() ([]*api.UserGroup, error)
+	ListContext(ctx context.Context) ([]*api.UserGroup, error)
 }
 
 type userGroups struct {
@@ -76,17 +83,42 @@ func (c *userGroups) Delete(groupID string) error {
 }
 
 func (c *userGroups) List() ([]*api.UserGroup, error) {
-	api.UserGroupsLock.Lock()
-	defer api.UserGroupsLock.Unlock()
-	var err error
-	if len(api.UserGroups) == 0 {
-		userGroups := []*api.UserGroup{}
-		err = c.client.
-			Get().
-			Resource("user_groups").
-			Do().
-			Parse(&userGroups)
-		api.UserGroups = userGroups
-	}
-	return api.UserGroups, err
+	userGroups := []*api.UserGroup{}
+	err := c.client.
+		Get().
+		Resource("user_groups").
+		Do().
+		Parse(&userGroups)
+
+	return userGroups, err
+}
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *userGroups) withContext(ctx context.Context) *userGroups {
+	return &userGroups{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *userGroups) GetContext(ctx context.Context, groupID string) (*api.UserGroup, error) {
+	return c.withContext(ctx).Get(groupID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *userGroups) CreateContext(ctx context.Context, group *api.UserGroup) (*api.UserGroup, error) {
+	return c.withContext(ctx).Create(group)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *userGroups) UpdateContext(ctx context.Context, group *api.UserGroup) (*api.UserGroup, error) {
+	return c.withContext(ctx).Update(group)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *userGroups) DeleteContext(ctx context.Context, groupID string) error {
+	return c.withContext(ctx).Delete(groupID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *userGroups) ListContext(ctx context.Context) ([]*api.UserGroup, error) {
+	return c.withContext(ctx).List()
 }
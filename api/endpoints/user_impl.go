@@ -1,6 +1,8 @@
 package endpoints
 
 import (
+	"context"
+
 	"github.com/jinzhu/copier"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
@@ -8,10 +10,15 @@ import (
 
 type Users interface {
 	Get(groupID string) (*api.User, error)
+	GetContext(ctx context.Context, groupID string) (*api.User, error)
 	Create(group *api.User) (*api.User, error)
+	CreateContext(ctx context.Context, group *api.User) (*api.User, error)
 	Update(group *api.User) (*api.User, error)
+	UpdateContext(ctx context.Context, group *api.User) (*api.User, error)
 	Delete(groupID string) error
+	DeleteContext(ctx context.Context, groupID string) error
 	List() ([]*api.User, error)
+	ListContext(ctx context.Context) ([]*api.User, error)
 }
 
 type users struct {
@@ -85,3 +92,33 @@ func (c *users) List() ([]*api.User, error) {
 
 	return users, err
 }
+
+// withContext returns a copy of c whose requests are bound to ctx.
+func (c *users) withContext(ctx context.Context) *users {
+	return &users{client: c.client.WithContext(ctx)}
+}
+
+// GetContext is like Get but binds the request to ctx.
+func (c *users) GetContext(ctx context.Context, groupID string) (*api.User, error) {
+	return c.withContext(ctx).Get(groupID)
+}
+
+// CreateContext is like Create but binds the request to ctx.
+func (c *users) CreateContext(ctx context.Context, group *api.User) (*api.User, error) {
+	return c.withContext(ctx).Create(group)
+}
+
+// UpdateContext is like Update but binds the request to ctx.
+func (c *users) UpdateContext(ctx context.Context, group *api.User) (*api.User, error) {
+	return c.withContext(ctx).Update(group)
+}
+
+// DeleteContext is like Delete but binds the request to ctx.
+func (c *users) DeleteContext(ctx context.Context, groupID string) error {
+	return c.withContext(ctx).Delete(groupID)
+}
+
+// ListContext is like List but binds the request to ctx.
+func (c *users) ListContext(ctx context.Context) ([]*api.User, error) {
+	return c.withContext(ctx).List()
+}
@@ -0,0 +1,101 @@
+package errors
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+)
+
+// Sentinel errors for well-known failure classes of the Site24x7 API. Errors
+// returned by the API client can be matched against them with errors.Is, even
+// if they were wrapped along the way, e.g. by the retrying http client.
+var (
+	// ErrNotFound is returned if the requested resource does not exist
+	// (anymore).
+	ErrNotFound = errors.New("resource not found")
+
+	// ErrDuplicateName is returned if another resource with the same
+	// display name already exists.
+	ErrDuplicateName = errors.New("duplicate display name")
+
+	// ErrPermissionDenied is returned if the OAuth token or the user lacks
+	// the privileges required for the operation.
+	ErrPermissionDenied = errors.New("permission denied")
+
+	// ErrValidation is returned if the request payload was rejected as
+	// invalid.
+	ErrValidation = errors.New("validation failed")
+)
+
+// Error codes of the Site24x7 API. Only codes listed in the error code table
+// of the API documentation are defined here.
+const (
+	// CodeMissingParameter is returned if a mandatory parameter is missing.
+	// See https://www.site24x7.com/help/api/#error-codes, code 1101.
+	CodeMissingParameter = 1101
+
+	// CodeInvalidParameter is returned if a parameter has an invalid value.
+	// See https://www.site24x7.com/help/api/#error-codes, code 1102.
+	CodeInvalidParameter = 1102
+
+	// CodeInvalidPayload is returned if the request body is not valid JSON.
+	// See https://www.site24x7.com/help/api/#error-codes, code 1103.
+	CodeInvalidPayload = 1103
+
+	// CodeDuplicateName is returned if the display name is already in use.
+	// See https://www.site24x7.com/help/api/#error-codes, code 2101.
+	CodeDuplicateName = 2101
+)
+
+// errorCode describes how a documented Site24x7 error code is handled.
+type errorCode struct {
+	// sentinel is the sentinel error matching the code, if any.
+	sentinel error
+
+	// retryable marks codes that signal throttling or a temporary failure
+	// and are retried by default.
+	retryable bool
+}
+
+// errorCodes is the catalogue of documented Site24x7 error codes. It maps the
+// error_code field of API error responses to sentinel errors and decides which
+// codes are retried by default. None of the documented codes signals
+// throttling, so throttled requests are only retried based on their HTTP
+// status code.
+var errorCodes = map[int]errorCode{
+	CodeMissingParameter: {sentinel: ErrValidation},
+	CodeInvalidParameter: {sentinel: ErrValidation},
+	CodeInvalidPayload:   {sentinel: ErrValidation},
+	CodeDuplicateName:    {sentinel: ErrDuplicateName},
+}
+
+// RetryableErrorCodes returns the documented error codes that signal
+// throttling or a temporary failure, in ascending order.
+func RetryableErrorCodes() []int {
+	codes := []int{}
+	for code, c := range errorCodes {
+		if c.retryable {
+			codes = append(codes, code)
+		}
+	}
+	sort.Ints(codes)
+
+	return codes
+}
+
+// statusCodes maps HTTP status codes to sentinel errors. They are used if
+// the error code of a response is unknown.
+var statusCodes = map[int]error{
+	http.StatusNotFound:  ErrNotFound,
+	http.StatusForbidden: ErrPermissionDenied,
+}
+
+// sentinelFor returns the sentinel error for the given Site24x7 error code and
+// HTTP status code, or nil if there is none.
+func sentinelFor(errorCode, statusCode int) error {
+	if c, ok := errorCodes[errorCode]; ok && c.sentinel != nil {
+		return c.sentinel
+	}
+
+	return statusCodes[statusCode]
+}
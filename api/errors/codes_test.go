@@ -0,0 +1,80 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{
+			name:     "not found by status code",
+			err:      NewStatusError(404, "not found"),
+			expected: ErrNotFound,
+		},
+		{
+			name:     "permission denied by status code",
+			err:      NewStatusError(403, "forbidden"),
+			expected: ErrPermissionDenied,
+		},
+		{
+			name:     "duplicate name by error code",
+			err:      NewExtendedStatusError(400, "display name already exists", CodeDuplicateName, nil),
+			expected: ErrDuplicateName,
+		},
+		{
+			name:     "validation failure by error code",
+			err:      NewExtendedStatusError(400, "invalid parameter", CodeInvalidParameter, nil),
+			expected: ErrValidation,
+		},
+		{
+			name:     "extended error falls back to status code",
+			err:      NewExtendedStatusError(404, "not found", 0, nil),
+			expected: ErrNotFound,
+		},
+		{
+			name:     "wrapped extended error",
+			err:      fmt.Errorf("giving up after 4 attempts due to: %w", NewExtendedStatusError(403, "denied", 0, nil)),
+			expected: ErrPermissionDenied,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.True(t, errors.Is(test.err, test.expected))
+		})
+	}
+}
+
+func TestSentinelErrorsDoNotMatchUnrelated(t *testing.T) {
+	err := NewExtendedStatusError(400, "display name already exists", CodeDuplicateName, nil)
+
+	assert.False(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrValidation))
+	assert.False(t, errors.Is(NewStatusError(409, "conflict"), ErrDuplicateName))
+	assert.False(t, errors.Is(NewExtendedStatusError(400, "undocumented error code", 2105, nil), ErrValidation))
+	assert.False(t, errors.Is(NewStatusError(500, "error"), ErrNotFound))
+	assert.False(t, errors.Is(errors.New("foo"), ErrNotFound))
+}
+
+func TestErrorCode(t *testing.T) {
+	code, ok := ErrorCode(fmt.Errorf("wrapped: %w", NewExtendedStatusError(400, "invalid", 1102, nil)))
+	assert.True(t, ok)
+	assert.Equal(t, 1102, code)
+
+	_, ok = ErrorCode(NewStatusError(400, "invalid"))
+	assert.False(t, ok)
+}
+
+func TestRetryableErrorCodes(t *testing.T) {
+	// None of the documented error codes signals throttling or a temporary
+	// failure.
+	assert.Equal(t, []int{}, RetryableErrorCodes())
+}
@@ -1,5 +1,7 @@
 package errors
 
+import "errors"
+
 // StatusError provides the HTTP status code in addition to the error message.
 type StatusError interface {
 	error
@@ -36,6 +38,13 @@ func (e *statusError) Error() string {
 	return e.message
 }
 
+// Is allows matching e against the sentinel errors of this package by HTTP
+// status code.
+func (e *statusError) Is(target error) bool {
+	sentinel := statusCodes[e.statusCode]
+	return sentinel != nil && sentinel == target
+}
+
 type extendedStatusError struct {
 	StatusError
 	errorCode int
@@ -61,3 +70,13 @@ func (e *extendedStatusError) ErrorCode() int {
 func (e *extendedStatusError) ErrorInfo() map[string]interface{} {
 	return e.errorInfo
 }
+
+// Is allows matching e against the sentinel errors of this package. The
+// Site24x7 error code takes precedence over the HTTP status code.
+func (e *extendedStatusError) Is(target error) bool {
+	if sentinel := sentinelFor(e.errorCode, e.StatusCode()); sentinel != nil && sentinel == target {
+		return true
+	}
+
+	return errors.Is(e.StatusError, target)
+}
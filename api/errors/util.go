@@ -1,23 +1,48 @@
 package errors
 
-import "net/http"
+import (
+	"errors"
+	"net/http"
+)
 
-// IsStatusError returns true if err is of type StatusError.
+// IsStatusError returns true if err is or wraps a StatusError.
 func IsStatusError(err error) bool {
-	_, ok := err.(StatusError)
+	_, ok := AsStatusError(err)
 	return ok
 }
 
-// IsExtendedStatusError returns true if err is of type ExtendedStatusError.
+// IsExtendedStatusError returns true if err is or wraps an
+// ExtendedStatusError.
 func IsExtendedStatusError(err error) bool {
-	_, ok := err.(ExtendedStatusError)
-	return ok
+	var extendedErr ExtendedStatusError
+	return errors.As(err, &extendedErr)
+}
+
+// AsStatusError returns the StatusError wrapped in err, if any.
+func AsStatusError(err error) (StatusError, bool) {
+	var statusErr StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr, true
+	}
+
+	return nil, false
+}
+
+// ErrorCode returns the Site24x7 error code of err. The second return value
+// is false if err does not wrap an ExtendedStatusError.
+func ErrorCode(err error) (int, bool) {
+	var extendedErr ExtendedStatusError
+	if errors.As(err, &extendedErr) {
+		return extendedErr.ErrorCode(), true
+	}
+
+	return 0, false
 }
 
-// HasStatusCode returns true if err has the given status code. If err is not
-// of type StatusError, this will always return false.
+// HasStatusCode returns true if err has the given status code. If err does
+// not wrap a StatusError, this will always return false.
 func HasStatusCode(err error, code int) bool {
-	if statusErr, ok := err.(StatusError); ok {
+	if statusErr, ok := AsStatusError(err); ok {
 		return statusErr.StatusCode() == code
 	}
 
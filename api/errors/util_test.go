@@ -2,6 +2,7 @@ package errors
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -38,6 +39,11 @@ func TestIsNotFound(t *testing.T) {
 			err:      NewExtendedStatusError(503, "service unavailable", 0, nil),
 			expected: false,
 		},
+		{
+			name:     "wrapped 404 status error",
+			err:      fmt.Errorf("GET /monitors/123: %w", NewStatusError(404, "not found")),
+			expected: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -594,6 +594,18 @@ type MonitorStatus struct {
 	Duration       string   `json:"duration"`
 	ServerType     string   `json:"server_type"`
 	Tags           []string `json:"tags"`
+
+	// Locations is only populated if the locations were requested.
+	Locations []*LocationStatus `json:"locations"`
+}
+
+// LocationStatus describes the status of a monitor in a monitoring location.
+type LocationStatus struct {
+	LocationName   string `json:"location_name"`
+	Status         Status `json:"status"`
+	LastPolledTime string `json:"last_polled_time"`
+	DownReason     string `json:"down_reason"`
+	OutageID       string `json:"outage_id"`
 }
 
 // CurrentStatusListOptions hold the options that can be specified to filter
@@ -1,15 +1,24 @@
 package backoff
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"math"
 	"net/http"
 	"strconv"
 	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 )
 
+// now is replaceable in tests.
+var now = time.Now
+
 // HTTPClient is the interface of an http client that is compatible with
 // *http.Client.
 type HTTPClient interface {
@@ -34,6 +43,13 @@ type RetryConfig struct {
 
 	// Backoff calculates the sleep duration for the next retry.
 	Backoff retryablehttp.Backoff
+
+	// RetryableErrorCodes are Site24x7 error codes which mark an error
+	// response as transient, e.g. throttling that is reported with a 4xx
+	// status code. Requests failing with one of these codes are retried. If
+	// nil, DefaultRetryableErrorCodes is used; an empty slice disables
+	// retrying on error codes.
+	RetryableErrorCodes []int
 }
 
 func (c *RetryConfig) withDefaults(defaults RetryConfig) *RetryConfig {
@@ -57,6 +73,10 @@ func (c *RetryConfig) withDefaults(defaults RetryConfig) *RetryConfig {
 		c.Backoff = defaults.Backoff
 	}
 
+	if c.RetryableErrorCodes == nil {
+		c.RetryableErrorCodes = defaults.RetryableErrorCodes
+	}
+
 	return c
 }
 
@@ -67,6 +87,8 @@ var DefaultRetryConfig = RetryConfig{
 	MaxRetries: 4,
 	CheckRetry: DefaultRetryPolicy,
 	Backoff:    DefaultBackoff,
+
+	RetryableErrorCodes: DefaultRetryableErrorCodes,
 }
 
 // retryableClient wraps *retryablehttp.Client to be compatible with the
@@ -82,13 +104,18 @@ func WithRetries(httpClient *http.Client, config *RetryConfig) HTTPClient {
 		cfg = *config.withDefaults(DefaultRetryConfig)
 	}
 
+	checkRetry := cfg.CheckRetry
+	if len(cfg.RetryableErrorCodes) > 0 {
+		checkRetry = ErrorCodeRetryPolicy(cfg.RetryableErrorCodes, checkRetry)
+	}
+
 	c := &retryableClient{
 		&retryablehttp.Client{
 			HTTPClient:   httpClient,
 			RetryWaitMin: cfg.MinWait,
 			RetryWaitMax: cfg.MaxWait,
 			RetryMax:     cfg.MaxRetries,
-			CheckRetry:   cfg.CheckRetry,
+			CheckRetry:   checkRetry,
 			Backoff:      cfg.Backoff,
 			ErrorHandler: errorHandler,
 		},
@@ -108,19 +135,51 @@ func (c *retryableClient) Do(req *http.Request) (*http.Response, error) {
 
 	resp, err := c.Client.Do(wrappedReq)
 	if err != nil {
-		err = fmt.Errorf("%s %s: %v", req.Method, req.URL, err)
+		err = fmt.Errorf("%s %s: %w", req.Method, req.URL, err)
 	}
 
 	return resp, err
 }
 
-// errorHandler wraps the error with the number of request attempts.
+// RetryError is returned once the retrying client gives up. It reports the
+// number of attempts that were made and wraps the error of the last attempt.
+// If the last attempt yielded a response, it is returned alongside the
+// *RetryError with Err set to nil, so that callers can decode the error
+// response and attach it via Err.
+type RetryError struct {
+	// Attempts is the number of requests that were sent.
+	Attempts int
+
+	// Err is the error of the last attempt.
+	Err error
+}
+
+// Error implements error.
+func (e *RetryError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("giving up after %d attempts", e.Attempts)
+	}
+
+	return fmt.Sprintf("giving up after %d attempts due to: %v", e.Attempts, e.Err)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// errorHandler wraps the error with the number of request attempts. It is
+// only invoked once the client gives up.
 func errorHandler(resp *http.Response, err error, attempts int) (*http.Response, error) {
 	if err != nil {
-		err = fmt.Errorf("giving up after %d attempts due to: %v", attempts, err)
+		return resp, &RetryError{Attempts: attempts, Err: err}
 	}
 
-	return resp, err
+	if resp != nil && attempts > 1 {
+		return resp, &RetryError{Attempts: attempts}
+	}
+
+	return resp, nil
 }
 
 // DefaultRetryPolicy provides a callback for retryablehttp.Client.CheckRetry, which
@@ -147,6 +206,54 @@ func DefaultRetryPolicy(ctx context.Context, resp *http.Response, err error) (bo
 	return false, nil
 }
 
+// DefaultRetryableErrorCodes are the Site24x7 error codes that indicate
+// throttling or a temporary failure which is worth retrying. They are taken
+// from the error code catalogue in api/errors.
+var DefaultRetryableErrorCodes = apierrors.RetryableErrorCodes()
+
+// ErrorCodeRetryPolicy wraps next and additionally retries error responses
+// whose JSON body carries one of the given Site24x7 error codes. The response
+// body is buffered so that it can still be read after the retry decision.
+func ErrorCodeRetryPolicy(retryableCodes []int, next retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	codes := make(map[int]bool, len(retryableCodes))
+	for _, code := range retryableCodes {
+		codes[code] = true
+	}
+
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		retry, checkErr := next(ctx, resp, err)
+		if retry || checkErr != nil || err != nil || resp == nil || resp.StatusCode < 400 {
+			return retry, checkErr
+		}
+
+		errorCode, ok := peekErrorCode(resp)
+
+		return ok && codes[errorCode], nil
+	}
+}
+
+// peekErrorCode decodes the error_code of a Site24x7 error response without
+// consuming resp.Body.
+func peekErrorCode(resp *http.Response) (int, bool) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return 0, false
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return 0, false
+	}
+
+	errorResponse := &api.ErrorResponse{}
+	if err := json.Unmarshal(body, errorResponse); err != nil {
+		return 0, false
+	}
+
+	return errorResponse.ErrorCode, errorResponse.ErrorCode != 0
+}
+
 // DefaultBackoff provides a callback for retryablehttp.Client.Backoff which will
 // perform exponential backoff based on the attempt number and limited by the
 // provided minimum and maximum durations. On 429 responses it will try to
@@ -154,7 +261,7 @@ func DefaultRetryPolicy(ctx context.Context, resp *http.Response, err error) (bo
 // exponential backoff if the Retry-After header is not present or cannot be
 // parsed.
 func DefaultBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
-	retryAfter, ok := getRetryAfter(resp)
+	retryAfter, ok := RetryAfter(resp)
 	if ok && retryAfter > 0 {
 		if retryAfter > max {
 			return max
@@ -163,12 +270,22 @@ func DefaultBackoff(min, max time.Duration, attemptNum int, resp *http.Response)
 		return retryAfter
 	}
 
-	return retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+	// retryablehttp.DefaultBackoff honors Retry-After itself and would
+	// return zero here, so compute the exponential backoff locally.
+	mult := math.Pow(2, float64(attemptNum)) * float64(min)
+
+	backoff := time.Duration(mult)
+	if float64(backoff) != mult || backoff > max {
+		return max
+	}
+
+	return backoff
 }
 
-// getRetryAfter obtains the timeout from the Retry-After header if set. The
-// second return value is true if a valid Retry-After value was found.
-func getRetryAfter(resp *http.Response) (time.Duration, bool) {
+// RetryAfter obtains the timeout from the Retry-After header if set. Both the
+// delay-seconds and the HTTP-date form are supported. The second return value
+// is true if a valid Retry-After value was found.
+func RetryAfter(resp *http.Response) (time.Duration, bool) {
 	if resp == nil || resp.Header == nil {
 		return 0, false
 	}
@@ -182,5 +299,15 @@ func getRetryAfter(resp *http.Response) (time.Duration, bool) {
 		return timeout, true
 	}
 
+	date, err := http.ParseTime(retryAfter)
+	if err == nil {
+		timeout := date.Sub(now())
+		if timeout < 0 {
+			timeout = 0
+		}
+
+		return timeout, true
+	}
+
 	return 0, false
 }
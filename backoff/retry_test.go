@@ -3,7 +3,11 @@ package backoff
 import (
 	"context"
 	"errors"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -152,6 +156,21 @@ func TestDefaultBackoff(t *testing.T) {
 			expected:   4 * time.Second,
 			resp:       withRetryAfter(newResponse(429), ""),
 		},
+		{
+			name:     "use Retry-After HTTP-date",
+			min:      1 * time.Second,
+			max:      30 * time.Second,
+			expected: 10 * time.Second,
+			resp:     withRetryAfter(newResponse(429), "Wed, 21 Oct 2015 07:28:10 GMT"),
+		},
+		{
+			name:       "Retry-After HTTP-date in the past falls back to exponential backoff",
+			min:        1 * time.Second,
+			max:        30 * time.Second,
+			attemptNum: 1,
+			expected:   2 * time.Second,
+			resp:       withRetryAfter(newResponse(429), "Wed, 21 Oct 2015 07:27:00 GMT"),
+		},
 		{
 			name:       "Zero Retry-After falls back to exponential backoff",
 			min:        1 * time.Second,
@@ -162,6 +181,8 @@ func TestDefaultBackoff(t *testing.T) {
 		},
 	}
 
+	defer setNow(time.Date(2015, 10, 21, 7, 28, 0, 0, time.UTC))()
+
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			backoff := DefaultBackoff(test.min, test.max, test.attemptNum, test.resp)
@@ -185,3 +206,87 @@ func withRetryAfter(resp *http.Response, val string) *http.Response {
 
 	return resp
 }
+
+func TestErrorHandlerWrapsError(t *testing.T) {
+	cause := errors.New("whoops")
+
+	_, err := errorHandler(nil, cause, 3)
+
+	require.Error(t, err)
+	assert.Equal(t, "giving up after 3 attempts due to: whoops", err.Error())
+	assert.True(t, errors.Is(err, cause))
+}
+
+func TestErrorCodeRetryPolicy(t *testing.T) {
+	checkRetry := ErrorCodeRetryPolicy([]int{1007}, DefaultRetryPolicy)
+
+	resp := withBody(newResponse(400), `{"error_code":1007,"message":"too many requests"}`)
+
+	retry, err := checkRetry(context.Background(), resp, nil)
+	require.NoError(t, err)
+	assert.True(t, retry)
+
+	// The body must still be readable afterwards.
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"error_code":1007,"message":"too many requests"}`, string(body))
+
+	retry, err = checkRetry(context.Background(), withBody(newResponse(400), `{"error_code":1102,"message":"invalid"}`), nil)
+	require.NoError(t, err)
+	assert.False(t, retry)
+
+	retry, err = checkRetry(context.Background(), withBody(newResponse(400), `not json`), nil)
+	require.NoError(t, err)
+	assert.False(t, retry)
+
+	retry, err = checkRetry(context.Background(), newResponse(503), nil)
+	require.NoError(t, err)
+	assert.True(t, retry)
+}
+
+func TestWithRetriesReportsAttempts(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error_code":1007,"message":"too many requests"}`))
+	}))
+	defer server.Close()
+
+	client := WithRetries(server.Client(), &RetryConfig{
+		MinWait:             time.Millisecond,
+		MaxWait:             time.Millisecond,
+		MaxRetries:          2,
+		RetryableErrorCodes: []int{1007},
+	})
+
+	req, err := http.NewRequest("GET", server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+
+	var retryErr *RetryError
+	require.True(t, errors.As(err, &retryErr))
+	assert.Equal(t, 3, retryErr.Attempts)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+
+	// The last response is handed out so that it can be decoded.
+	require.NotNil(t, resp)
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "too many requests")
+}
+
+func withBody(resp *http.Response, body string) *http.Response {
+	resp.Body = ioutil.NopCloser(strings.NewReader(body))
+	return resp
+}
+
+func setNow(t time.Time) func() {
+	orig := now
+	now = func() time.Time { return t }
+
+	return func() {
+		now = orig
+	}
+}
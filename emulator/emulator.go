@@ -0,0 +1,630 @@
+// Package emulator implements a stateful in-memory emulation of the Site24x7
+// API and its OAuth token endpoint. It allows running the provider and the API
+// client against a local server in tests, without Site24x7 credentials or
+// network access.
+//
+// The emulator supports create, get, update, delete and list for every
+// endpoint in api/endpoints, wraps responses in the api.Response envelope and
+// reports errors as api.ErrorResponse. It only validates what is needed to
+// exercise the error handling of the client: mandatory monitor fields,
+// duplicate display names and references to profiles and groups. Every MSP
+// customer, identified by the zaaid cookie, has its own set of entities.
+package emulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+)
+
+// Default OAuth credentials accepted by the emulator if none are configured.
+const (
+	DefaultClientID     = "1000.EMULATORCLIENTID"
+	DefaultClientSecret = "emulator-client-secret"
+	DefaultRefreshToken = "1000.emulator-refresh-token"
+)
+
+// Config configures the emulator.
+type Config struct {
+	// ClientID, ClientSecret and RefreshToken are the OAuth credentials the
+	// token endpoint accepts. They default to DefaultClientID,
+	// DefaultClientSecret and DefaultRefreshToken.
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+
+	// APIDomain is the api_domain returned alongside access tokens. Defaults
+	// to the URL of the server.
+	APIDomain string
+}
+
+// Server is a running Site24x7 API emulator.
+type Server struct {
+	*httptest.Server
+
+	config Config
+
+	mu       sync.Mutex
+	lastID   int64
+	tokens   map[string]bool
+	accounts map[string]*account
+}
+
+// New starts an emulator. It has to be closed with Close after use.
+func New(config Config) *Server {
+	if config.ClientID == "" {
+		config.ClientID = DefaultClientID
+	}
+	if config.ClientSecret == "" {
+		config.ClientSecret = DefaultClientSecret
+	}
+	if config.RefreshToken == "" {
+		config.RefreshToken = DefaultRefreshToken
+	}
+
+	s := &Server{
+		config:   config,
+		lastID:   100000000000000,
+		tokens:   make(map[string]bool),
+		accounts: make(map[string]*account),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/v2/token", s.handleToken)
+	mux.HandleFunc("/api/", s.handleAPI)
+
+	s.Server = httptest.NewServer(mux)
+	if s.config.APIDomain == "" {
+		s.config.APIDomain = s.URL
+	}
+
+	return s
+}
+
+// APIBaseURL returns the API base URL of the emulator, to be used as
+// site24x7.Config.APIBaseURL.
+func (s *Server) APIBaseURL() string {
+	return s.URL + "/api"
+}
+
+// TokenURL returns the URL of the OAuth token endpoint of the emulator, to be
+// used as site24x7.Config.TokenURL.
+func (s *Server) TokenURL() string {
+	return s.URL + "/oauth/v2/token"
+}
+
+// RevokeTokens invalidates all access tokens issued so far.
+func (s *Server) RevokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens = make(map[string]bool)
+}
+
+// SetMonitorStatus sets the status the current_status endpoints report for a
+// monitor of the account with the given zaaid ("" for the main account).
+// Monitors are reported as up until their status is set, and as suspended
+// while they are suspended.
+func (s *Server) SetMonitorStatus(zaaid, monitorID string, status api.Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.account(zaaid).statuses[monitorID] = status
+}
+
+// Entity returns a copy of the entity with the given ID from a collection,
+// e.g. "monitors", of the account with the given zaaid.
+func (s *Server) Entity(zaaid, collection, id string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entity, ok := s.account(zaaid).entities[collection][id]
+	if !ok {
+		return nil, false
+	}
+
+	return copyObject(entity), true
+}
+
+// Entities returns copies of all entities of a collection of the account with
+// the given zaaid, ordered by creation time.
+func (s *Server) Entities(zaaid, collection string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []map[string]interface{}
+	for _, entity := range s.account(zaaid).list(collection) {
+		result = append(result, copyObject(entity))
+	}
+
+	return result
+}
+
+// Seed adds an entity to a collection of the account with the given zaaid
+// without validating it and returns its ID. The ID is generated unless the
+// entity already has one.
+func (s *Server) Seed(zaaid, collection string, entity map[string]interface{}) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := collections[collection]
+	if !ok {
+		panic(fmt.Sprintf("emulator: unknown collection %q", collection))
+	}
+
+	entity = copyObject(entity)
+	id, _ := entity[c.idKey].(string)
+	if id == "" {
+		id = s.nextID()
+		entity[c.idKey] = id
+	}
+	s.account(zaaid).entities[collection][id] = entity
+
+	return id
+}
+
+// account returns the account with the given zaaid, creating it if it does
+// not exist yet. s.mu has to be held.
+func (s *Server) account(zaaid string) *account {
+	a, ok := s.accounts[zaaid]
+	if !ok {
+		a = newAccount()
+		a.seed(s.nextID)
+		s.accounts[zaaid] = a
+	}
+
+	return a
+}
+
+// nextID returns a new unique entity ID. s.mu has to be held.
+func (s *Server) nextID() string {
+	s.lastID++
+	return strconv.FormatInt(s.lastID, 10)
+}
+
+// tokenResponse mirrors the response of the Zoho accounts token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token,omitempty"`
+	ExpiresIn   int    `json:"expires_in,omitempty"`
+	APIDomain   string `json:"api_domain,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.Form.Get("client_id"), r.Form.Get("client_secret")
+	}
+
+	// Like the Zoho accounts server, errors are reported with status 200.
+	var resp tokenResponse
+	switch {
+	case clientID != s.config.ClientID || clientSecret != s.config.ClientSecret:
+		resp.Error = "invalid_client"
+	case r.Form.Get("grant_type") != "refresh_token" || r.Form.Get("refresh_token") != s.config.RefreshToken:
+		resp.Error = "invalid_code"
+	default:
+		s.mu.Lock()
+		resp.AccessToken = "1000.emulator." + s.nextID()
+		s.tokens[resp.AccessToken] = true
+		s.mu.Unlock()
+
+		resp.ExpiresIn = 3600
+		resp.APIDomain = s.config.APIDomain
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// apiError is an error response of the API. Only error codes documented in
+// api/errors are reported; errors without a documented code carry none.
+type apiError struct {
+	status  int
+	code    int
+	message string
+}
+
+func notFound(path string) *apiError {
+	return &apiError{
+		status:  http.StatusNotFound,
+		message: fmt.Sprintf("Resource %s does not exist", path),
+	}
+}
+
+func (s *Server) handleAPI(w http.ResponseWriter, r *http.Request) {
+	if !s.authorized(r) {
+		writeError(w, &apiError{
+			status:  http.StatusUnauthorized,
+			message: "Invalid OAuth token",
+		})
+		return
+	}
+
+	var body object
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		decoder := json.NewDecoder(r.Body)
+		decoder.UseNumber()
+		if err := decoder.Decode(&body); err != nil && err.Error() != "EOF" {
+			writeError(w, &apiError{
+				status:  http.StatusBadRequest,
+				code:    apierrors.CodeInvalidPayload,
+				message: "Invalid JSON payload: " + err.Error(),
+			})
+			return
+		}
+	}
+
+	zaaid := ""
+	if cookie, err := r.Cookie("zaaid"); err == nil {
+		zaaid = cookie.Value
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/"), "/")
+
+	s.mu.Lock()
+	data, apiErr := s.route(s.account(zaaid), r, path, body)
+	s.mu.Unlock()
+
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+
+	writeData(w, data)
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	header := r.Header.Get("Authorization")
+	for _, prefix := range []string{"Zoho-oauthtoken ", "Bearer "} {
+		if strings.HasPrefix(header, prefix) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			return s.tokens[strings.TrimPrefix(header, prefix)]
+		}
+	}
+
+	return false
+}
+
+// route dispatches an API request. s.mu has to be held.
+func (s *Server) route(a *account, r *http.Request, path string, body object) (interface{}, *apiError) {
+	method := r.Method
+
+	switch {
+	case path == "current_status" && method == http.MethodGet:
+		return a.currentStatuses(func(object) bool { return true }, r.URL.Query().Get("suspended_required") != "false"), nil
+	case strings.HasPrefix(path, "current_status/type/") && method == http.MethodGet:
+		monitorType := strings.TrimPrefix(path, "current_status/type/")
+		return a.currentStatuses(func(monitor object) bool {
+			return monitor["type"] == monitorType
+		}, true), nil
+	case strings.HasPrefix(path, "current_status/group/") && method == http.MethodGet:
+		groupID := strings.TrimPrefix(path, "current_status/group/")
+		if _, ok := a.entities["monitor_groups"][groupID]; !ok {
+			return nil, notFound(path)
+		}
+		return a.currentStatuses(func(monitor object) bool {
+			return containsString(monitor["monitor_groups"], groupID)
+		}, true), nil
+	case strings.HasPrefix(path, "current_status/") && method == http.MethodGet:
+		monitor, ok := a.entities["monitors"][strings.TrimPrefix(path, "current_status/")]
+		if !ok {
+			return nil, notFound(path)
+		}
+		return a.currentStatus(monitor), nil
+
+	case strings.HasPrefix(path, "monitors/activate/") && method == http.MethodPut:
+		return a.setState("monitors", strings.TrimPrefix(path, "monitors/activate/"), "state", 0)
+	case strings.HasPrefix(path, "monitors/suspend/") && method == http.MethodPut:
+		return a.setState("monitors", strings.TrimPrefix(path, "monitors/suspend/"), "state", 5)
+	case strings.HasPrefix(path, "monitors/steps/") && method == http.MethodGet:
+		monitor, ok := a.entities["monitors"][strings.TrimPrefix(path, "monitors/steps/")]
+		if !ok {
+			return nil, notFound(path)
+		}
+		if steps, ok := monitor["steps"]; ok {
+			return steps, nil
+		}
+		return []interface{}{}, nil
+
+	case path == "location_template" && method == http.MethodGet:
+		return locationTemplate, nil
+	case path == "device_key" && method == http.MethodGet:
+		return object{"device_key": "us_emulatordevicekey"}, nil
+	case path == "aws/external_id" && method == http.MethodGet:
+		return object{"external_id": "emulator-external-id"}, nil
+
+	case path == "short/msp/customers" && method == http.MethodGet:
+		var customers []object
+		for _, customer := range a.list("msp/customers") {
+			customers = append(customers, object{
+				"name":    customer["display_name"],
+				"zaaid":   customer["zaaid"],
+				"user_id": customer["user_id"],
+			})
+		}
+		return nonNil(customers), nil
+	case path == "credential_profiles" && method == http.MethodGet:
+		return a.list("credential_profile"), nil
+	case path == "third_party_services" && method == http.MethodGet:
+		var services []object
+		for _, name := range integrationCollections {
+			services = append(services, a.list(name)...)
+		}
+		return nonNil(services), nil
+	case strings.HasPrefix(path, "integration/thirdparty_service/"):
+		return a.thirdPartyService(method, strings.TrimPrefix(path, "integration/thirdparty_service/"))
+	}
+
+	if _, ok := collections[path]; ok {
+		switch method {
+		case http.MethodGet:
+			return a.list(path), nil
+		case http.MethodPost:
+			return s.create(a, path, body)
+		}
+		return nil, notFound(path)
+	}
+
+	if i := strings.LastIndex(path, "/"); i > 0 {
+		name, id := path[:i], path[i+1:]
+		if _, ok := collections[name]; ok {
+			switch method {
+			case http.MethodGet:
+				if entity, ok := a.entities[name][id]; ok {
+					return entity, nil
+				}
+			case http.MethodPut:
+				return a.update(name, id, body)
+			case http.MethodDelete:
+				if _, ok := a.entities[name][id]; ok {
+					delete(a.entities[name], id)
+					delete(a.statuses, id)
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, notFound(path)
+}
+
+// create adds an entity to a collection. s.mu has to be held.
+func (s *Server) create(a *account, name string, body object) (interface{}, *apiError) {
+	if err := a.validate(name, "", body); err != nil {
+		return nil, err
+	}
+
+	c := collections[name]
+	entity := copyObject(body)
+	id := s.nextID()
+	entity[c.idKey] = id
+
+	if name == "monitors" {
+		entity["state"] = 0
+	}
+	if c.integrationType != 0 {
+		entity["type"] = c.integrationType
+	}
+	if name == "msp/customers" {
+		// Every customer is a separate account.
+		entity["zaaid"] = s.nextID()
+		s.account(entity["zaaid"].(string))
+	}
+
+	a.entities[name][id] = entity
+
+	return entity, nil
+}
+
+func (a *account) update(name, id string, body object) (interface{}, *apiError) {
+	entity, ok := a.entities[name][id]
+	if !ok {
+		return nil, notFound(name + "/" + id)
+	}
+
+	updated := copyObject(entity)
+	for k, v := range body {
+		updated[k] = v
+	}
+	updated[collections[name].idKey] = id
+
+	if err := a.validate(name, id, updated); err != nil {
+		return nil, err
+	}
+
+	a.entities[name][id] = updated
+
+	return updated, nil
+}
+
+// validate checks an entity that is about to be stored with the given ID (""
+// for new entities).
+func (a *account) validate(name, id string, entity object) *apiError {
+	if name == "monitors" {
+		for _, key := range []string{"display_name", "type"} {
+			if s, _ := entity[key].(string); s == "" {
+				return &apiError{
+					status:  http.StatusBadRequest,
+					code:    apierrors.CodeMissingParameter,
+					message: fmt.Sprintf("Mandatory parameter %s is missing", key),
+				}
+			}
+		}
+
+		if err := a.checkReferences(entity); err != nil {
+			return err
+		}
+	}
+
+	if nameKey := collections[name].nameKey; nameKey != "" {
+		if displayName, ok := entity[nameKey].(string); ok {
+			if otherID, ok := a.findName(name, displayName); ok && otherID != id {
+				return &apiError{
+					status:  http.StatusBadRequest,
+					code:    apierrors.CodeDuplicateName,
+					message: fmt.Sprintf("The name %s already exists", displayName),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *account) setState(name, id, key string, value int) (interface{}, *apiError) {
+	entity, ok := a.entities[name][id]
+	if !ok {
+		return nil, notFound(name + "/" + id)
+	}
+	entity[key] = value
+
+	return nil, nil
+}
+
+// thirdPartyService handles the integration/thirdparty_service endpoints,
+// which delete, activate and suspend integrations of any kind.
+func (a *account) thirdPartyService(method, path string) (interface{}, *apiError) {
+	action, id := "", path
+	if i := strings.Index(path, "/"); i >= 0 {
+		action, id = path[:i], path[i+1:]
+	}
+
+	for _, name := range integrationCollections {
+		if _, ok := a.entities[name][id]; !ok {
+			continue
+		}
+
+		switch {
+		case action == "" && method == http.MethodDelete:
+			delete(a.entities[name], id)
+			return nil, nil
+		case action == "activate" && method == http.MethodPut:
+			return a.setState(name, id, "service_status", 1)
+		case action == "suspend" && method == http.MethodPut:
+			return a.setState(name, id, "service_status", 0)
+		}
+	}
+
+	return nil, notFound("integration/thirdparty_service/" + path)
+}
+
+func (a *account) currentStatuses(include func(object) bool, includeSuspended bool) *api.MonitorsStatus {
+	statuses := &api.MonitorsStatus{Monitors: []*api.MonitorStatus{}}
+	for _, monitor := range a.list("monitors") {
+		if !include(monitor) {
+			continue
+		}
+
+		status := a.currentStatus(monitor)
+		if status.Status == api.Suspended && !includeSuspended {
+			continue
+		}
+
+		statuses.Monitors = append(statuses.Monitors, status)
+	}
+
+	return statuses
+}
+
+func (a *account) currentStatus(monitor object) *api.MonitorStatus {
+	id, _ := monitor["monitor_id"].(string)
+	name, _ := monitor["display_name"].(string)
+	monitorType, _ := monitor["type"].(string)
+
+	status, ok := a.statuses[id]
+	if !ok {
+		status = api.Up
+	}
+	if fmt.Sprint(monitor["state"]) == "5" {
+		status = api.Suspended
+	}
+
+	return &api.MonitorStatus{
+		Name:        name,
+		MonitorID:   id,
+		MonitorType: monitorType,
+		Status:      status,
+	}
+}
+
+func writeData(w http.ResponseWriter, data interface{}) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		writeError(w, &apiError{status: http.StatusInternalServerError, message: err.Error()})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	json.NewEncoder(w).Encode(&api.Response{
+		Code:    0,
+		Message: "success",
+		Data:    raw,
+	})
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(err.status)
+	json.NewEncoder(w).Encode(&api.ErrorResponse{
+		ErrorCode: err.code,
+		Message:   err.message,
+	})
+}
+
+func copyObject(o object) object {
+	c := make(object, len(o))
+	for k, v := range o {
+		c[k] = v
+	}
+
+	return c
+}
+
+func containsString(v interface{}, s string) bool {
+	values, _ := v.([]interface{})
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func nonNil(objects []object) []object {
+	if objects == nil {
+		return []object{}
+	}
+
+	return objects
+}
+
+// locationTemplate is the response of the location_template endpoint.
+var locationTemplate = &api.LocationTemplate{
+	Locations: []*api.Location{
+		{LocationID: "20", DisplayName: "Chicago - US", CityName: "Chicago", CityShort: "CHI", CountryName: "United States", Continent: "North America"},
+		{LocationID: "48", DisplayName: "London - UK", CityName: "London", CityShort: "LON", CountryName: "United Kingdom", Continent: "Europe"},
+		{LocationID: "73", DisplayName: "Mumbai - IN", CityName: "Mumbai", CityShort: "MUM", CountryName: "India", Continent: "Asia"},
+		{LocationID: "3", DisplayName: "Dallas - US", CityName: "Dallas", CityShort: "DAL", CountryName: "United States", Continent: "North America"},
+		{LocationID: "58", DisplayName: "Frankfurt - DE", CityName: "Frankfurt", CityShort: "FRA", CountryName: "Germany", Continent: "Europe"},
+	},
+}
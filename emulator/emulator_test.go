@@ -0,0 +1,195 @@
+package emulator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+	"github.com/site24x7/terraform-provider-site24x7/backoff"
+	"github.com/site24x7/terraform-provider-site24x7/oauth"
+	"github.com/site24x7/terraform-provider-site24x7/site24x7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(s *Server, zaaid string) site24x7.Client {
+	return site24x7.New(testConfig(s, zaaid))
+}
+
+func testConfig(s *Server, zaaid string) site24x7.Config {
+	return site24x7.Config{
+		ClientID:     DefaultClientID,
+		ClientSecret: DefaultClientSecret,
+		RefreshToken: DefaultRefreshToken,
+		ZAAID:        zaaid,
+		APIBaseURL:   s.APIBaseURL(),
+		TokenURL:     s.TokenURL(),
+		RetryConfig: &backoff.RetryConfig{
+			MinWait:    time.Millisecond,
+			MaxWait:    time.Millisecond,
+			MaxRetries: 1,
+		},
+	}
+}
+
+func TestMonitorLifecycle(t *testing.T) {
+	s := New(Config{})
+	defer s.Close()
+
+	c := newClient(s, "")
+
+	locationProfiles, err := c.LocationProfiles().List()
+	require.NoError(t, err)
+	require.Len(t, locationProfiles, 1)
+
+	notificationProfiles, err := c.NotificationProfiles().List()
+	require.NoError(t, err)
+	require.Len(t, notificationProfiles, 1)
+
+	monitor, err := c.WebsiteMonitors().Create(&api.WebsiteMonitor{
+		DisplayName:           "foo",
+		Type:                  string(api.URL),
+		Website:               "https://example.com",
+		LocationProfileID:     locationProfiles[0].ProfileID,
+		NotificationProfileID: notificationProfiles[0].ProfileID,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, monitor.MonitorID)
+
+	monitor.DisplayName = "bar"
+	_, err = c.WebsiteMonitors().Update(monitor)
+	require.NoError(t, err)
+
+	monitor, err = c.WebsiteMonitors().Get(monitor.MonitorID)
+	require.NoError(t, err)
+	assert.Equal(t, "bar", monitor.DisplayName)
+	assert.Equal(t, "https://example.com", monitor.Website)
+
+	status, err := c.CurrentStatus().Get(monitor.MonitorID)
+	require.NoError(t, err)
+	assert.Equal(t, api.Up, status.Status)
+
+	s.SetMonitorStatus("", monitor.MonitorID, api.Down)
+	status, err = c.CurrentStatus().Get(monitor.MonitorID)
+	require.NoError(t, err)
+	assert.Equal(t, api.Down, status.Status)
+
+	require.NoError(t, c.WebsiteMonitors().Suspend(monitor.MonitorID))
+	statuses, err := c.CurrentStatus().ListType(string(api.URL))
+	require.NoError(t, err)
+	require.Len(t, statuses.Monitors, 1)
+	assert.Equal(t, api.Suspended, statuses.Monitors[0].Status)
+
+	require.NoError(t, c.WebsiteMonitors().Delete(monitor.MonitorID))
+
+	_, err = c.WebsiteMonitors().Get(monitor.MonitorID)
+	assert.True(t, errors.Is(err, apierrors.ErrNotFound), "got %v", err)
+
+	monitors, err := c.WebsiteMonitors().List()
+	require.NoError(t, err)
+	assert.Empty(t, monitors)
+}
+
+func TestErrors(t *testing.T) {
+	s := New(Config{})
+	defer s.Close()
+
+	c := newClient(s, "")
+
+	_, err := c.Tags().Create(&api.Tag{TagName: "foo", TagValue: "bar"})
+	require.NoError(t, err)
+
+	_, err = c.Tags().Create(&api.Tag{TagName: "foo", TagValue: "baz"})
+	assert.True(t, errors.Is(err, apierrors.ErrDuplicateName), "got %v", err)
+
+	_, err = c.WebsiteMonitors().Create(&api.WebsiteMonitor{
+		DisplayName:       "foo",
+		Type:              string(api.URL),
+		LocationProfileID: "123",
+	})
+	assert.True(t, errors.Is(err, apierrors.ErrValidation), "got %v", err)
+
+	_, err = c.WebsiteMonitors().Create(&api.WebsiteMonitor{Type: string(api.URL)})
+	assert.True(t, errors.Is(err, apierrors.ErrValidation), "got %v", err)
+
+	_, err = c.MonitorGroups().Get("123")
+	assert.True(t, errors.Is(err, apierrors.ErrNotFound), "got %v", err)
+}
+
+func TestTokens(t *testing.T) {
+	s := New(Config{})
+	defer s.Close()
+
+	c := newClient(s, "")
+
+	_, err := c.Tags().List()
+	require.NoError(t, err)
+
+	// Revoked access tokens are replaced transparently.
+	s.RevokeTokens()
+	_, err = c.Tags().List()
+	require.NoError(t, err)
+
+	config := testConfig(s, "")
+	config.ClientSecret = "wrong"
+	c = site24x7.New(config)
+
+	_, err = c.Tags().List()
+	var tokenErr *oauth.TokenError
+	require.True(t, errors.As(err, &tokenErr), "got %v", err)
+	assert.Equal(t, "invalid_client", tokenErr.Code)
+}
+
+func TestCustomerAccounts(t *testing.T) {
+	s := New(Config{})
+	defer s.Close()
+
+	c := newClient(s, "")
+
+	customer, err := c.Customers().Create(&api.Customer{DisplayName: "customer"})
+	require.NoError(t, err)
+	require.NotEmpty(t, customer.Zaaid)
+
+	customers, err := c.MSP().List()
+	require.NoError(t, err)
+	require.Len(t, customers, 1)
+	assert.Equal(t, customer.Zaaid, customers[0].ZAAID)
+	assert.Equal(t, "customer", customers[0].Name)
+
+	_, err = site24x7.WithZAAID(customer.Zaaid, c).Tags().Create(&api.Tag{TagName: "foo"})
+	require.NoError(t, err)
+
+	tags, err := c.Tags().List()
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	tags, err = newClient(s, customer.Zaaid).Tags().List()
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "foo", tags[0].TagName)
+
+	assert.Len(t, s.Entities(customer.Zaaid, "tags"), 1)
+}
+
+func TestSeed(t *testing.T) {
+	s := New(Config{})
+	defer s.Close()
+
+	id := s.Seed("", "monitor_groups", map[string]interface{}{"display_name": "group"})
+
+	c := newClient(s, "")
+
+	group, err := c.MonitorGroups().Get(id)
+	require.NoError(t, err)
+	assert.Equal(t, "group", group.DisplayName)
+
+	group.Description = "description"
+	_, err = c.MonitorGroups().Update(group)
+	require.NoError(t, err)
+
+	entity, ok := s.Entity("", "monitor_groups", id)
+	require.True(t, ok)
+	assert.Equal(t, "description", entity["description"])
+}
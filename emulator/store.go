@@ -0,0 +1,228 @@
+package emulator
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+)
+
+// collection describes an API resource collection, e.g. monitors, which
+// supports create, get, update, delete and list.
+type collection struct {
+	// idKey is the JSON key of the ID field of the collection's entities.
+	idKey string
+
+	// nameKey is the JSON key of the display name of the collection's
+	// entities. If set, names have to be unique within the collection.
+	nameKey string
+
+	// integrationType is the type of the third party integrations in the
+	// collection as returned by the list endpoint. It is 0 if the type is not
+	// known, in which case integrations are listed without it.
+	integrationType int
+}
+
+// collections maps the API resource paths of all endpoints in api/endpoints
+// to their collections.
+var collections = map[string]collection{
+	"monitors":                {idKey: "monitor_id", nameKey: "display_name"},
+	"monitor_groups":          {idKey: "group_id", nameKey: "display_name"},
+	"subgroups":               {idKey: "group_id"},
+	"tags":                    {idKey: "tag_id", nameKey: "tag_name"},
+	"location_profiles":       {idKey: "profile_id", nameKey: "profile_name"},
+	"notification_profiles":   {idKey: "profile_id", nameKey: "profile_name"},
+	"threshold_profiles":      {idKey: "profile_id", nameKey: "profile_name"},
+	"user_groups":             {idKey: "user_group_id", nameKey: "display_name"},
+	"users":                   {idKey: "user_id"},
+	"it_automation":           {idKey: "action_id"},
+	"maintenance":             {idKey: "maintenance_id"},
+	"scheduled_reports":       {idKey: "report_id"},
+	"business_hours":          {idKey: "business_hours_id"},
+	"credential_profile":      {idKey: "credential_profile_id"},
+	"oauth2_providers":        {idKey: "provider_id"},
+	"msp/customers":           {idKey: "user_id"},
+	"integration/webhooks":    {idKey: "service_id"},
+	"integration/slack":       {idKey: "service_id", integrationType: 5},
+	"integration/opsgenie":    {idKey: "service_id", integrationType: 10},
+	"integration/pager_duty":  {idKey: "service_id"},
+	"integration/service_now": {idKey: "service_id"},
+	"integration/connectwise": {idKey: "service_id", integrationType: 14},
+	"integration/telegram":    {idKey: "service_id", integrationType: 21},
+}
+
+// integrationCollections are the collections that are listed, deleted,
+// activated and suspended through the third party services endpoints.
+var integrationCollections = []string{
+	"integration/webhooks",
+	"integration/slack",
+	"integration/opsgenie",
+	"integration/pager_duty",
+	"integration/service_now",
+	"integration/connectwise",
+	"integration/telegram",
+}
+
+// references are the fields of monitors that have to reference existing
+// entities. Dangling references are reported as invalid parameter values.
+var references = []struct {
+	key        string
+	collection string
+}{
+	{key: "location_profile_id", collection: "location_profiles"},
+	{key: "notification_profile_id", collection: "notification_profiles"},
+	{key: "threshold_profile_id", collection: "threshold_profiles"},
+	{key: "monitor_groups", collection: "monitor_groups"},
+	{key: "user_group_ids", collection: "user_groups"},
+}
+
+// object is an entity as it is sent over the wire.
+type object = map[string]interface{}
+
+// account holds the entities of a Site24x7 account. Every MSP customer has
+// its own account.
+type account struct {
+	entities map[string]map[string]object
+
+	// statuses holds the current status of monitors that was set with
+	// SetMonitorStatus.
+	statuses map[string]api.Status
+}
+
+func newAccount() *account {
+	a := &account{
+		entities: make(map[string]map[string]object),
+		statuses: make(map[string]api.Status),
+	}
+	for name := range collections {
+		a.entities[name] = make(map[string]object)
+	}
+
+	return a
+}
+
+// list returns the entities of the collection ordered by ID, i.e. by creation
+// time.
+func (a *account) list(name string) []object {
+	entities := a.entities[name]
+
+	ids := make([]string, 0, len(entities))
+	for id := range entities {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return lessID(ids[i], ids[j])
+	})
+
+	result := make([]object, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, entities[id])
+	}
+
+	return result
+}
+
+// findName returns the ID of the entity of the collection with the given
+// display name, if any.
+func (a *account) findName(name, displayName string) (string, bool) {
+	c := collections[name]
+	if c.nameKey == "" {
+		return "", false
+	}
+
+	for id, entity := range a.entities[name] {
+		if entity[c.nameKey] == displayName {
+			return id, true
+		}
+	}
+
+	return "", false
+}
+
+// checkReferences validates the references of a monitor to other entities.
+func (a *account) checkReferences(monitor object) *apiError {
+	for _, ref := range references {
+		var ids []string
+		switch v := monitor[ref.key].(type) {
+		case string:
+			ids = []string{v}
+		case []interface{}:
+			for _, id := range v {
+				if s, ok := id.(string); ok {
+					ids = append(ids, s)
+				}
+			}
+		}
+
+		for _, id := range ids {
+			if id == "" {
+				continue
+			}
+			if _, ok := a.entities[ref.collection][id]; !ok {
+				return &apiError{
+					status:  http.StatusBadRequest,
+					code:    apierrors.CodeInvalidParameter,
+					message: fmt.Sprintf("Invalid %s %s", ref.key, id),
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// seed adds the configuration profiles that every new Site24x7 account
+// comes with.
+func (a *account) seed(nextID func() string) {
+	add := func(name string, entity object) {
+		id := nextID()
+		entity[collections[name].idKey] = id
+		a.entities[name][id] = entity
+	}
+
+	add("location_profiles", object{
+		"profile_name":        "Default Location Profile",
+		"primary_location":    "20",
+		"secondary_locations": []interface{}{"48", "73"},
+		"restrict_alt_loc":    false,
+	})
+	add("notification_profiles", object{
+		"profile_name":                   "Default Notification",
+		"rca_needed":                     true,
+		"notify_after_executing_actions": true,
+	})
+	add("user_groups", object{
+		"display_name":       "Admin Group",
+		"users":              []interface{}{},
+		"attribute_group_id": "",
+		"product_id":         0,
+	})
+
+	for _, monitorType := range []api.MonitorType{
+		api.URL, api.HOMEPAGE, api.SSL_CERT, api.RESTAPI, api.RESTAPISEQ,
+		api.AMAZON, api.SERVER, api.CRON, api.HEARTBEAT, api.DNS,
+		api.DOMAINEXPIRY, api.REALBROWSER, api.FTP, api.ISP, api.PORT,
+		api.PING, api.SOAP, api.GCP, api.AZURE,
+	} {
+		add("threshold_profiles", object{
+			"profile_name":            "Default Threshold - " + string(monitorType),
+			"type":                    string(monitorType),
+			"profile_type":            1,
+			"down_location_threshold": 1,
+		})
+	}
+}
+
+// lessID orders numeric IDs numerically and all other IDs lexically.
+func lessID(a, b string) bool {
+	x, errA := strconv.ParseInt(a, 10, 64)
+	y, errB := strconv.ParseInt(b, 10, 64)
+	if errA == nil && errB == nil {
+		return x < y
+	}
+
+	return a < b
+}
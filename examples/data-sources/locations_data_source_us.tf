@@ -0,0 +1,74 @@
+terraform {
+  # Require Terraform version 0.15.x (recommended)
+  required_version = "~> 0.15.0"
+
+  required_providers {
+    site24x7 = {
+      source  = "site24x7/site24x7"
+      # Update the latest version from https://registry.terraform.io/providers/site24x7/site24x7/latest 
+      
+    }
+  }
+}
+
+// Authentication API doc - https://www.site24x7.com/help/api/#authentication
+provider "site24x7" {
+  // (Security recommendation - It is always best practice to store your credentials in a Vault of your choice.)
+	// (Required) The client ID will be looked up in the SITE24X7_OAUTH2_CLIENT_ID
+	// environment variable if the attribute is empty or omitted.
+	oauth2_client_id = "<SITE24X7_OAUTH2_CLIENT_ID>"
+
+  // (Security recommendation - It is always best practice to store your credentials in a Vault of your choice.)
+	// (Required) The client secret will be looked up in the SITE24X7_OAUTH2_CLIENT_SECRET
+	// environment variable if the attribute is empty or omitted.
+	oauth2_client_secret = "<SITE24X7_OAUTH2_CLIENT_SECRET>"
+    
+  // (Security recommendation - It is always best practice to store your credentials in a Vault of your choice.)
+	// (Required) The refresh token will be looked up in the SITE24X7_OAUTH2_REFRESH_TOKEN
+	// environment variable if the attribute is empty or omitted.
+	oauth2_refresh_token = "<SITE24X7_OAUTH2_REFRESH_TOKEN>"
+  
+	// (Required) Specify the data center from which you have obtained your
+	// OAuth client credentials and refresh token. It can be (US/EU/IN/AU/CN/JP/CA).
+	data_center = "US"
+	
+	// (Optional) ZAAID of the customer under a MSP or BU
+	zaaid = "1234"
+  
+	// (Optional) The minimum time to wait in seconds before retrying failed Site24x7 API requests.
+	retry_min_wait = 1
+  
+	// (Optional) The maximum time to wait in seconds before retrying failed Site24x7 API
+	// requests. This is the upper limit for the wait duration with exponential
+	// backoff.
+	retry_max_wait = 30
+  
+	// (Optional) Maximum number of Site24x7 API request retries to perform until giving up.
+	max_retries = 4
+  
+}
+
+// Data source to fetch the European monitoring locations supporting IPv6
+data "site24x7_locations" "europe_ipv6" {
+  // (Optional) Continent of the locations.
+  continent = "Europe"
+  // (Optional) Country of the locations.
+  // country_name = "Germany"
+  // (Optional) Short name of the city of the location.
+  // city_short = "FRA"
+  // (Optional) If true, only locations supporting IPv6 are returned.
+  use_ipv6 = true
+}
+
+// Location profile monitoring from all European IPv6 locations
+resource "site24x7_location_profile" "europe_ipv6" {
+  profile_name        = "Europe IPv6"
+  primary_location    = data.site24x7_locations.europe_ipv6.ids[0]
+  secondary_locations = slice(data.site24x7_locations.europe_ipv6.ids, 1, length(data.site24x7_locations.europe_ipv6.ids))
+}
+
+// Displays the names of the matching locations
+output "s247_location_names" {
+  description = "Location names : "
+  value       = data.site24x7_locations.europe_ipv6.names
+}
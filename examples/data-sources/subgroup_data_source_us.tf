@@ -50,9 +50,14 @@ provider "site24x7" {
 
 // Data source to fetch a Subgroup
 data "site24x7_subgroup" "s247subgroup" {
-  // (Required) Regular expression denoting the name of the monitor group.
-  name_regex = "a"
-  
+  // (Required) Regular expression denoting the name of the subgroup.
+  name_regex = "^Databases$"
+
+  // (Optional) ID of the monitor group whose business view contains the subgroup.
+  top_group_id = "123456000000025005"
+
+  // (Optional) ID of the parent group of the subgroup.
+  // parent_group_id = "123456000000025007"
 }
 
 // Displays the Subgroup ID
@@ -102,3 +107,21 @@ output "s247_subgroup_type" {
   description = "Subgroup Type : "
   value       = data.site24x7_subgroup.s247subgroup.group_type
 }
+
+// Displays the parent groups up to the top monitor group
+output "s247_subgroup_parents" {
+  description = "Parent Groups : "
+  value       = data.site24x7_subgroup.s247subgroup.parents
+}
+
+// Displays the child subgroups
+output "s247_subgroup_child_subgroups" {
+  description = "Child Subgroups : "
+  value       = data.site24x7_subgroup.s247subgroup.child_subgroups
+}
+
+// Displays the monitors of the subgroup and its nested subgroups
+output "s247_subgroup_all_monitors" {
+  description = "All Monitors : "
+  value       = data.site24x7_subgroup.s247subgroup.all_monitors
+}
@@ -1,12 +1,15 @@
 package fake
 
 import (
+	"sync"
+
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/aws"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/common"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/fake"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/integration"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
+	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/msp"
 )
 
 // Client is an implementation of site24x7.Client that stubs out all endpoints
@@ -56,6 +59,15 @@ type Client struct {
 	FakeBusinesshour                  *fake.BusinessHour
 	FakeCustomer                      *fake.Customer
 	FakeAWSExternalID                 *fake.AWSExternalID
+	FakeGCPMonitors                   *fake.GCPMonitors
+	FakeDeviceKey                     *fake.DeviceKey
+	FakeOAuth2Provider                *fake.OAuth2Provider
+
+	// MSPCustomers holds the fake clients that serve requests sent on behalf
+	// of MSP customers, keyed by zaaid. See Customer.
+	MSPCustomers map[string]*Client
+
+	mu sync.Mutex
 }
 
 // NewClient creates a new fake site24x7 API client.
@@ -105,7 +117,33 @@ func NewClient() *Client {
 		FakeBusinesshour:                  &fake.BusinessHour{},
 		FakeCustomer:                      &fake.Customer{},
 		FakeAWSExternalID:                 &fake.AWSExternalID{},
+		FakeGCPMonitors:                   &fake.GCPMonitors{},
+		FakeDeviceKey:                     &fake.DeviceKey{},
+		FakeOAuth2Provider:                &fake.OAuth2Provider{},
+		MSPCustomers:                      make(map[string]*Client),
+	}
+}
+
+// Customer returns the fake client that serves requests sent on behalf of the
+// MSP customer with zaaid, creating it if necessary. Expectations for calls
+// that are routed to a customer have to be set on its client.
+func (c *Client) Customer(zaaid string) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	customer, ok := c.MSPCustomers[zaaid]
+	if !ok {
+		customer = NewClient()
+		c.MSPCustomers[zaaid] = customer
 	}
+
+	return customer
+}
+
+// WithZAAID returns the fake client of the MSP customer with zaaid. It allows
+// site24x7.WithZAAID to route calls to per-customer fakes.
+func (c *Client) WithZAAID(zaaid string) interface{} {
+	return c.Customer(zaaid)
 }
 
 // CurrentStatus implements Client.
@@ -174,7 +212,7 @@ func (c *Client) SOAPMonitors() monitors.SOAPMonitors {
 }
 
 // WebTransactionBrowserMonitors implements Client.
-func (c *Client) WebTransactionBrowserMonitor() monitors.WebTransactionBrowserMonitors {
+func (c *Client) WebTransactionBrowserMonitors() monitors.WebTransactionBrowserMonitors {
 	return c.FakeWebTransactionBrowserMonitors
 }
 
@@ -311,8 +349,8 @@ func (c *Client) MSP() endpoints.MSP {
 	return c.FakeMSP
 }
 
-// RestApiMonitors implements Client.
-func (c *Client) credentialProfile() common.CredentialProfile {
+// CredentialProfile implements Client.
+func (c *Client) CredentialProfile() common.CredentialProfile {
 	return c.FakeCredentialProfile
 }
 
@@ -320,3 +358,23 @@ func (c *Client) credentialProfile() common.CredentialProfile {
 func (c *Client) BusinessHour() common.BusinessHourService {
 	return c.FakeBusinesshour
 }
+
+// GCPMonitors implements Client.
+func (c *Client) GCPMonitors() monitors.GCPMonitors {
+	return c.FakeGCPMonitors
+}
+
+// DeviceKey implements Client.
+func (c *Client) DeviceKey() common.DeviceKey {
+	return c.FakeDeviceKey
+}
+
+// Customers implements Client.
+func (c *Client) Customers() msp.Customers {
+	return c.FakeCustomer
+}
+
+// OAuth2Provider implements Client.
+func (c *Client) OAuth2Provider() common.OAuth2Provider {
+	return c.FakeOAuth2Provider
+}
@@ -0,0 +1,327 @@
+// Package faultinject implements an HTTP client and transport that inject
+// failures into requests, e.g. latency, connection resets, truncated bodies,
+// throttling, server errors and expired access tokens. It is used to test the
+// resilience of the OAuth, retry and request layers of the API client.
+//
+// An *Injector can wrap an http.RoundTripper, which is how it is plugged into
+// site24x7.Config.Transport to fail requests underneath the OAuth and retry
+// logic, or an HTTPClient, to fail requests on top of it.
+package faultinject
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Kind is the kind of failure a Fault injects.
+type Kind int
+
+const (
+	// Latency delays requests by Fault.Delay. Unlike the other kinds it
+	// can be combined with further faults for the same request.
+	Latency Kind = iota
+
+	// ConnectionReset fails requests with a connection reset error without
+	// sending them.
+	ConnectionReset
+
+	// TruncatedBody sends requests, but cuts off the response body halfway
+	// with io.ErrUnexpectedEOF.
+	TruncatedBody
+
+	// Throttle replies with 429 Too Many Requests and the Retry-After
+	// header set to Fault.RetryAfter.
+	Throttle
+
+	// ServerError replies with Fault.StatusCode, 503 Service Unavailable by
+	// default.
+	ServerError
+
+	// TokenExpiry replies with 401 Unauthorized, as the API does for
+	// expired or revoked access tokens.
+	TokenExpiry
+)
+
+// String implements fmt.Stringer.
+func (k Kind) String() string {
+	switch k {
+	case Latency:
+		return "latency"
+	case ConnectionReset:
+		return "connection reset"
+	case TruncatedBody:
+		return "truncated body"
+	case Throttle:
+		return "throttle"
+	case ServerError:
+		return "server error"
+	case TokenExpiry:
+		return "token expiry"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Fault describes a failure and the requests it is injected into.
+type Fault struct {
+	// Kind is the kind of failure.
+	Kind Kind
+
+	// Match selects the requests the fault applies to. If nil, the fault
+	// applies to API requests, i.e. all requests except those to the OAuth
+	// token endpoint.
+	Match func(req *http.Request) bool
+
+	// Every makes the fault intermittent by only injecting it into every
+	// n-th matching request. Zero and one inject it into every matching
+	// request.
+	Every int
+
+	// Count is the maximum number of requests the fault is injected into.
+	// Zero means unlimited.
+	Count int
+
+	// Delay is the latency added by Latency faults.
+	Delay time.Duration
+
+	// StatusCode is the status code of ServerError faults. Defaults to 503.
+	StatusCode int
+
+	// RetryAfter is the value of the Retry-After header of Throttle
+	// faults. The header is omitted if empty.
+	RetryAfter string
+}
+
+// APIRequests matches all requests except those to the OAuth token endpoint.
+func APIRequests(req *http.Request) bool {
+	return !TokenRequests(req)
+}
+
+// TokenRequests matches requests to the OAuth token endpoint.
+func TokenRequests(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, "/oauth/v2/token")
+}
+
+// HTTPClient is the interface of an http client that is compatible with
+// *http.Client.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Injector injects faults into requests. It implements both http.RoundTripper
+// and HTTPClient.
+type Injector struct {
+	send func(req *http.Request) (*http.Response, error)
+
+	mu       sync.Mutex
+	faults   []*faultState
+	requests int
+}
+
+type faultState struct {
+	Fault
+
+	matched  int
+	injected int
+}
+
+// NewTransport creates an *Injector that sends requests through base, or
+// http.DefaultTransport if base is nil.
+func NewTransport(base http.RoundTripper, faults ...Fault) *Injector {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return newInjector(base.RoundTrip, faults)
+}
+
+// NewClient creates an *Injector that sends requests through client.
+func NewClient(client HTTPClient, faults ...Fault) *Injector {
+	return newInjector(client.Do, faults)
+}
+
+func newInjector(send func(*http.Request) (*http.Response, error), faults []Fault) *Injector {
+	i := &Injector{send: send}
+	for _, fault := range faults {
+		i.faults = append(i.faults, &faultState{Fault: fault})
+	}
+
+	return i
+}
+
+// RoundTrip implements http.RoundTripper.
+func (i *Injector) RoundTrip(req *http.Request) (*http.Response, error) {
+	return i.Do(req)
+}
+
+// Do implements HTTPClient.
+func (i *Injector) Do(req *http.Request) (*http.Response, error) {
+	faults := i.faultsFor(req)
+
+	for _, fault := range faults {
+		if fault.Kind != Latency {
+			continue
+		}
+
+		if err := sleep(req.Context(), fault.Delay); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, fault := range faults {
+		switch fault.Kind {
+		case ConnectionReset:
+			return nil, &net.OpError{
+				Op:  "read",
+				Net: "tcp",
+				Err: os.NewSyscallError("read", syscall.ECONNRESET),
+			}
+		case Throttle:
+			resp := response(req, http.StatusTooManyRequests, 0, "Too many requests")
+			if fault.RetryAfter != "" {
+				resp.Header.Set("Retry-After", fault.RetryAfter)
+			}
+			return resp, nil
+		case ServerError:
+			statusCode := fault.StatusCode
+			if statusCode == 0 {
+				statusCode = http.StatusServiceUnavailable
+			}
+			return response(req, statusCode, 0, http.StatusText(statusCode)), nil
+		case TokenExpiry:
+			return response(req, http.StatusUnauthorized, 0, "Invalid OAuth token"), nil
+		case TruncatedBody:
+			resp, err := i.send(req)
+			if err != nil {
+				return nil, err
+			}
+			return truncate(resp)
+		}
+	}
+
+	return i.send(req)
+}
+
+// Requests returns the number of requests sent through i.
+func (i *Injector) Requests() int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.requests
+}
+
+// Injected returns the number of times a fault of the given kind was
+// injected.
+func (i *Injector) Injected(kind Kind) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	injected := 0
+	for _, fault := range i.faults {
+		if fault.Kind == kind {
+			injected += fault.injected
+		}
+	}
+
+	return injected
+}
+
+// faultsFor returns the faults to inject into req.
+func (i *Injector) faultsFor(req *http.Request) []Fault {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.requests++
+
+	var faults []Fault
+	for _, fault := range i.faults {
+		match := fault.Match
+		if match == nil {
+			match = APIRequests
+		}
+		if !match(req) {
+			continue
+		}
+
+		fault.matched++
+		if fault.Every > 1 && fault.matched%fault.Every != 0 {
+			continue
+		}
+		if fault.Count > 0 && fault.injected >= fault.Count {
+			continue
+		}
+
+		fault.injected++
+		faults = append(faults, fault.Fault)
+	}
+
+	return faults
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// response creates a Site24x7 error response.
+func response(req *http.Request, statusCode, errorCode int, message string) *http.Response {
+	body := fmt.Sprintf(`{"error_code":%d,"message":%q}`, errorCode, message)
+
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json;charset=UTF-8"}},
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// truncate replaces the body of resp with its first half followed by
+// io.ErrUnexpectedEOF, like a connection that drops while the body is read.
+func truncate(resp *http.Response) (*http.Response, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(io.MultiReader(
+		bytes.NewReader(body[:len(body)/2]),
+		errReader{io.ErrUnexpectedEOF},
+	))
+
+	return resp, nil
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,248 @@
+package faultinject
+
+import (
+	"context"
+	"errors"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+	"github.com/site24x7/terraform-provider-site24x7/backoff"
+	"github.com/site24x7/terraform-provider-site24x7/emulator"
+	"github.com/site24x7/terraform-provider-site24x7/oauth"
+	"github.com/site24x7/terraform-provider-site24x7/site24x7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The tests in this file run the complete client stack created by
+// site24x7.New against the emulator, with faults injected underneath the
+// OAuth and retry logic.
+
+func newClient(s *emulator.Server, injector *Injector, requestTimeout time.Duration) site24x7.Client {
+	return site24x7.New(site24x7.Config{
+		ClientID:       emulator.DefaultClientID,
+		ClientSecret:   emulator.DefaultClientSecret,
+		RefreshToken:   emulator.DefaultRefreshToken,
+		APIBaseURL:     s.APIBaseURL(),
+		TokenURL:       s.TokenURL(),
+		Transport:      injector,
+		RequestTimeout: requestTimeout,
+		RetryConfig: &backoff.RetryConfig{
+			MinWait:    time.Millisecond,
+			MaxWait:    10 * time.Millisecond,
+			MaxRetries: 4,
+		},
+	})
+}
+
+// exercise sends four API calls that need to succeed or fail as a whole.
+// Unlike profile and tag lookups, current status calls are not cached, so
+// each call sends a request.
+func exercise(c site24x7.Client) error {
+	for i := 0; i < 4; i++ {
+		if _, err := c.CurrentStatus().List(&api.CurrentStatusListOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestRecovery(t *testing.T) {
+	tests := []struct {
+		name          string
+		fault         Fault
+		expectedCount int
+	}{
+		{
+			name:          "latency",
+			fault:         Fault{Kind: Latency, Delay: 5 * time.Millisecond},
+			expectedCount: 4,
+		},
+		{
+			name:          "connection resets",
+			fault:         Fault{Kind: ConnectionReset, Count: 3},
+			expectedCount: 3,
+		},
+		{
+			name:          "throttling storm",
+			fault:         Fault{Kind: Throttle, Count: 4, RetryAfter: "0"},
+			expectedCount: 4,
+		},
+		{
+			name:          "intermittent server errors",
+			fault:         Fault{Kind: ServerError, Every: 2},
+			expectedCount: 3,
+		},
+		{
+			name:          "bad gateway",
+			fault:         Fault{Kind: ServerError, StatusCode: 502, Count: 2},
+			expectedCount: 2,
+		},
+		{
+			name:          "token expiry",
+			fault:         Fault{Kind: TokenExpiry, Count: 1},
+			expectedCount: 1,
+		},
+		{
+			name:          "token endpoint connection reset",
+			fault:         Fault{Kind: ConnectionReset, Match: TokenRequests, Count: 1},
+			expectedCount: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := emulator.New(emulator.Config{})
+			defer s.Close()
+
+			injector := NewTransport(nil, test.fault)
+
+			require.NoError(t, exercise(newClient(s, injector, 0)))
+			assert.Equal(t, test.expectedCount, injector.Injected(test.fault.Kind))
+		})
+	}
+}
+
+func TestRecoveryFromTokenExpiryRefreshesToken(t *testing.T) {
+	s := emulator.New(emulator.Config{})
+	defer s.Close()
+
+	injector := NewTransport(nil, Fault{Kind: TokenExpiry, Count: 1})
+
+	_, err := newClient(s, injector, 0).CurrentStatus().List(&api.CurrentStatusListOptions{})
+	require.NoError(t, err)
+
+	// Token request, rejected API request, token request, API request.
+	assert.Equal(t, 4, injector.Requests())
+}
+
+func TestRecoveryHonorsRetryAfter(t *testing.T) {
+	s := emulator.New(emulator.Config{})
+	defer s.Close()
+
+	injector := NewTransport(nil, Fault{Kind: Throttle, Count: 1, RetryAfter: "1"})
+
+	c := site24x7.New(site24x7.Config{
+		ClientID:     emulator.DefaultClientID,
+		ClientSecret: emulator.DefaultClientSecret,
+		RefreshToken: emulator.DefaultRefreshToken,
+		APIBaseURL:   s.APIBaseURL(),
+		TokenURL:     s.TokenURL(),
+		Transport:    injector,
+		RetryConfig: &backoff.RetryConfig{
+			MinWait:    time.Millisecond,
+			MaxWait:    5 * time.Second,
+			MaxRetries: 1,
+		},
+	})
+
+	start := time.Now()
+	_, err := c.CurrentStatus().List(&api.CurrentStatusListOptions{})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestFailure(t *testing.T) {
+	tests := []struct {
+		name           string
+		fault          Fault
+		requestTimeout time.Duration
+		check          func(t *testing.T, err error)
+	}{
+		{
+			name:           "latency exceeding the request timeout",
+			fault:          Fault{Kind: Latency, Delay: time.Second},
+			requestTimeout: 50 * time.Millisecond,
+			check: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+				assert.Contains(t, err.Error(), "timed out after 50ms")
+			},
+		},
+		{
+			name:  "persistent connection resets",
+			fault: Fault{Kind: ConnectionReset},
+			check: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, syscall.ECONNRESET), "got %v", err)
+				assert.Contains(t, err.Error(), "giving up after 5 attempts")
+				assert.Contains(t, err.Error(), "/api/current_status")
+			},
+		},
+		{
+			name:  "endless throttling",
+			fault: Fault{Kind: Throttle, RetryAfter: "0"},
+			check: func(t *testing.T, err error) {
+				assert.True(t, apierrors.HasStatusCode(err, 429), "got %v", err)
+				assert.Contains(t, err.Error(), "giving up after 5 attempts")
+				assert.Contains(t, err.Error(), "Too many requests")
+			},
+		},
+		{
+			name:  "persistent server errors",
+			fault: Fault{Kind: ServerError},
+			check: func(t *testing.T, err error) {
+				assert.True(t, apierrors.HasStatusCode(err, 503), "got %v", err)
+				assert.Contains(t, err.Error(), "giving up after 5 attempts")
+			},
+		},
+		{
+			name:  "truncated body",
+			fault: Fault{Kind: TruncatedBody},
+			check: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, io.ErrUnexpectedEOF), "got %v", err)
+				assert.Contains(t, err.Error(), "GET")
+				assert.Contains(t, err.Error(), "failed to read response body")
+			},
+		},
+		{
+			name:  "revoked token",
+			fault: Fault{Kind: TokenExpiry},
+			check: func(t *testing.T, err error) {
+				assert.True(t, apierrors.HasStatusCode(err, 401), "got %v", err)
+				assert.Contains(t, err.Error(), "Invalid OAuth token")
+			},
+		},
+		{
+			name:  "unreachable token endpoint",
+			fault: Fault{Kind: ConnectionReset, Match: TokenRequests},
+			check: func(t *testing.T, err error) {
+				assert.True(t, errors.Is(err, syscall.ECONNRESET), "got %v", err)
+				assert.Contains(t, err.Error(), "/oauth/v2/token")
+			},
+		},
+		{
+			name:  "throttled token endpoint",
+			fault: Fault{Kind: Throttle, Match: TokenRequests},
+			check: func(t *testing.T, err error) {
+				assert.Contains(t, err.Error(), "cannot fetch token")
+				assert.Contains(t, err.Error(), "429")
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := emulator.New(emulator.Config{})
+			defer s.Close()
+
+			err := exercise(newClient(s, NewTransport(nil, test.fault), test.requestTimeout))
+			require.Error(t, err)
+			test.check(t, err)
+		})
+	}
+}
+
+func TestFailureWithInvalidCredentials(t *testing.T) {
+	s := emulator.New(emulator.Config{RefreshToken: "1000.other-refresh-token"})
+	defer s.Close()
+
+	err := exercise(newClient(s, NewTransport(nil), 0))
+
+	var tokenErr *oauth.TokenError
+	require.True(t, errors.As(err, &tokenErr), "got %v", err)
+	assert.Equal(t, "invalid_code", tokenErr.Code)
+}
@@ -0,0 +1,53 @@
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var invalidAddressChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// sanitize converts a display name into a valid resource name, e.g.
+// "Example.com - Home" into "example_com_home".
+func sanitize(name string) string {
+	address := invalidAddressChars.ReplaceAllString(strings.ToLower(name), "_")
+	address = strings.Trim(address, "_")
+
+	if address == "" {
+		return "unnamed"
+	}
+	if address[0] >= '0' && address[0] <= '9' {
+		return "_" + address
+	}
+
+	return address
+}
+
+// addressAllocator hands out unique resource names per resource type.
+type addressAllocator struct {
+	used map[string]bool
+}
+
+func newAddressAllocator() *addressAllocator {
+	return &addressAllocator{used: make(map[string]bool)}
+}
+
+// reserve marks an address as used.
+func (a *addressAllocator) reserve(resourceType, address string) {
+	a.used[resourceType+"."+address] = true
+}
+
+// allocate returns the sanitized name, suffixed with a counter if objects of
+// the same type have the same sanitized name.
+func (a *addressAllocator) allocate(resourceType, name string) string {
+	base := sanitize(name)
+
+	address := base
+	for n := 2; a.used[resourceType+"."+address]; n++ {
+		address = fmt.Sprintf("%s_%d", base, n)
+	}
+	a.reserve(resourceType, address)
+
+	return address
+}
@@ -0,0 +1,164 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/site24x7/terraform-provider-site24x7/provider"
+)
+
+const (
+	// ResourcesFile is the name of the file holding the resource blocks.
+	ResourcesFile = "site24x7_resources.tf"
+
+	// ImportsFile is the name of the file holding the import blocks.
+	ImportsFile = "site24x7_imports.tf"
+
+	// MappingFile is the name of the file mapping imported objects to their
+	// resource addresses.
+	MappingFile = "site24x7_import_mapping.json"
+)
+
+const header = "# Generated by terraform-provider-site24x7 import. Review before applying.\n"
+
+const usage = `Usage: terraform-provider-site24x7 import [options]
+
+Generates Terraform configuration and import blocks for the objects of an
+existing Site24x7 account. The provider is configured from the same
+environment variables as in Terraform, e.g. SITE24X7_OAUTH2_CLIENT_ID,
+SITE24X7_OAUTH2_CLIENT_SECRET, SITE24X7_OAUTH2_REFRESH_TOKEN and
+SITE24X7_DATA_CENTER.
+
+The addresses of the imported objects are recorded in a mapping file. When
+the command runs again, objects in the mapping are skipped and the resource
+and import blocks of new objects are appended to the existing files.
+
+Options:
+`
+
+// Main runs the import command with the given arguments and returns its exit
+// code.
+func Main(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	out := flags.String("out", ".", "directory to write "+ResourcesFile+", "+ImportsFile+" and "+MappingFile+" to")
+	types := flags.String("types", "", "comma separated list of resource types to import, e.g. site24x7_website_monitor,site24x7_monitor_group (default all)")
+	force := flags.Bool("force", false, "overwrite existing files and ignore the mapping of previous runs")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	config := Config{Warnings: stderr}
+	if *types != "" {
+		config.ResourceTypes = strings.Split(*types, ",")
+	}
+
+	if err := run(context.Background(), *out, *force, config, stdout); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+func run(ctx context.Context, out string, force bool, config Config, stdout io.Writer) error {
+	resourcesPath := filepath.Join(out, ResourcesFile)
+	importsPath := filepath.Join(out, ImportsFile)
+	mappingPath := filepath.Join(out, MappingFile)
+
+	rerun := false
+	if !force {
+		mapping, err := LoadMapping(mappingPath)
+		switch {
+		case err == nil:
+			config.Mapping = mapping
+			rerun = true
+		case errors.Is(err, fs.ErrNotExist):
+			for _, path := range []string{resourcesPath, importsPath} {
+				if _, err := os.Stat(path); err == nil {
+					return fmt.Errorf("%s already exists, use -force to overwrite it", path)
+				}
+			}
+		default:
+			return err
+		}
+	}
+
+	p := provider.Provider()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		return fmt.Errorf("failed to configure provider: %s", diags[0].Summary)
+	}
+
+	result, err := New(p, p.Meta(), config).Import(ctx)
+	if err != nil {
+		return err
+	}
+
+	if rerun && result.Len() == 0 {
+		fmt.Fprintln(stdout, "No new objects found.")
+		return nil
+	}
+
+	if err := os.MkdirAll(out, 0755); err != nil {
+		return err
+	}
+	if err := writeFile(resourcesPath, rerun, result.WriteResources); err != nil {
+		return err
+	}
+	if err := writeFile(importsPath, rerun, result.WriteImports); err != nil {
+		return err
+	}
+	if err := result.Mapping().Save(mappingPath); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Wrote %d resources to %s and %s.\n", result.Len(), resourcesPath, importsPath)
+
+	return nil
+}
+
+// writeFile writes the generated blocks to path. If appendBlocks is set,
+// they are appended to the existing content of the file.
+func writeFile(path string, appendBlocks bool, write func(io.Writer) error) error {
+	mode := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if appendBlocks {
+		mode = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	}
+
+	f, err := os.OpenFile(path, mode, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := f.Stat()
+	if err == nil {
+		prefix := header + "\n"
+		if info.Size() > 0 {
+			prefix = "\n"
+		}
+		_, err = io.WriteString(f, prefix)
+	}
+	if err == nil {
+		err = write(f)
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
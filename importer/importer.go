@@ -0,0 +1,257 @@
+// Package importer generates Terraform configuration for the objects of an
+// existing Site24x7 account. It lists monitors, profiles, groups, users,
+// integrations and maintenance windows, reads them with the resources of the
+// provider and writes a resource block and an import block for each of them.
+// Attributes holding the ID of another imported object are written as
+// references to that object.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+	"github.com/site24x7/terraform-provider-site24x7/site24x7"
+)
+
+// Config configures an Importer.
+type Config struct {
+	// ResourceTypes restricts the import to the given resource types. All
+	// supported resource types are imported if empty.
+	ResourceTypes []string
+
+	// Mapping holds the objects imported by previous runs. They keep their
+	// addresses and are neither read nor written again. Import adds the
+	// newly found objects to it.
+	Mapping *Mapping
+
+	// Warnings receives messages about objects that are skipped. Discarded
+	// if nil.
+	Warnings io.Writer
+}
+
+// Importer reads the objects of an account with the resources of a provider.
+type Importer struct {
+	resources map[string]*schema.Resource
+	meta      interface{}
+	config    Config
+}
+
+// New creates an Importer reading objects with the resources of p. meta is
+// the configured provider client, i.e. a site24x7.Client.
+func New(p *schema.Provider, meta interface{}, config Config) *Importer {
+	if config.Mapping == nil {
+		config.Mapping = &Mapping{}
+	}
+	if config.Warnings == nil {
+		config.Warnings = io.Discard
+	}
+
+	return &Importer{
+		resources: p.ResourcesMap,
+		meta:      meta,
+		config:    config,
+	}
+}
+
+// object is an object of the account that is imported.
+type object struct {
+	resourceType string
+	id           string
+	name         string
+
+	// integration is the listed third party integration if the object is
+	// one. Its resource type is resolved when it is read.
+	integration *api.ThirdPartyIntegrations
+
+	// address is the name of the resource block in the configuration.
+	address string
+
+	data   *schema.ResourceData
+	schema map[string]*schema.Schema
+}
+
+func newObject(resourceType, id, name string) *object {
+	return &object{resourceType: resourceType, id: id, name: name}
+}
+
+// Result holds the objects found by Import.
+type Result struct {
+	objects []*object
+	mapping *Mapping
+}
+
+// Len returns the number of newly imported objects.
+func (r *Result) Len() int {
+	return len(r.objects)
+}
+
+// Mapping returns the mapping of all objects imported so far, including
+// those of previous runs.
+func (r *Result) Mapping() *Mapping {
+	return r.mapping
+}
+
+// Import lists all objects of the account and reads those that are not part
+// of the mapping yet.
+func (i *Importer) Import(ctx context.Context) (*Result, error) {
+	client := site24x7.WithContext(ctx, i.meta.(site24x7.Client))
+
+	mapping := i.config.Mapping
+	result := &Result{mapping: mapping}
+	addresses := newAddressAllocator()
+
+	known := make(map[string]bool, len(mapping.Objects))
+	for _, m := range mapping.Objects {
+		known[m.ID] = true
+		addresses.reserve(m.ResourceType, m.Address)
+	}
+
+	for _, source := range sources {
+		resourceTypes := i.selected(source.resourceTypes)
+		if len(resourceTypes) == 0 {
+			continue
+		}
+
+		objects, err := source.list(client)
+		if unavailable(err) {
+			fmt.Fprintf(i.config.Warnings, "skipping %s: %v\n", source.resourceTypes[0], err)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", source.resourceTypes[0], err)
+		}
+
+		sort.SliceStable(objects, func(a, b int) bool {
+			return objects[a].name < objects[b].name
+		})
+
+		for _, o := range objects {
+			if known[o.id] || !i.read(ctx, o, resourceTypes) {
+				continue
+			}
+			known[o.id] = true
+
+			o.address = addresses.allocate(o.resourceType, o.name)
+			result.objects = append(result.objects, o)
+			mapping.Objects = append(mapping.Objects, &MappedObject{
+				ResourceType: o.resourceType,
+				ID:           o.id,
+				Name:         o.name,
+				Address:      o.address,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// unavailable reports whether err means that the account cannot use an
+// endpoint, e.g. the MSP endpoints in a regular account.
+func unavailable(err error) bool {
+	return apierrors.HasStatusCode(err, http.StatusBadRequest) ||
+		apierrors.HasStatusCode(err, http.StatusForbidden) ||
+		apierrors.HasStatusCode(err, http.StatusNotFound)
+}
+
+// selected returns those of resourceTypes that are to be imported.
+func (i *Importer) selected(resourceTypes []string) []string {
+	if len(i.config.ResourceTypes) == 0 {
+		return resourceTypes
+	}
+
+	var selected []string
+	for _, resourceType := range resourceTypes {
+		for _, s := range i.config.ResourceTypes {
+			if s == resourceType {
+				selected = append(selected, resourceType)
+				break
+			}
+		}
+	}
+
+	return selected
+}
+
+// read reads o with the resource managing it. It reports whether o was found.
+func (i *Importer) read(ctx context.Context, o *object, resourceTypes []string) bool {
+	if o.integration != nil {
+		resourceType, err := site24x7.IntegrationResourceType(site24x7.WithContext(ctx, i.meta.(site24x7.Client)), o.integration)
+		if err != nil {
+			fmt.Fprintf(i.config.Warnings, "skipping integration %q (%s): %v\n", o.name, o.id, err)
+			return false
+		}
+		if resourceType == "" {
+			fmt.Fprintf(i.config.Warnings, "skipping integration %q (%s): unsupported integration type %d\n", o.name, o.id, o.integration.Type)
+			return false
+		}
+
+		o.resourceType = resourceType
+	}
+
+	if !contains(resourceTypes, o.resourceType) {
+		return false
+	}
+
+	d, err := i.readAs(ctx, o.resourceType, o.id)
+	if err != nil {
+		fmt.Fprintf(i.config.Warnings, "skipping %s %q (%s): %v\n", o.resourceType, o.name, o.id, err)
+		return false
+	}
+	if d == nil {
+		fmt.Fprintf(i.config.Warnings, "skipping %s %q (%s): not found\n", o.resourceType, o.name, o.id)
+		return false
+	}
+
+	o.data = d
+	o.schema = i.resources[o.resourceType].Schema
+	return true
+}
+
+// readAs reads the object with the given ID with the resource of the given
+// type. It returns nil if the object does not exist.
+func (i *Importer) readAs(ctx context.Context, resourceType, id string) (_ *schema.ResourceData, err error) {
+	r, ok := i.resources[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource type %s", resourceType)
+	}
+
+	// A single object the resource cannot handle should not abort the
+	// import of all others.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to read: %v", r)
+		}
+	}()
+
+	d := r.Data(nil)
+	d.SetId(id)
+
+	for _, diagnostic := range r.ReadContext(ctx, d, i.meta) {
+		if diagnostic.Severity == diag.Error {
+			return nil, fmt.Errorf("%s", diagnostic.Summary)
+		}
+	}
+
+	if d.Id() == "" {
+		return nil, nil
+	}
+
+	return d, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,348 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/site24x7/terraform-provider-site24x7/backoff"
+	"github.com/site24x7/terraform-provider-site24x7/emulator"
+	"github.com/site24x7/terraform-provider-site24x7/provider"
+	"github.com/site24x7/terraform-provider-site24x7/site24x7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(s *emulator.Server) site24x7.Client {
+	return site24x7.New(site24x7.Config{
+		ClientID:     emulator.DefaultClientID,
+		ClientSecret: emulator.DefaultClientSecret,
+		RefreshToken: emulator.DefaultRefreshToken,
+		APIBaseURL:   s.APIBaseURL(),
+		TokenURL:     s.TokenURL(),
+		RetryConfig: &backoff.RetryConfig{
+			MinWait:    time.Millisecond,
+			MaxWait:    time.Millisecond,
+			MaxRetries: 1,
+		},
+	})
+}
+
+// generate runs the importer against c and returns the generated resources
+// and import blocks.
+func generate(t *testing.T, c site24x7.Client, config Config) (resources, imports string) {
+	result, err := New(provider.Provider(), c, config).Import(context.Background())
+	require.NoError(t, err)
+
+	var resourcesBuf, importsBuf bytes.Buffer
+	require.NoError(t, result.WriteResources(&resourcesBuf))
+	require.NoError(t, result.WriteImports(&importsBuf))
+
+	for _, src := range []*bytes.Buffer{&resourcesBuf, &importsBuf} {
+		_, diags := hclsyntax.ParseConfig(src.Bytes(), "generated.tf", hcl.InitialPos)
+		require.False(t, diags.HasErrors(), diags.Error())
+	}
+
+	return resourcesBuf.String(), importsBuf.String()
+}
+
+func assertAttribute(t *testing.T, src, name, value string) {
+	t.Helper()
+
+	assert.Regexp(t, `(?m)^\s+`+regexp.QuoteMeta(name)+`\s+= `+regexp.QuoteMeta(value)+`$`, src)
+}
+
+func TestImport(t *testing.T) {
+	s := emulator.New(emulator.Config{})
+	defer s.Close()
+
+	c := newClient(s)
+
+	group, err := c.MonitorGroups().Create(&api.MonitorGroup{DisplayName: "Web Servers"})
+	require.NoError(t, err)
+
+	webhook, err := c.WebhookIntegration().Create(&api.WebhookIntegration{
+		Name:    "Ops Hook",
+		URL:     "https://example.com/hook",
+		Method:  "P",
+		Timeout: 30,
+	})
+	require.NoError(t, err)
+
+	locationProfiles, err := c.LocationProfiles().List()
+	require.NoError(t, err)
+	notificationProfiles, err := c.NotificationProfiles().List()
+	require.NoError(t, err)
+
+	monitor, err := c.WebsiteMonitors().Create(&api.WebsiteMonitor{
+		DisplayName:           "Example.com - Home",
+		Type:                  string(api.URL),
+		Website:               "https://example.com",
+		CheckFrequency:        "5",
+		Timeout:               10,
+		HTTPMethod:            "G",
+		LocationProfileID:     locationProfiles[0].ProfileID,
+		NotificationProfileID: notificationProfiles[0].ProfileID,
+		MonitorGroups:         []string{group.GroupID},
+		ThirdPartyServiceIDs:  []string{webhook.ServiceID},
+	})
+	require.NoError(t, err)
+
+	resources, imports := generate(t, c, Config{})
+
+	assert.Contains(t, resources, `resource "site24x7_website_monitor" "example_com_home" {`)
+	assertAttribute(t, resources, "display_name", `"Example.com - Home"`)
+	assertAttribute(t, resources, "website", `"https://example.com"`)
+	assert.NotContains(t, resources, "auth_method")
+	assert.NotContains(t, resources, `= ""`)
+	assert.Contains(t, resources, "# users is required, but empty in Site24x7 and needs to be set manually.")
+	assertAttribute(t, resources, "monitor_groups", `[site24x7_monitor_group.web_servers.id]`)
+	assertAttribute(t, resources, "third_party_service_ids", `[site24x7_webhook_integration.ops_hook.id]`)
+	assertAttribute(t, resources, "location_profile_id", `site24x7_location_profile.default_location_profile.id`)
+	assertAttribute(t, resources, "notification_profile_id", `site24x7_notification_profile.default_notification.id`)
+	assert.Contains(t, resources, `resource "site24x7_monitor_group" "web_servers" {`)
+	assert.Contains(t, resources, `resource "site24x7_webhook_integration" "ops_hook" {`)
+
+	// Referenced objects come first.
+	assert.Less(t, bytes.Index([]byte(resources), []byte(`"site24x7_monitor_group"`)), bytes.Index([]byte(resources), []byte(`"site24x7_website_monitor"`)))
+
+	assert.Contains(t, imports, "import {\n  to = site24x7_website_monitor.example_com_home\n  id = \""+monitor.MonitorID+"\"\n}")
+	assert.Contains(t, imports, "import {\n  to = site24x7_monitor_group.web_servers\n  id = \""+group.GroupID+"\"\n}")
+}
+
+func TestImportRoundTrip(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("Terraform CLI not found, set TF_ACC_TERRAFORM_PATH or add terraform to the PATH")
+		}
+	}
+
+	s := emulator.New(emulator.Config{})
+	defer s.Close()
+
+	for _, env := range []string{"SITE24X7_DATA_CENTER", "SITE24X7_API_BASE_URL", "SITE24X7_TOKEN_URL"} {
+		t.Setenv(env, "")
+	}
+
+	c := newClient(s)
+
+	// The objects carry all attributes the API returns, like the objects
+	// of a real account. The notification profile seeded by the emulator
+	// lacks some of them and is removed.
+	notificationProfiles, err := c.NotificationProfiles().List()
+	require.NoError(t, err)
+	for _, profile := range notificationProfiles {
+		require.NoError(t, c.NotificationProfiles().Delete(profile.ProfileID))
+	}
+
+	locationProfile, err := c.LocationProfiles().Create(&api.LocationProfile{
+		ProfileName:        "Europe",
+		PrimaryLocation:    "20",
+		SecondaryLocations: []string{"48", "73"},
+	})
+	require.NoError(t, err)
+
+	notificationProfile, err := c.NotificationProfiles().Create(&api.NotificationProfile{
+		ProfileName:        "On Call",
+		RcaNeeded:          true,
+		TemplateID:         "0",
+		SuppressAutomation: true,
+	})
+	require.NoError(t, err)
+
+	group, err := c.MonitorGroups().Create(&api.MonitorGroup{DisplayName: "Web Servers"})
+	require.NoError(t, err)
+
+	_, err = c.WebsiteMonitors().Create(&api.WebsiteMonitor{
+		DisplayName:           "Example.com - Home",
+		Type:                  string(api.URL),
+		Website:               "https://example.com",
+		CheckFrequency:        "5",
+		Timeout:               10,
+		HTTPMethod:            "G",
+		AuthMethod:            "B",
+		UseNameServer:         true,
+		FollowHTTPRedirection: true,
+		SSLProtocol:           "Auto",
+		HTTPProtocol:          "H1.1",
+		LocationProfileID:     locationProfile.ProfileID,
+		NotificationProfileID: notificationProfile.ProfileID,
+		MonitorGroups:         []string{group.GroupID},
+	})
+	require.NoError(t, err)
+
+	resources, imports := generate(t, c, Config{ResourceTypes: []string{
+		"site24x7_location_profile",
+		"site24x7_notification_profile",
+		"site24x7_monitor_group",
+		"site24x7_website_monitor",
+	}})
+
+	assert.NotContains(t, resources, `= ""`)
+	assertAttribute(t, resources, "profile_name", `"Europe"`)
+
+	config := fmt.Sprintf(`
+provider "site24x7" {
+  oauth2_client_id     = %q
+  oauth2_client_secret = %q
+  oauth2_refresh_token = %q
+  api_base_url         = %q
+  token_url            = %q
+}
+`, emulator.DefaultClientID, emulator.DefaultClientSecret, emulator.DefaultRefreshToken, s.APIBaseURL(), s.TokenURL())
+
+	// Planning the generated configuration imports the objects and must not
+	// propose any changes.
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"site24x7": func() (*schema.Provider, error) {
+				return provider.Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:   config + resources + imports,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestImportResourceTypes(t *testing.T) {
+	s := emulator.New(emulator.Config{})
+	defer s.Close()
+
+	c := newClient(s)
+
+	_, err := c.MonitorGroups().Create(&api.MonitorGroup{DisplayName: "Web Servers"})
+	require.NoError(t, err)
+
+	resources, imports := generate(t, c, Config{ResourceTypes: []string{"site24x7_monitor_group"}})
+
+	assert.Contains(t, resources, `resource "site24x7_monitor_group" "web_servers" {`)
+	assert.NotContains(t, resources, "site24x7_location_profile")
+	assert.Equal(t, 1, bytes.Count([]byte(imports), []byte("import {")))
+}
+
+func TestImportMapping(t *testing.T) {
+	s := emulator.New(emulator.Config{})
+	defer s.Close()
+
+	c := newClient(s)
+
+	group, err := c.MonitorGroups().Create(&api.MonitorGroup{DisplayName: "Web Servers"})
+	require.NoError(t, err)
+
+	config := Config{
+		ResourceTypes: []string{"site24x7_monitor_group", "site24x7_subgroup"},
+		Mapping:       &Mapping{},
+	}
+	resources, _ := generate(t, c, config)
+	assert.Contains(t, resources, `resource "site24x7_monitor_group" "web_servers" {`)
+	require.Len(t, config.Mapping.Objects, 1)
+	assert.Equal(t, &MappedObject{
+		ResourceType: "site24x7_monitor_group",
+		ID:           group.GroupID,
+		Name:         "Web Servers",
+		Address:      "web_servers",
+	}, config.Mapping.Objects[0])
+
+	_, err = c.MonitorGroups().Create(&api.MonitorGroup{DisplayName: "Web-Servers"})
+	require.NoError(t, err)
+	_, err = c.Subgroups().Create(&api.Subgroup{DisplayName: "Frontend", TopGroupID: group.GroupID, ParentGroupID: group.GroupID})
+	require.NoError(t, err)
+
+	// Only the new objects are written. They reference known objects by
+	// their recorded address and do not reuse it.
+	resources, imports := generate(t, c, config)
+	assert.NotContains(t, resources, `resource "site24x7_monitor_group" "web_servers" {`)
+	assert.Contains(t, resources, `resource "site24x7_monitor_group" "web_servers_2" {`)
+	assert.Contains(t, resources, `resource "site24x7_subgroup" "frontend" {`)
+	assertAttribute(t, resources, "parent_group_id", "site24x7_monitor_group.web_servers.id")
+	assert.Equal(t, 2, bytes.Count([]byte(imports), []byte("import {")))
+	assert.Len(t, config.Mapping.Objects, 3)
+
+	resources, imports = generate(t, c, config)
+	assert.Empty(t, resources)
+	assert.Empty(t, imports)
+}
+
+func TestMainRerun(t *testing.T) {
+	s := emulator.New(emulator.Config{})
+	defer s.Close()
+
+	t.Setenv("SITE24X7_OAUTH2_CLIENT_ID", emulator.DefaultClientID)
+	t.Setenv("SITE24X7_OAUTH2_CLIENT_SECRET", emulator.DefaultClientSecret)
+	t.Setenv("SITE24X7_OAUTH2_REFRESH_TOKEN", emulator.DefaultRefreshToken)
+	t.Setenv("SITE24X7_API_BASE_URL", s.APIBaseURL())
+	t.Setenv("SITE24X7_TOKEN_URL", s.TokenURL())
+
+	c := newClient(s)
+	_, err := c.Tags().Create(&api.Tag{TagName: "env", TagValue: "prod"})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	args := []string{"-out", dir, "-types", "site24x7_tag"}
+
+	var stdout, stderr bytes.Buffer
+	require.Equal(t, 0, Main(args, &stdout, &stderr), stderr.String())
+	assert.Contains(t, stdout.String(), "Wrote 1 resources")
+
+	_, err = c.Tags().Create(&api.Tag{TagName: "team", TagValue: "ops"})
+	require.NoError(t, err)
+
+	stdout.Reset()
+	require.Equal(t, 0, Main(args, &stdout, &stderr), stderr.String())
+	assert.Contains(t, stdout.String(), "Wrote 1 resources")
+
+	stdout.Reset()
+	require.Equal(t, 0, Main(args, &stdout, &stderr), stderr.String())
+	assert.Contains(t, stdout.String(), "No new objects found.")
+
+	resources, err := os.ReadFile(filepath.Join(dir, ResourcesFile))
+	require.NoError(t, err)
+	assert.Equal(t, 1, bytes.Count(resources, []byte(header)))
+	assert.Contains(t, string(resources), `resource "site24x7_tag" "env_prod" {`)
+	assert.Contains(t, string(resources), `resource "site24x7_tag" "team_ops" {`)
+
+	imports, err := os.ReadFile(filepath.Join(dir, ImportsFile))
+	require.NoError(t, err)
+	assert.Equal(t, 2, bytes.Count(imports, []byte("import {")))
+
+	mapping, err := LoadMapping(filepath.Join(dir, MappingFile))
+	require.NoError(t, err)
+	assert.Len(t, mapping.Objects, 2)
+}
+
+func TestAddressAllocator(t *testing.T) {
+	a := newAddressAllocator()
+
+	assert.Equal(t, "example_com_home", a.allocate("site24x7_website_monitor", "Example.com - Home"))
+	assert.Equal(t, "example_com_home_2", a.allocate("site24x7_website_monitor", "example.com home"))
+	assert.Equal(t, "example_com_home", a.allocate("site24x7_ssl_monitor", "Example.com - Home"))
+	assert.Equal(t, "_24x7", a.allocate("site24x7_monitor_group", "24x7"))
+	assert.Equal(t, "unnamed", a.allocate("site24x7_monitor_group", "äöü"))
+
+	a.reserve("site24x7_tag", "env")
+	assert.Equal(t, "env_2", a.allocate("site24x7_tag", "env"))
+}
+
+func TestMainRefusesToOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ResourcesFile), nil, 0644))
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 1, Main([]string{"-out", dir}, &stdout, &stderr))
+	assert.Contains(t, stderr.String(), "use -force to overwrite it")
+}
@@ -0,0 +1,48 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// Mapping records the addresses assigned to imported objects. It is stored
+// next to the generated configuration, so that re-runs keep the addresses
+// of known objects and only add objects discovered since the last run.
+type Mapping struct {
+	Objects []*MappedObject `json:"objects"`
+}
+
+// MappedObject is an imported object and its resource address.
+type MappedObject struct {
+	ResourceType string `json:"resource_type"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Address      string `json:"address"`
+}
+
+// LoadMapping reads a mapping file written by Save. The returned error
+// satisfies errors.Is(err, fs.ErrNotExist) if the file does not exist.
+func LoadMapping(path string) (*Mapping, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := &Mapping{}
+	if err := json.Unmarshal(buf, mapping); err != nil {
+		return nil, fmt.Errorf("failed to parse mapping file %s: %w", path, err)
+	}
+
+	return mapping, nil
+}
+
+// Save writes the mapping to path.
+func (m *Mapping) Save(path string) error {
+	buf, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(buf, '\n'), 0644)
+}
@@ -0,0 +1,245 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// WriteResources writes a resource block for each imported object.
+func (r *Result) WriteResources(w io.Writer) error {
+	refs := r.references()
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for n, o := range r.objects {
+		if n > 0 {
+			body.AppendNewline()
+		}
+
+		block := body.AppendNewBlock("resource", []string{o.resourceType, o.address})
+		g := &generator{refs: refs, self: o.id}
+		g.writeBody(block.Body(), o.data.Get, o.schema)
+	}
+
+	return write(w, f)
+}
+
+// WriteImports writes an import block for each imported object.
+func (r *Result) WriteImports(w io.Writer) error {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for n, o := range r.objects {
+		if n > 0 {
+			body.AppendNewline()
+		}
+
+		block := body.AppendNewBlock("import", nil)
+		block.Body().SetAttributeTraversal("to", traversal(o.resourceType, o.address))
+		block.Body().SetAttributeValue("id", cty.StringVal(o.id))
+	}
+
+	return write(w, f)
+}
+
+func write(w io.Writer, f *hclwrite.File) error {
+	_, err := w.Write(hclwrite.Format(f.Bytes()))
+	return err
+}
+
+// references maps the IDs of all objects in the mapping to traversals
+// referencing their id attribute.
+func (r *Result) references() map[string]hcl.Traversal {
+	refs := make(map[string]hcl.Traversal, len(r.mapping.Objects))
+	for _, m := range r.mapping.Objects {
+		refs[m.ID] = append(traversal(m.ResourceType, m.Address), hcl.TraverseAttr{Name: "id"})
+	}
+
+	return refs
+}
+
+func traversal(resourceType, address string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: address},
+	}
+}
+
+// generator writes attribute values as HCL.
+type generator struct {
+	refs map[string]hcl.Traversal
+
+	// self is the ID of the object written, which is never replaced by a
+	// reference.
+	self string
+}
+
+// writeBody writes the configurable attributes of s to body. Empty attributes
+// and attributes set to their default or zero value are omitted. Required
+// attributes that are empty are replaced by a comment, as they have to be set
+// manually.
+func (g *generator) writeBody(body *hclwrite.Body, get func(string) interface{}, s map[string]*schema.Schema) {
+	var required, optional, blocks []string
+	for key, attr := range s {
+		switch {
+		case !attr.Required && !attr.Optional:
+			// Computed only.
+		case attr.Deprecated != "":
+		case isBlock(attr):
+			blocks = append(blocks, key)
+		case attr.Required:
+			required = append(required, key)
+		default:
+			optional = append(optional, key)
+		}
+	}
+	sort.Strings(required)
+	sort.Strings(optional)
+	sort.Strings(blocks)
+
+	for _, key := range append(required, optional...) {
+		attr := s[key]
+		value := get(key)
+
+		if isEmpty(value) {
+			if attr.Required {
+				body.AppendUnstructuredTokens(comment(fmt.Sprintf("# %s is required, but empty in Site24x7 and needs to be set manually.\n", key)))
+			}
+			continue
+		}
+
+		if !attr.Required && isDefault(attr, value) {
+			continue
+		}
+
+		if attr.Sensitive {
+			body.AppendUnstructuredTokens(comment(fmt.Sprintf("# %s is sensitive and needs to be set manually.\n", key)))
+			continue
+		}
+
+		body.SetAttributeRaw(key, g.tokens(value))
+	}
+
+	for _, key := range blocks {
+		resource := s[key].Elem.(*schema.Resource)
+
+		for _, item := range items(get(key)) {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			block := body.AppendNewBlock(key, nil)
+			g.writeBody(block.Body(), func(k string) interface{} { return m[k] }, resource.Schema)
+		}
+	}
+}
+
+// tokens returns the HCL tokens for value.
+func (g *generator) tokens(value interface{}) hclwrite.Tokens {
+	switch v := value.(type) {
+	case string:
+		if ref, ok := g.refs[v]; ok && v != g.self {
+			return hclwrite.TokensForTraversal(ref)
+		}
+		return hclwrite.TokensForValue(cty.StringVal(v))
+	case int:
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v)))
+	case float64:
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v))
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	case []interface{}, *schema.Set:
+		var elems []hclwrite.Tokens
+		for _, item := range items(v) {
+			elems = append(elems, g.tokens(item))
+		}
+		return hclwrite.TokensForTuple(elems)
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		attrs := make([]hclwrite.ObjectAttrTokens, 0, len(keys))
+		for _, key := range keys {
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: g.tokens(v[key]),
+			})
+		}
+		return hclwrite.TokensForObject(attrs)
+	default:
+		return hclwrite.TokensForValue(cty.StringVal(fmt.Sprint(v)))
+	}
+}
+
+// items returns the elements of a list or set value. Sets are sorted, so
+// that the output is stable.
+func items(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		list := v.List()
+		sort.SliceStable(list, func(a, b int) bool {
+			return fmt.Sprint(list[a]) < fmt.Sprint(list[b])
+		})
+		return list
+	default:
+		return nil
+	}
+}
+
+func isBlock(s *schema.Schema) bool {
+	if s.Type != schema.TypeList && s.Type != schema.TypeSet {
+		return false
+	}
+
+	_, ok := s.Elem.(*schema.Resource)
+	return ok
+}
+
+// isEmpty reports whether value is unknown, an empty string or an empty
+// collection. Writing such values would override the defaults of the
+// resource.
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// isDefault reports whether value is the default value of s, or the zero
+// value if s has no default.
+func isDefault(s *schema.Schema, value interface{}) bool {
+	if s.Default != nil {
+		return fmt.Sprint(value) == fmt.Sprint(s.Default)
+	}
+
+	return reflect.ValueOf(value).IsZero()
+}
+
+func comment(text string) hclwrite.Tokens {
+	return hclwrite.Tokens{{Type: hclsyntax.TokenComment, Bytes: []byte(text)}}
+}
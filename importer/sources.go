@@ -0,0 +1,240 @@
+package importer
+
+import (
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/site24x7/terraform-provider-site24x7/site24x7"
+)
+
+// monitorResourceTypes maps monitor types to the resources managing them.
+var monitorResourceTypes = map[string]string{
+	string(api.URL):          "site24x7_website_monitor",
+	string(api.HOMEPAGE):     "site24x7_web_page_speed_monitor",
+	string(api.SSL_CERT):     "site24x7_ssl_monitor",
+	string(api.RESTAPI):      "site24x7_rest_api_monitor",
+	string(api.RESTAPISEQ):   "site24x7_rest_api_transaction_monitor",
+	string(api.SERVER):       "site24x7_server_monitor",
+	string(api.CRON):         "site24x7_cron_monitor",
+	string(api.HEARTBEAT):    "site24x7_heartbeat_monitor",
+	string(api.DNS):          "site24x7_dns_server_monitor",
+	string(api.DOMAINEXPIRY): "site24x7_domain_expiry_monitor",
+	string(api.REALBROWSER):  "site24x7_web_transaction_browser_monitor",
+	string(api.ISP):          "site24x7_isp_monitor",
+	string(api.FTP):          "site24x7_ftp_transfer_monitor",
+	string(api.PORT):         "site24x7_port_monitor",
+	string(api.PING):         "site24x7_ping_monitor",
+	string(api.SOAP):         "site24x7_soap_monitor",
+	string(api.AMAZON):       "site24x7_amazon_monitor",
+	string(api.GCP):          "site24x7_gcp_monitor",
+	string(api.AZURE):        "site24x7_azure_monitor",
+}
+
+// source lists the objects of an account that are managed by a set of
+// resource types.
+type source struct {
+	resourceTypes []string
+	list          func(c site24x7.Client) ([]*object, error)
+}
+
+// sources are ordered so that referenced objects come before the objects
+// referencing them in the generated configuration.
+var sources = []source{
+	{
+		resourceTypes: []string{"site24x7_customer"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			customers, err := c.Customers().List()
+			return collect(customers, err, func(customer *api.Customer) *object {
+				return newObject("site24x7_customer", customer.UserID, customer.DisplayName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_tag"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			tags, err := c.Tags().List()
+			return collect(tags, err, func(t *api.Tag) *object {
+				name := t.TagName
+				if t.TagValue != "" {
+					name += " " + t.TagValue
+				}
+				return newObject("site24x7_tag", t.TagID, name)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_credential_profile"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			profiles, err := c.CredentialProfile().ListWebCredentials()
+			return collect(profiles, err, func(p *api.CredentialProfile) *object {
+				return newObject("site24x7_credential_profile", p.ID, p.CredentialName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_oauth2_provider"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			providers, err := c.OAuth2Provider().List()
+			return collect(providers, err, func(p *api.OAuth2Provider) *object {
+				return newObject("site24x7_oauth2_provider", p.ProviderID, p.ProviderName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_businesshour"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			businessHours, err := c.BusinessHour().List()
+			return collect(businessHours, err, func(b *api.BusinessHour) *object {
+				return newObject("site24x7_businesshour", b.ID, b.DisplayName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_location_profile"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			profiles, err := c.LocationProfiles().List()
+			return collect(profiles, err, func(p *api.LocationProfile) *object {
+				return newObject("site24x7_location_profile", p.ProfileID, p.ProfileName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_notification_profile"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			profiles, err := c.NotificationProfiles().List()
+			return collect(profiles, err, func(p *api.NotificationProfile) *object {
+				return newObject("site24x7_notification_profile", p.ProfileID, p.ProfileName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_threshold_profile"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			profiles, err := c.ThresholdProfiles().List()
+			return collect(profiles, err, func(p *api.ThresholdProfile) *object {
+				return newObject("site24x7_threshold_profile", p.ProfileID, p.ProfileName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_user"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			users, err := c.Users().List()
+			return collect(users, err, func(u *api.User) *object {
+				return newObject("site24x7_user", u.ID, u.DisplayName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_user_group"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			groups, err := c.UserGroups().List()
+			return collect(groups, err, func(g *api.UserGroup) *object {
+				return newObject("site24x7_user_group", g.UserGroupID, g.DisplayName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_monitor_group"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			groups, err := c.MonitorGroups().List()
+			return collect(groups, err, func(g *api.MonitorGroup) *object {
+				return newObject("site24x7_monitor_group", g.GroupID, g.DisplayName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_subgroup"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			subgroups, err := c.Subgroups().List()
+			return collect(subgroups, err, func(g *api.Subgroup) *object {
+				return newObject("site24x7_subgroup", g.ID, g.DisplayName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_url_action"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			actions, err := c.URLActions().List()
+			return collect(actions, err, func(a *api.URLAction) *object {
+				return newObject("site24x7_url_action", a.ActionID, a.ActionName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{
+			"site24x7_webhook_integration",
+			"site24x7_slack_integration",
+			"site24x7_opsgenie_integration",
+			"site24x7_pagerduty_integration",
+			"site24x7_servicenow_integration",
+			"site24x7_connectwise_integration",
+			"site24x7_telegram_integration",
+		},
+		list: func(c site24x7.Client) ([]*object, error) {
+			integrations, err := c.ThirdPartyIntegrations().List()
+			return collect(integrations, err, func(i *api.ThirdPartyIntegrations) *object {
+				o := newObject("", i.ServiceID, i.Name)
+				o.integration = i
+				return o
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_schedule_maintenance"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			maintenances, err := c.ScheduleMaintenance().List()
+			return collect(maintenances, err, func(m *api.ScheduleMaintenance) *object {
+				return newObject("site24x7_schedule_maintenance", m.MaintenanceID, m.DisplayName)
+			})
+		},
+	},
+	{
+		resourceTypes: []string{"site24x7_schedule_report"},
+		list: func(c site24x7.Client) ([]*object, error) {
+			reports, err := c.ScheduleReport().List()
+			return collect(reports, err, func(r *api.ScheduleReport) *object {
+				return newObject("site24x7_schedule_report", r.ReportID, r.DisplayName)
+			})
+		},
+	},
+	{
+		resourceTypes: monitorResourceTypeList(),
+		list: func(c site24x7.Client) ([]*object, error) {
+			// The list endpoint of any monitor type returns monitors of all
+			// types.
+			monitors, err := c.WebsiteMonitors().List()
+			return collect(monitors, err, func(m *api.WebsiteMonitor) *object {
+				resourceType, ok := monitorResourceTypes[m.Type]
+				if !ok {
+					return nil
+				}
+				return newObject(resourceType, m.MonitorID, m.DisplayName)
+			})
+		},
+	},
+}
+
+func monitorResourceTypeList() []string {
+	resourceTypes := make([]string, 0, len(monitorResourceTypes))
+	for _, resourceType := range monitorResourceTypes {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+
+	return resourceTypes
+}
+
+// collect converts the result of a list call into objects. Items for which
+// convert returns nil are skipped.
+func collect[T any](items []T, err error, convert func(T) *object) ([]*object, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*object, 0, len(items))
+	for _, item := range items {
+		if o := convert(item); o != nil {
+			objects = append(objects, o)
+		}
+	}
+
+	return objects, nil
+}
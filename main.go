@@ -1,11 +1,18 @@
 package main
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/plugin"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/site24x7/terraform-provider-site24x7/importer"
 	"github.com/site24x7/terraform-provider-site24x7/provider"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(importer.Main(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: provider.Provider,
 	})
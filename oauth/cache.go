@@ -0,0 +1,278 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// DefaultRefreshBefore is the default duration before the expiry of a cached
+// access token at which it is proactively refreshed.
+const DefaultRefreshBefore = 5 * time.Minute
+
+// TokenCacheConfig configures the on-disk access token cache.
+type TokenCacheConfig struct {
+	// Path is the location of the cache file. It is created if it does not
+	// exist yet.
+	Path string
+
+	// DataCenter is the data center the client credentials belong to. It is
+	// part of the cache key.
+	DataCenter string
+
+	// RefreshBefore is the duration before the expiry of an access token at
+	// which it is considered stale and is refreshed. Defaults to
+	// DefaultRefreshBefore.
+	RefreshBefore time.Duration
+}
+
+// DefaultTokenCachePath returns the default location of the token cache file
+// inside of the user's cache directory.
+func DefaultTokenCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "terraform-provider-site24x7", "oauth-tokens.json"), nil
+}
+
+// cachedToken is the on-disk representation of an access token. The refresh
+// token is deliberately never written to disk.
+type cachedToken struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	Expiry      time.Time `json:"expiry"`
+	APIDomain   string    `json:"api_domain,omitempty"`
+}
+
+// tokenCacheFile is the on-disk format of the token cache.
+type tokenCacheFile struct {
+	Tokens map[string]cachedToken `json:"tokens"`
+}
+
+// cachingTokenSource is an oauth2.TokenSource which shares access tokens
+// across processes via a cache file. Access to the cache file is serialized
+// with a file lock, so that parallel provider runs neither corrupt the file
+// nor mint access tokens concurrently.
+type cachingTokenSource struct {
+	// refresher obtains a new access token from the token endpoint.
+	refresher oauth2.TokenSource
+
+	path          string
+	key           string
+	refreshBefore time.Duration
+
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+// newCachingTokenSource creates a new *cachingTokenSource. The cache key is
+// derived from the client ID, the data center and a fingerprint of the
+// refresh token, so that tokens of different accounts using the same client
+// are never mixed up.
+func newCachingTokenSource(refresher oauth2.TokenSource, config TokenCacheConfig, clientID, refreshToken string, initial *oauth2.Token) *cachingTokenSource {
+	refreshBefore := config.RefreshBefore
+	if refreshBefore <= 0 {
+		refreshBefore = DefaultRefreshBefore
+	}
+
+	fingerprint := sha256.Sum256([]byte(refreshToken))
+
+	return &cachingTokenSource{
+		refresher:     refresher,
+		path:          config.Path,
+		key:           fmt.Sprintf("%s/%s/%s", config.DataCenter, clientID, hex.EncodeToString(fingerprint[:8])),
+		refreshBefore: refreshBefore,
+		token:         initial,
+	}
+}
+
+// Token implements oauth2.TokenSource.
+func (s *cachingTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fresh(s.token) {
+		return s.token, nil
+	}
+
+	unlock, err := lockFile(s.path + ".lock")
+	if err != nil {
+		log.Warnf("[oauth] unable to lock token cache %s, bypassing it: %v", s.path, err)
+		return s.refresh()
+	}
+	defer unlock()
+
+	cache := s.read()
+
+	if cached, ok := cache.Tokens[s.key]; ok {
+		token := (&oauth2.Token{
+			AccessToken: cached.AccessToken,
+			TokenType:   cached.TokenType,
+			Expiry:      cached.Expiry,
+		}).WithExtra(map[string]interface{}{
+			"api_domain": cached.APIDomain,
+		})
+
+		if s.fresh(token) {
+			log.Debugf("[oauth] reusing cached access token valid until %s", token.Expiry)
+			s.token = token
+			return token, nil
+		}
+	}
+
+	token, err := s.refresh()
+	if err != nil {
+		return nil, err
+	}
+
+	apiDomain, _ := token.Extra("api_domain").(string)
+
+	cache.Tokens[s.key] = cachedToken{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		Expiry:      token.Expiry,
+		APIDomain:   apiDomain,
+	}
+
+	if err := s.write(cache); err != nil {
+		log.Warnf("[oauth] unable to write token cache %s: %v", s.path, err)
+	}
+
+	return token, nil
+}
+
+// Invalidate implements InvalidatingTokenSource. It drops the in-memory
+// token and removes it from the cache file if it still equals token.
+func (s *cachingTokenSource) Invalidate(token *oauth2.Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if token == nil || s.token == nil || s.token.AccessToken != token.AccessToken {
+		// Someone else already obtained a new token.
+		return
+	}
+
+	s.token = nil
+
+	unlock, err := lockFile(s.path + ".lock")
+	if err != nil {
+		return
+	}
+	defer unlock()
+
+	cache := s.read()
+	if cached, ok := cache.Tokens[s.key]; ok && cached.AccessToken == token.AccessToken {
+		delete(cache.Tokens, s.key)
+
+		if err := s.write(cache); err != nil {
+			log.Warnf("[oauth] unable to write token cache %s: %v", s.path, err)
+		}
+	}
+}
+
+// fresh returns true if token is present and does not expire within the
+// refresh window.
+func (s *cachingTokenSource) fresh(token *oauth2.Token) bool {
+	if token == nil || token.AccessToken == "" {
+		return false
+	}
+
+	if token.Expiry.IsZero() {
+		return true
+	}
+
+	return time.Until(token.Expiry) > s.refreshBefore
+}
+
+// refresh obtains a new access token and stores it in memory. Must be called
+// with s.mu held.
+func (s *cachingTokenSource) refresh() (*oauth2.Token, error) {
+	token, err := s.refresher.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// See tokenSource for why this is needed.
+	if time.Until(token.Expiry) > maxExpiresIn {
+		token.Expiry = time.Now().Add(maxExpiresIn)
+	}
+
+	s.token = token
+
+	return token, nil
+}
+
+// read loads the cache file. A missing or corrupt file yields an empty
+// cache.
+func (s *cachingTokenSource) read() *tokenCacheFile {
+	cache := &tokenCacheFile{}
+
+	buf, err := ioutil.ReadFile(s.path)
+	if err == nil {
+		if err := json.Unmarshal(buf, cache); err != nil {
+			log.Warnf("[oauth] ignoring corrupt token cache %s: %v", s.path, err)
+		}
+	} else if !os.IsNotExist(err) {
+		log.Warnf("[oauth] unable to read token cache %s: %v", s.path, err)
+	}
+
+	if cache.Tokens == nil {
+		cache.Tokens = make(map[string]cachedToken)
+	}
+
+	// Drop expired entries so the file does not grow indefinitely.
+	for key, token := range cache.Tokens {
+		if !token.Expiry.IsZero() && token.Expiry.Before(time.Now()) {
+			delete(cache.Tokens, key)
+		}
+	}
+
+	return cache
+}
+
+// write atomically replaces the cache file with cache. The file is only
+// readable by the current user as it contains credentials.
+func (s *cachingTokenSource) write(cache *tokenCacheFile) error {
+	buf, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if _, err := tmp.Write(buf); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
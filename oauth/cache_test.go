@@ -0,0 +1,141 @@
+package oauth
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+type countingTokenSource struct {
+	mu     sync.Mutex
+	calls  int
+	expiry time.Duration
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+
+	return &oauth2.Token{
+		AccessToken: fmt.Sprintf("token-%d", s.calls),
+		TokenType:   TokenType,
+		Expiry:      time.Now().Add(s.expiry),
+	}, nil
+}
+
+func newTestCache(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "site24x7-token-cache")
+	require.NoError(t, err)
+
+	return filepath.Join(dir, "tokens.json"), func() { os.RemoveAll(dir) }
+}
+
+func TestCachingTokenSource_sharedAcrossSources(t *testing.T) {
+	path, cleanup := newTestCache(t)
+	defer cleanup()
+
+	refresher := &countingTokenSource{expiry: time.Hour}
+	config := TokenCacheConfig{Path: path, DataCenter: "US"}
+
+	first := newCachingTokenSource(refresher, config, "client", "refresh", nil)
+	token, err := first.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken)
+
+	// a second source, e.g. in another process, picks up the cached token.
+	second := newCachingTokenSource(refresher, config, "client", "refresh", nil)
+	token, err = second.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken)
+	assert.Equal(t, 1, refresher.calls)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	buf, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(buf), "refresh")
+}
+
+func TestCachingTokenSource_keyedByClientAndDataCenter(t *testing.T) {
+	path, cleanup := newTestCache(t)
+	defer cleanup()
+
+	refresher := &countingTokenSource{expiry: time.Hour}
+
+	_, err := newCachingTokenSource(refresher, TokenCacheConfig{Path: path, DataCenter: "US"}, "client", "refresh", nil).Token()
+	require.NoError(t, err)
+
+	token, err := newCachingTokenSource(refresher, TokenCacheConfig{Path: path, DataCenter: "EU"}, "client", "refresh", nil).Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token.AccessToken)
+
+	token, err = newCachingTokenSource(refresher, TokenCacheConfig{Path: path, DataCenter: "US"}, "other-client", "refresh", nil).Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-3", token.AccessToken)
+}
+
+func TestCachingTokenSource_refreshesBeforeExpiry(t *testing.T) {
+	path, cleanup := newTestCache(t)
+	defer cleanup()
+
+	refresher := &countingTokenSource{expiry: 2 * time.Minute}
+	ts := newCachingTokenSource(refresher, TokenCacheConfig{Path: path, DataCenter: "US"}, "client", "refresh", nil)
+
+	_, err := ts.Token()
+	require.NoError(t, err)
+
+	// the token expires within DefaultRefreshBefore and is refreshed
+	// proactively.
+	token, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token.AccessToken)
+}
+
+func TestCachingTokenSource_concurrentProcesses(t *testing.T) {
+	path, cleanup := newTestCache(t)
+	defer cleanup()
+
+	refresher := &countingTokenSource{expiry: time.Hour}
+	config := TokenCacheConfig{Path: path, DataCenter: "US"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ts := newCachingTokenSource(refresher, config, "client", "refresh", nil)
+			token, err := ts.Token()
+			assert.NoError(t, err)
+			assert.Equal(t, "token-1", token.AccessToken)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, refresher.calls)
+}
+
+func TestCachingTokenSource_corruptCache(t *testing.T) {
+	path, cleanup := newTestCache(t)
+	defer cleanup()
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("{not json"), 0600))
+
+	refresher := &countingTokenSource{expiry: time.Hour}
+	ts := newCachingTokenSource(refresher, TokenCacheConfig{Path: path}, "client", "refresh", nil)
+
+	token, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken)
+}
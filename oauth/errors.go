@@ -0,0 +1,174 @@
+package oauth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrInvalidClient is returned if the token endpoint does not know the
+	// client ID or the client secret is wrong. Clients are only known to the
+	// accounts server of the data center they were registered in.
+	ErrInvalidClient = errors.New("invalid_client")
+
+	// ErrInvalidCode is returned if the refresh token is malformed, was
+	// revoked or belongs to a different client or data center.
+	ErrInvalidCode = errors.New("invalid_code")
+
+	// ErrAccessDenied is returned if the token endpoint throttles requests
+	// because too many access tokens were requested in a short time.
+	ErrAccessDenied = errors.New("access denied")
+
+	// ErrWrongRegion is returned if the token endpoint issued an access token
+	// for an API domain that does not belong to the configured data center.
+	ErrWrongRegion = errors.New("wrong region")
+)
+
+// TokenError is the error returned by the Zoho token endpoint. It can be
+// matched against ErrInvalidClient, ErrInvalidCode, ErrAccessDenied and
+// ErrWrongRegion with errors.Is.
+type TokenError struct {
+	// StatusCode is the HTTP status code of the token response.
+	StatusCode int
+
+	// Code is the value of the error field, e.g. invalid_code.
+	Code string
+
+	// Description is the optional human readable error description.
+	Description string
+
+	// TokenURL is the URL of the token endpoint that returned the error.
+	TokenURL string
+
+	// APIDomain is the API domain the access token was issued for. Only set
+	// for wrong-region errors.
+	APIDomain string
+
+	// ExpectedAPIDomain is the API domain of the configured data center.
+	// Only set for wrong-region errors.
+	ExpectedAPIDomain string
+}
+
+// Error implements error.
+func (e *TokenError) Error() string {
+	if errors.Is(e, ErrWrongRegion) {
+		return fmt.Sprintf("token endpoint %s issued an access token for %s, expected %s", e.TokenURL, e.APIDomain, e.ExpectedAPIDomain)
+	}
+
+	msg := fmt.Sprintf("token endpoint %s replied with error %q", e.TokenURL, e.Code)
+	if e.Description != "" {
+		msg += ": " + e.Description
+	}
+
+	return msg
+}
+
+// Is allows matching e against the sentinel errors of this package.
+func (e *TokenError) Is(target error) bool {
+	switch target {
+	case ErrInvalidClient:
+		return e.Code == "invalid_client" || e.Code == "invalid_client_secret"
+	case ErrInvalidCode:
+		return e.Code == "invalid_code"
+	case ErrAccessDenied:
+		return strings.EqualFold(e.Code, "access denied") || strings.EqualFold(e.Code, "access_denied")
+	case ErrWrongRegion:
+		return e.Code == ErrWrongRegion.Error()
+	}
+
+	return false
+}
+
+// tokenResponse contains the fields of a Zoho token response that are
+// relevant for error detection.
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	APIDomain        string `json:"api_domain"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// tokenErrorTransport inspects responses of the Zoho token endpoint and turns
+// error payloads into *TokenError. This is necessary because the endpoint
+// replies with status 200 on most errors, which golang.org/x/oauth2 reports
+// as an opaque "server response missing access_token" error.
+type tokenErrorTransport struct {
+	base http.RoundTripper
+
+	// expectedAPIDomain is the API domain of the configured data center.
+	// Optional.
+	expectedAPIDomain string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tokenErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	tokenURL := req.URL.Scheme + "://" + req.URL.Host + req.URL.Path
+
+	payload := &tokenResponse{}
+	if err := json.Unmarshal(body, payload); err != nil {
+		// Let golang.org/x/oauth2 deal with non-JSON responses.
+		return resp, nil
+	}
+
+	if payload.Error != "" {
+		return nil, &TokenError{
+			StatusCode:  resp.StatusCode,
+			Code:        payload.Error,
+			Description: payload.ErrorDescription,
+			TokenURL:    tokenURL,
+		}
+	}
+
+	if t.expectedAPIDomain != "" && payload.AccessToken != "" && payload.APIDomain != "" &&
+		!SameHost(payload.APIDomain, t.expectedAPIDomain) {
+		return nil, &TokenError{
+			StatusCode:        resp.StatusCode,
+			Code:              ErrWrongRegion.Error(),
+			TokenURL:          tokenURL,
+			APIDomain:         payload.APIDomain,
+			ExpectedAPIDomain: t.expectedAPIDomain,
+		}
+	}
+
+	return resp, nil
+}
+
+// SameHost compares two URLs or hostnames ignoring scheme, case and trailing
+// slashes.
+func SameHost(a, b string) bool {
+	normalize := func(s string) string {
+		s = strings.TrimPrefix(s, "https://")
+		s = strings.TrimPrefix(s, "http://")
+		return strings.ToLower(strings.TrimRight(s, "/"))
+	}
+
+	return normalize(a) == normalize(b)
+}
+
+// AsTokenError returns the *TokenError wrapped in err, if any.
+func AsTokenError(err error) (*TokenError, bool) {
+	var tokenErr *TokenError
+	if errors.As(err, &tokenErr) {
+		return tokenErr, true
+	}
+
+	return nil, false
+}
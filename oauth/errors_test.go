@@ -0,0 +1,110 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTokenServer(status int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+}
+
+func TestTokenSource_errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		apiDomain string
+		expected  error
+	}{
+		{
+			name:     "invalid client",
+			status:   200,
+			body:     `{"error":"invalid_client"}`,
+			expected: ErrInvalidClient,
+		},
+		{
+			name:     "invalid client secret",
+			status:   200,
+			body:     `{"error":"invalid_client_secret"}`,
+			expected: ErrInvalidClient,
+		},
+		{
+			name:     "invalid refresh token",
+			status:   200,
+			body:     `{"error":"invalid_code"}`,
+			expected: ErrInvalidCode,
+		},
+		{
+			name:     "throttled",
+			status:   400,
+			body:     `{"error_description":"You have made too many requests continuously. Please try again after some time.","error":"Access Denied","status":"failure"}`,
+			expected: ErrAccessDenied,
+		},
+		{
+			name:      "wrong region",
+			status:    200,
+			body:      `{"access_token":"foo","api_domain":"https://www.zohoapis.eu","token_type":"Bearer","expires_in":3600}`,
+			apiDomain: "https://www.zohoapis.com",
+			expected:  ErrWrongRegion,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTokenServer(test.status, test.body)
+			defer server.Close()
+
+			c := NewConfig("client", "secret", "refresh", "", "")
+			c.Endpoint.TokenURL = server.URL
+			c.APIDomain = test.apiDomain
+
+			_, err := c.TokenSource(context.Background()).Token()
+			require.Error(t, err)
+
+			assert.True(t, errors.Is(err, test.expected), "expected %v to match %v", err, test.expected)
+
+			tokenErr, ok := AsTokenError(err)
+			require.True(t, ok)
+			assert.Equal(t, server.URL, tokenErr.TokenURL)
+		})
+	}
+}
+
+func TestTokenSource_matchingAPIDomain(t *testing.T) {
+	server := newTokenServer(200, `{"access_token":"foo","api_domain":"https://www.zohoapis.com","token_type":"Bearer","expires_in":3600}`)
+	defer server.Close()
+
+	c := NewConfig("client", "secret", "refresh", "", "")
+	c.Endpoint.TokenURL = server.URL
+	c.APIDomain = "https://www.zohoapis.com/"
+
+	token, err := c.TokenSource(context.Background()).Token()
+	require.NoError(t, err)
+	assert.Equal(t, "foo", token.AccessToken)
+}
+
+func TestTokenError_Error(t *testing.T) {
+	err := &TokenError{Code: "invalid_code", TokenURL: "https://accounts.zoho.com/oauth/v2/token"}
+	assert.Equal(t, `token endpoint https://accounts.zoho.com/oauth/v2/token replied with error "invalid_code"`, err.Error())
+
+	err = &TokenError{Code: "Access Denied", Description: "Too many requests", TokenURL: "https://accounts.zoho.com/oauth/v2/token"}
+	assert.Equal(t, `token endpoint https://accounts.zoho.com/oauth/v2/token replied with error "Access Denied": Too many requests`, err.Error())
+	assert.False(t, errors.Is(err, ErrInvalidCode))
+}
+
+func TestSameHost(t *testing.T) {
+	assert.True(t, SameHost("https://www.zohoapis.com", "www.zohoapis.com/"))
+	assert.True(t, SameHost("http://WWW.zohoapis.eu/", "https://www.zohoapis.eu"))
+	assert.False(t, SameHost("https://www.zohoapis.com", "https://www.zohoapis.com.au"))
+}
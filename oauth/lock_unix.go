@@ -0,0 +1,34 @@
+//go:build !windows
+// +build !windows
+
+package oauth
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// lockFile obtains an exclusive lock on the file at path, creating it if
+// necessary. It blocks until the lock is acquired. The returned func
+// releases the lock.
+func lockFile(path string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return func() {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}, nil
+}
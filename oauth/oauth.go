@@ -34,6 +34,19 @@ type Config struct {
 	// (as opposed to the user) to refresh the access token
 	// if it expires.
 	RefreshToken string
+
+	// APIDomain is the Zoho API domain of the configured data center, e.g.
+	// https://www.zohoapis.com. If set, access tokens issued for a different
+	// API domain are rejected with ErrWrongRegion. Optional.
+	APIDomain string
+
+	// Transport is used to send requests to the token endpoint. If nil,
+	// http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	// TokenCache enables sharing access tokens across processes through a
+	// cache file. If nil, every process obtains its own access token.
+	TokenCache *TokenCacheConfig
 }
 
 // NewConfig creates a new *Config for the provided client credentials.
@@ -57,15 +70,18 @@ func NewConfig(clientID, clientSecret, refreshToken, accessToken, expiry string)
 // tokens and attaches them to any request made with it.
 func (c *Config) Client(ctx context.Context) *http.Client {
 	return &http.Client{
-		Transport: &oauth2.Transport{
+		Transport: &Transport{
 			Source: c.TokenSource(ctx),
+			Base:   c.Transport,
 		},
 	}
 }
 
 // TokenSource creates an oauth2.TokenSource which obtains access tokens using
 // the refresh token.
-func (c *Config) TokenSource(ctx context.Context) oauth2.TokenSource {
+func (c *Config) TokenSource(ctx context.Context) InvalidatingTokenSource {
+	ctx = c.withTokenErrors(ctx)
+
 	t := &oauth2.Token{
 		RefreshToken: c.RefreshToken,
 		TokenType:    TokenType,
@@ -78,8 +94,54 @@ func (c *Config) TokenSource(ctx context.Context) oauth2.TokenSource {
 			}
 		}
 	}
+
+	// Every call to the refresher obtains a new access token from the token
+	// endpoint. Reuse is handled by the token sources wrapping it.
+	refresher := tokenSourceFunc(func() (*oauth2.Token, error) {
+		return c.Config.TokenSource(ctx, &oauth2.Token{
+			RefreshToken: c.RefreshToken,
+			TokenType:    TokenType,
+		}).Token()
+	})
+
+	if c.TokenCache != nil && c.TokenCache.Path != "" {
+		var initial *oauth2.Token
+		if t.AccessToken != "" {
+			initial = t
+		}
+
+		return newCachingTokenSource(refresher, *c.TokenCache, c.ClientID, c.RefreshToken, initial)
+	}
+
 	tokenSrc := &tokenSource{
-		delegate: c.Config.TokenSource(ctx, t),
+		delegate:  oauth2.ReuseTokenSource(t, refresher),
+		refresher: refresher,
 	}
 	return tokenSrc
 }
+
+// withTokenErrors returns a copy of ctx which instructs golang.org/x/oauth2
+// to use an *http.Client that parses token endpoint errors into *TokenError.
+// If ctx already carries an *http.Client, its transport is wrapped.
+// Otherwise c.Transport is used.
+func (c *Config) withTokenErrors(ctx context.Context) context.Context {
+	var base http.RoundTripper = http.DefaultTransport
+	if c.Transport != nil {
+		base = c.Transport
+	}
+	httpClient := &http.Client{}
+
+	if existing, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok && existing != nil {
+		*httpClient = *existing
+		if existing.Transport != nil {
+			base = existing.Transport
+		}
+	}
+
+	httpClient.Transport = &tokenErrorTransport{
+		base:              base,
+		expectedAPIDomain: c.APIDomain,
+	}
+
+	return context.WithValue(ctx, oauth2.HTTPClient, httpClient)
+}
@@ -19,6 +19,14 @@ const maxExpiresIn = 1 * time.Hour
 type tokenSource struct {
 	delegate oauth2.TokenSource
 	mu       sync.Mutex
+
+	// refresher obtains a new access token from the token endpoint. It is
+	// used to replace delegate once the current token was invalidated.
+	// Optional.
+	refresher oauth2.TokenSource
+
+	// current is the token that was handed out last.
+	current *oauth2.Token
 }
 
 // Token implements oauth2.TokenSource.
@@ -48,5 +56,36 @@ func (s *tokenSource) Token() (*oauth2.Token, error) {
 		token.Expiry = time.Now().Add(maxExpiresIn)
 	}
 
+	s.current = token
+
 	return token, nil
 }
+
+// Invalidate implements InvalidatingTokenSource. It discards the current
+// access token if it still equals token, so that the next call to Token
+// obtains a new one.
+func (s *tokenSource) Invalidate(token *oauth2.Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.refresher == nil {
+		return
+	}
+
+	if token == nil || s.current == nil || s.current.AccessToken != token.AccessToken {
+		// Someone else already obtained a new token.
+		return
+	}
+
+	s.current = nil
+	s.delegate = oauth2.ReuseTokenSource(nil, s.refresher)
+}
+
+// tokenSourceFunc is an adapter to allow the use of ordinary functions as
+// oauth2.TokenSource.
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+// Token implements oauth2.TokenSource.
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) {
+	return f()
+}
@@ -0,0 +1,147 @@
+package oauth
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// InvalidatingTokenSource is an oauth2.TokenSource whose tokens can be
+// discarded before they expire, e.g. because the API rejected them.
+type InvalidatingTokenSource interface {
+	oauth2.TokenSource
+
+	// Invalidate discards token if it is still the current token of the
+	// source. The next call to Token obtains a new access token.
+	Invalidate(token *oauth2.Token)
+}
+
+// Transport is an http.RoundTripper that attaches OAuth access tokens to
+// requests. Unlike *oauth2.Transport it recovers from access tokens that were
+// revoked or expired early: if the API responds with 401 Unauthorized, the
+// token is invalidated, a new one is obtained and the request is replayed
+// once.
+type Transport struct {
+	// Source supplies the access tokens.
+	Source InvalidatingTokenSource
+
+	// Base is the underlying http.RoundTripper. If nil,
+	// http.DefaultTransport is used.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req, err := replayable(req)
+	if err != nil {
+		return nil, err
+	}
+
+	token, resp, err := t.roundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	log.Debugf("[oauth] %s %s was rejected with 401, obtaining a new access token", req.Method, req.URL)
+
+	t.Source.Invalidate(token)
+
+	// Only discard the rejected response once the request body could be
+	// rewound, otherwise the original response is the best we can return.
+	retry, err := rewind(req)
+	if err != nil {
+		return resp, nil
+	}
+
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	_, resp, err = t.roundTrip(retry)
+
+	return resp, err
+}
+
+// roundTrip sends req with an access token attached. It returns the token
+// that was used alongside the response.
+func (t *Transport) roundTrip(req *http.Request) (*oauth2.Token, *http.Response, error) {
+	token, err := t.Source.Token()
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, nil, err
+	}
+
+	// per RoundTripper contract the original request must not be modified.
+	req2 := cloneRequest(req)
+	token.SetAuthHeader(req2)
+
+	resp, err := t.base().RoundTrip(req2)
+
+	return token, resp, err
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+
+	return http.DefaultTransport
+}
+
+// replayable makes sure that the body of req can be rewound by buffering it
+// in memory if req does not provide GetBody already. This mirrors what
+// retryablehttp does for retries in the backoff package.
+func replayable(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return req, nil
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req)
+	req2.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(buf)), nil
+	}
+	req2.Body, _ = req2.GetBody()
+	req2.ContentLength = int64(len(buf))
+
+	return req2, nil
+}
+
+// rewind returns a copy of req with a fresh body.
+func rewind(req *http.Request) (*http.Request, error) {
+	req2 := cloneRequest(req)
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		req2.Body = body
+	}
+
+	return req2, nil
+}
+
+// cloneRequest returns a clone of the provided *http.Request. The clone is a
+// shallow copy of the struct and its Header map.
+func cloneRequest(r *http.Request) *http.Request {
+	r2 := new(http.Request)
+	*r2 = *r
+
+	r2.Header = make(http.Header, len(r.Header))
+	for k, s := range r.Header {
+		r2.Header[k] = append([]string(nil), s...)
+	}
+
+	return r2
+}
@@ -0,0 +1,127 @@
+package oauth
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+type fakeTokenSource struct {
+	tokens      []string
+	invalidated int
+	err         error
+}
+
+func (s *fakeTokenSource) Token() (*oauth2.Token, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	return &oauth2.Token{AccessToken: s.tokens[0], TokenType: TokenType}, nil
+}
+
+func (s *fakeTokenSource) Invalidate(token *oauth2.Token) {
+	if token.AccessToken == s.tokens[0] && len(s.tokens) > 1 {
+		s.tokens = s.tokens[1:]
+	}
+	s.invalidated++
+}
+
+func newUnauthorizedServer(t *testing.T, validToken string, bodies *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		*bodies = append(*bodies, string(buf))
+
+		if r.Header.Get("Authorization") != TokenType+" "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error_code":1010,"message":"Invalid OAuth token"}`))
+			return
+		}
+
+		w.Write([]byte(`{"data":{}}`))
+	}))
+}
+
+func TestTransport_reauthenticatesOnUnauthorized(t *testing.T) {
+	var bodies []string
+	server := newUnauthorizedServer(t, "new-token", &bodies)
+	defer server.Close()
+
+	source := &fakeTokenSource{tokens: []string{"revoked-token", "new-token"}}
+	client := &http.Client{Transport: &Transport{Source: source}}
+
+	// A body without GetBody, like it would be handed over by retryablehttp.
+	req, err := http.NewRequest("POST", server.URL, ioutil.NopCloser(bytes.NewBufferString(`{"foo":"bar"}`)))
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, source.invalidated)
+	assert.Equal(t, []string{`{"foo":"bar"}`, `{"foo":"bar"}`}, bodies)
+}
+
+func TestTransport_retriesOnlyOnce(t *testing.T) {
+	var bodies []string
+	server := newUnauthorizedServer(t, "valid-token", &bodies)
+	defer server.Close()
+
+	source := &fakeTokenSource{tokens: []string{"revoked-token", "also-revoked-token"}}
+	client := &http.Client{Transport: &Transport{Source: source}}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Len(t, bodies, 2)
+
+	buf, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(buf), "Invalid OAuth token")
+}
+
+func TestTransport_tokenError(t *testing.T) {
+	source := &fakeTokenSource{err: errors.New("whoops")}
+	client := &http.Client{Transport: &Transport{Source: source}}
+
+	_, err := client.Get("http://localhost")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "whoops")
+}
+
+func TestTokenSource_Invalidate(t *testing.T) {
+	refresher := &countingTokenSource{expiry: maxExpiresIn}
+
+	ts := &tokenSource{
+		delegate:  oauth2.ReuseTokenSource(nil, refresher),
+		refresher: refresher,
+	}
+
+	token, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken)
+
+	ts.Invalidate(token)
+
+	token, err = ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token.AccessToken)
+
+	// invalidating a stale token does not discard the current one
+	ts.Invalidate(&oauth2.Token{AccessToken: "token-1"})
+
+	token, err = ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token.AccessToken)
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	log "github.com/sirupsen/logrus"
+	"github.com/site24x7/terraform-provider-site24x7/oauth"
+	"github.com/site24x7/terraform-provider-site24x7/site24x7"
+	"golang.org/x/oauth2"
+)
+
+// checkCredentials obtains an access token for config right away, so that
+// misconfigured credentials are reported at configure time instead of on the
+// first resource call. On success, the token source is stored in config to be
+// reused by the API client.
+func checkCredentials(ctx context.Context, config *site24x7.Config) error {
+	tokenSource := newTokenSource(ctx, config)
+
+	if _, err := tokenWithContext(ctx, tokenSource); err != nil {
+		return credentialsError(err, config)
+	}
+
+	config.TokenSource = tokenSource
+
+	return nil
+}
+
+// dataCenterCandidate is a data center whose token endpoint is tried while
+// detecting the data center of the OAuth credentials.
+type dataCenterCandidate struct {
+	code     string
+	tokenURL string
+}
+
+// detectDataCenter determines the data center of the OAuth credentials from
+// the api_domain field of the token response and fills in the missing API
+// base URL and token URL of config. If no token URL is configured, the token
+// endpoints of all known data centers are tried in turn until one accepts
+// the credentials.
+func detectDataCenter(ctx context.Context, config *site24x7.Config) error {
+	if config.TokenURL != "" {
+		return detectDataCenterFrom(ctx, config, []dataCenterCandidate{{code: config.DataCenter, tokenURL: config.TokenURL}})
+	}
+
+	var candidates []dataCenterCandidate
+	for _, code := range site24x7.DataCenterCodes() {
+		dataCenter, _ := site24x7.GetDataCenter(code)
+		candidates = append(candidates, dataCenterCandidate{code: code, tokenURL: dataCenter.GetTokenURL()})
+	}
+
+	return detectDataCenterFrom(ctx, config, candidates)
+}
+
+// detectDataCenterFrom tries the token endpoints of candidates in order. An
+// endpoint rejecting the client or the refresh token moves on to the next
+// candidate, since the credentials likely belong to another data center. Any
+// other response ends the detection.
+func detectDataCenterFrom(ctx context.Context, config *site24x7.Config, candidates []dataCenterCandidate) error {
+	var rejected []string
+	for _, c := range candidates {
+		cfg := *config
+		cfg.DataCenter = c.code
+		cfg.TokenURL = c.tokenURL
+		cfg.APIDomain = ""
+
+		log.Debugf("Trying token endpoint %s to detect the data center", c.tokenURL)
+
+		tokenSource := newTokenSource(ctx, &cfg)
+
+		token, err := tokenWithContext(ctx, tokenSource)
+		if err != nil {
+			if len(candidates) > 1 && (errors.Is(err, oauth.ErrInvalidClient) || errors.Is(err, oauth.ErrInvalidCode)) {
+				// The credentials belong to another data center.
+				log.Debugf("Token endpoint %s rejected the credentials: %v", c.tokenURL, err)
+				rejected = append(rejected, c.tokenURL)
+				continue
+			}
+
+			return credentialsError(err, &cfg)
+		}
+
+		if cfg.APIBaseURL == "" {
+			apiDomain, _ := token.Extra("api_domain").(string)
+
+			dataCenter, err := site24x7.GetDataCenterByAPIDomain(apiDomain)
+			if err != nil {
+				return err
+			}
+
+			cfg.DataCenter = dataCenter.GetCode()
+			cfg.APIBaseURL = dataCenter.GetAPIBaseURL()
+		}
+
+		log.Printf("[INFO] Detected data center %q from token endpoint %s after trying %d endpoint(s)", cfg.DataCenter, cfg.TokenURL, len(rejected)+1)
+
+		cfg.TokenSource = tokenSource
+		*config = cfg
+
+		return nil
+	}
+
+	return fmt.Errorf("unable to detect the data center: the OAuth credentials were rejected by all token endpoints (%s). "+
+		"Check oauth2_client_id, oauth2_client_secret and oauth2_refresh_token, or set data_center explicitly.",
+		strings.Join(rejected, ", "))
+}
+
+// newTokenSource creates the token source of config. It outlives ctx, which
+// is cancelled once the provider is configured, so only the values of ctx are
+// kept for the refreshes made later on.
+func newTokenSource(ctx context.Context, config *site24x7.Config) oauth.InvalidatingTokenSource {
+	return config.OAuthConfig().TokenSource(context.WithoutCancel(ctx))
+}
+
+// tokenWithContext obtains a token from tokenSource, giving up once ctx is
+// done.
+func tokenWithContext(ctx context.Context, tokenSource oauth2.TokenSource) (*oauth2.Token, error) {
+	type result struct {
+		token *oauth2.Token
+		err   error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		token, err := tokenSource.Token()
+		done <- result{token, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.token, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// credentialsError translates token endpoint errors into an actionable
+// message naming the provider arguments that are likely misconfigured.
+func credentialsError(err error, config *site24x7.Config) error {
+	tokenErr, ok := oauth.AsTokenError(err)
+	if !ok {
+		return fmt.Errorf("unable to obtain OAuth access token from %s: %v", config.TokenURL, err)
+	}
+
+	switch {
+	case errors.Is(tokenErr, oauth.ErrInvalidClient):
+		return fmt.Errorf("%v\n\nThe OAuth client is unknown to %s. Check oauth2_client_id and oauth2_client_secret, "+
+			"and make sure data_center (%q) is the data center in which the client was registered.",
+			tokenErr, config.TokenURL, config.DataCenter)
+	case errors.Is(tokenErr, oauth.ErrInvalidCode):
+		return fmt.Errorf("%v\n\nThe oauth2_refresh_token was rejected. It is either invalid, revoked, was generated for "+
+			"another oauth2_client_id, or belongs to a data center other than data_center (%q).",
+			tokenErr, config.DataCenter)
+	case errors.Is(tokenErr, oauth.ErrAccessDenied):
+		return fmt.Errorf("%v\n\nToo many access tokens were requested in a short time. Wait a few minutes before retrying. "+
+			"Setting oauth2_access_token or enabling oauth2_token_cache avoids requesting a new access token on every run.",
+			tokenErr)
+	case errors.Is(tokenErr, oauth.ErrWrongRegion):
+		return fmt.Errorf("%v\n\nThe oauth2_refresh_token belongs to another data center than data_center (%q). "+
+			"Set data_center to the data center of your Site24x7 account.",
+			tokenErr, config.DataCenter)
+	}
+
+	return fmt.Errorf("%v\n\nCheck oauth2_client_id, oauth2_client_secret, oauth2_refresh_token and data_center (%q).",
+		tokenErr, config.DataCenter)
+}
+
+// credentialsDiagnostics turns an error returned by checkCredentials or
+// detectDataCenter into diagnostics, using the first paragraph of the message
+// as summary and the remaining hints as detail.
+func credentialsDiagnostics(err error) diag.Diagnostics {
+	parts := strings.SplitN(err.Error(), "\n\n", 2)
+
+	d := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  parts[0],
+	}
+	if len(parts) > 1 {
+		d.Detail = parts[1]
+	}
+
+	return diag.Diagnostics{d}
+}
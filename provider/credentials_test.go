@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/site24x7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCredentials(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "invalid client",
+			body:     `{"error":"invalid_client"}`,
+			expected: `make sure data_center ("US") is the data center in which the client was registered`,
+		},
+		{
+			name:     "invalid refresh token",
+			body:     `{"error":"invalid_code"}`,
+			expected: "The oauth2_refresh_token was rejected",
+		},
+		{
+			name:     "throttled",
+			body:     `{"error":"Access Denied","error_description":"You have made too many requests continuously."}`,
+			expected: "enabling oauth2_token_cache",
+		},
+		{
+			name:     "wrong region",
+			body:     `{"access_token":"foo","api_domain":"https://www.zohoapis.eu","expires_in":3600}`,
+			expected: `belongs to another data center than data_center ("US")`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			config := &site24x7.Config{
+				ClientID:     "client",
+				ClientSecret: "secret",
+				RefreshToken: "refresh",
+				DataCenter:   "US",
+				TokenURL:     server.URL,
+				APIDomain:    "https://www.zohoapis.com",
+			}
+
+			err := checkCredentials(context.Background(), config)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expected)
+			assert.Nil(t, config.TokenSource)
+		})
+	}
+}
+
+func TestCheckCredentials_success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"foo","api_domain":"https://www.zohoapis.com","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	config := &site24x7.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RefreshToken: "refresh",
+		DataCenter:   "US",
+		TokenURL:     server.URL,
+		APIDomain:    "https://www.zohoapis.com",
+	}
+
+	require.NoError(t, checkCredentials(context.Background(), config))
+	assert.NotNil(t, config.TokenSource)
+}
+
+func TestCheckCredentials_refreshAfterConfigure(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"foo","api_domain":"https://www.zohoapis.com","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	config := &site24x7.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RefreshToken: "refresh",
+		DataCenter:   "US",
+		TokenURL:     server.URL,
+		APIDomain:    "https://www.zohoapis.com",
+	}
+
+	// The context of the configure call is cancelled once it returns.
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, checkCredentials(ctx, config))
+	cancel()
+
+	token, err := config.TokenSource.Token()
+	require.NoError(t, err)
+
+	config.TokenSource.Invalidate(token)
+
+	_, err = config.TokenSource.Token()
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestDetectDataCenter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"foo","api_domain":"https://www.zohoapis.eu","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	config := &site24x7.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RefreshToken: "refresh",
+		TokenURL:     server.URL,
+	}
+
+	require.NoError(t, detectDataCenter(context.Background(), config))
+
+	assert.Equal(t, "EU", config.DataCenter)
+	assert.Equal(t, "https://www.site24x7.eu/api", config.APIBaseURL)
+	assert.Equal(t, server.URL, config.TokenURL)
+	assert.NotNil(t, config.TokenSource)
+}
+
+func TestDetectDataCenter_laterCandidateAccepts(t *testing.T) {
+	var rejectedRequests int
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rejectedRequests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"error":"invalid_client"}`))
+	}))
+	defer rejecting.Close()
+
+	accepting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"foo","api_domain":"https://www.zohoapis.com.au","expires_in":3600}`))
+	}))
+	defer accepting.Close()
+
+	var unusedRequests int
+	unused := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		unusedRequests++
+	}))
+	defer unused.Close()
+
+	config := &site24x7.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RefreshToken: "refresh",
+	}
+
+	candidates := []dataCenterCandidate{
+		{code: "US", tokenURL: rejecting.URL},
+		{code: "AU", tokenURL: accepting.URL},
+		{code: "IN", tokenURL: unused.URL},
+	}
+
+	require.NoError(t, detectDataCenterFrom(context.Background(), config, candidates))
+
+	assert.Equal(t, 1, rejectedRequests)
+	assert.Equal(t, 0, unusedRequests)
+	assert.Equal(t, "AU", config.DataCenter)
+	assert.Equal(t, "https://www.site24x7.net.au/api", config.APIBaseURL)
+	assert.Equal(t, accepting.URL, config.TokenURL)
+	assert.NotNil(t, config.TokenSource)
+}
+
+func TestDetectDataCenter_allCandidatesReject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"error":"invalid_code"}`))
+	}))
+	defer server.Close()
+
+	config := &site24x7.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RefreshToken: "refresh",
+	}
+
+	err := detectDataCenterFrom(context.Background(), config, []dataCenterCandidate{
+		{code: "US", tokenURL: server.URL},
+		{code: "EU", tokenURL: server.URL + "/eu"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), server.URL+", "+server.URL+"/eu")
+	assert.Nil(t, config.TokenSource)
+}
+
+func TestProviderConfigure_skipCredentialsValidationRequiresDataCenter(t *testing.T) {
+	t.Setenv("SITE24X7_DATA_CENTER", "")
+	t.Setenv("SITE24X7_API_BASE_URL", "")
+	t.Setenv("SITE24X7_TOKEN_URL", "")
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"oauth2_client_id":            "client",
+		"oauth2_client_secret":        "secret",
+		"oauth2_refresh_token":        "refresh",
+		"skip_credentials_validation": true,
+	})
+
+	_, diags := providerConfigure(context.Background(), d)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "data_center")
+}
+
+func TestDetectDataCenter_unknownAPIDomain(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"foo","api_domain":"https://www.zohoapis.sa","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	config := &site24x7.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RefreshToken: "refresh",
+		TokenURL:     server.URL,
+	}
+
+	err := detectDataCenter(context.Background(), config)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "api_base_url")
+
+	// an explicit API base URL makes detection unnecessary
+	config.APIBaseURL = "https://www.site24x7.sa/api"
+
+	require.NoError(t, detectDataCenter(context.Background(), config))
+	assert.Equal(t, "https://www.site24x7.sa/api", config.APIBaseURL)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/site24x7"
+)
+
+// mspLevelResources manage MSP customers themselves and thus cannot be
+// targeted at a customer.
+var mspLevelResources = map[string]bool{
+	"site24x7_customer": true,
+	"site24x7_msp":      true,
+}
+
+// customerImportID matches import IDs of the form <zaaid>:<id> which import a
+// resource of an MSP customer.
+var customerImportID = regexp.MustCompile(`^(\d+):(.+)$`)
+
+// addCustomerTargeting adds the optional zaaid argument to all resources and
+// data sources. If set, every API call of the resource or data source is sent
+// on behalf of the MSP customer with that zaaid instead of the one configured
+// at the provider level. This allows managing many customers with a single
+// provider configuration.
+func addCustomerTargeting(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		if !mspLevelResources[name] {
+			withCustomerTargeting(r, false)
+		}
+	}
+
+	for name, r := range p.DataSourcesMap {
+		if !mspLevelResources[name] {
+			withCustomerTargeting(r, true)
+		}
+	}
+}
+
+func withCustomerTargeting(r *schema.Resource, dataSource bool) {
+	// Schemas are shared between resources in some places, so the map is
+	// copied before it is modified.
+	s := make(map[string]*schema.Schema, len(r.Schema)+1)
+	for k, v := range r.Schema {
+		s[k] = v
+	}
+	s["zaaid"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    !dataSource,
+		Description: "Account ID of the MSP customer to manage. Overrides the zaaid of the provider configuration.",
+	}
+	r.Schema = s
+
+	r.CreateContext = forCustomer(r.CreateContext)
+	r.ReadContext = forCustomer(r.ReadContext)
+	r.UpdateContext = forCustomer(r.UpdateContext)
+	r.DeleteContext = forCustomer(r.DeleteContext)
+
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		r.Importer.StateContext = importForCustomer(r.Importer.StateContext)
+	}
+}
+
+// forCustomer wraps f so that it uses a client bound to the zaaid of the
+// resource, if any.
+func forCustomer[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return f(ctx, d, customerClient(d, meta))
+	}
+}
+
+// importForCustomer wraps f so that IDs of the form <zaaid>:<id> import the
+// resource with the given ID of the MSP customer with the given zaaid.
+func importForCustomer(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if m := customerImportID.FindStringSubmatch(d.Id()); m != nil {
+			if err := d.Set("zaaid", m[1]); err != nil {
+				return nil, fmt.Errorf("unable to set zaaid: %w", err)
+			}
+			d.SetId(m[2])
+		}
+
+		return f(ctx, d, customerClient(d, meta))
+	}
+}
+
+func customerClient(d *schema.ResourceData, meta interface{}) interface{} {
+	zaaid, _ := d.Get("zaaid").(string)
+	if zaaid == "" {
+		return meta
+	}
+
+	client, ok := meta.(site24x7.Client)
+	if !ok {
+		return meta
+	}
+
+	return site24x7.WithZAAID(zaaid, client)
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/site24x7/terraform-provider-site24x7/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomerTargetingSchema(t *testing.T) {
+	p := Provider()
+
+	for name, r := range p.ResourcesMap {
+		if mspLevelResources[name] {
+			continue
+		}
+		require.Contains(t, r.Schema, "zaaid", name)
+		assert.True(t, r.Schema["zaaid"].ForceNew, name)
+	}
+
+	for name, r := range p.DataSourcesMap {
+		if mspLevelResources[name] {
+			continue
+		}
+		require.Contains(t, r.Schema, "zaaid", name)
+	}
+
+	assert.NotContains(t, p.ResourcesMap["site24x7_customer"].Schema, "zaaid")
+	assert.True(t, p.DataSourcesMap["site24x7_msp"].Schema["zaaid"].Computed)
+}
+
+func TestCustomerTargetingRoutesCalls(t *testing.T) {
+	r := Provider().ResourcesMap["site24x7_tag"]
+	c := fake.NewClient()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tag_name":  "foo",
+		"tag_color": "#B7DA9E",
+		"zaaid":     "123",
+	})
+
+	c.Customer("123").FakeTags.On("Create", mock.Anything).Return(&api.Tag{TagID: "1"}, nil).Once()
+
+	diags := r.CreateContext(context.Background(), d, c)
+	require.Nil(t, diags)
+	assert.Equal(t, "1", d.Id())
+
+	// Without zaaid the calls go to the provider-level account.
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tag_name":  "foo",
+		"tag_color": "#B7DA9E",
+	})
+
+	c.FakeTags.On("Create", mock.Anything).Return(&api.Tag{TagID: "2"}, nil).Once()
+
+	diags = r.CreateContext(context.Background(), d, c)
+	require.Nil(t, diags)
+	assert.Equal(t, "2", d.Id())
+
+	c.FakeTags.AssertExpectations(t)
+	c.Customer("123").FakeTags.AssertExpectations(t)
+	assert.Len(t, c.MSPCustomers, 1)
+}
+
+func TestCustomerTargetingImport(t *testing.T) {
+	r := Provider().ResourcesMap["site24x7_tag"]
+	c := fake.NewClient()
+
+	d := r.Data(nil)
+	d.SetId("123:456")
+
+	states, err := r.Importer.StateContext(context.Background(), d, c)
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+
+	assert.Equal(t, "456", states[0].Id())
+	assert.Equal(t, "123", states[0].Get("zaaid"))
+}
+
+func TestCustomerTargetingImportByName(t *testing.T) {
+	r := Provider().ResourcesMap["site24x7_tag"]
+	c := fake.NewClient()
+
+	c.Customer("123").FakeTags.On("List").Return([]*api.Tag{{TagID: "456", TagName: "foo"}}, nil).Once()
+
+	d := r.Data(nil)
+	d.SetId("123:name:foo")
+
+	states, err := r.Importer.StateContext(context.Background(), d, c)
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+
+	assert.Equal(t, "456", states[0].Id())
+	assert.Equal(t, "123", states[0].Get("zaaid"))
+	c.Customer("123").FakeTags.AssertExpectations(t)
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/site24x7/terraform-provider-site24x7/emulator"
+)
+
+// skipWithoutTerraformCLI skips tests driving the Terraform CLI if it is
+// neither configured via TF_ACC_TERRAFORM_PATH nor found in the PATH, since
+// installing it requires network access.
+func skipWithoutTerraformCLI(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("Terraform CLI not found, set TF_ACC_TERRAFORM_PATH or add terraform to the PATH")
+	}
+}
+
+// emulatorProviderFactories returns the provider factories for tests running
+// against the API emulator.
+func emulatorProviderFactories() map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"site24x7": func() (*schema.Provider, error) {
+			return Provider(), nil
+		},
+	}
+}
+
+// emulatorProviderConfig returns a provider block pointing to s.
+func emulatorProviderConfig(s *emulator.Server) string {
+	return fmt.Sprintf(`
+provider "site24x7" {
+  oauth2_client_id     = %q
+  oauth2_client_secret = %q
+  oauth2_refresh_token = %q
+  api_base_url         = %q
+  token_url            = %q
+}
+`, emulator.DefaultClientID, emulator.DefaultClientSecret, emulator.DefaultRefreshToken, s.APIBaseURL(), s.TokenURL())
+}
+
+func TestTagResourceAgainstEmulator(t *testing.T) {
+	skipWithoutTerraformCLI(t)
+
+	s := emulator.New(emulator.Config{})
+	defer s.Close()
+
+	for _, env := range []string{"SITE24X7_DATA_CENTER", "SITE24X7_API_BASE_URL", "SITE24X7_TOKEN_URL"} {
+		t.Setenv(env, "")
+	}
+
+	config := func(value string) string {
+		return emulatorProviderConfig(s) + fmt.Sprintf(`
+resource "site24x7_tag" "test" {
+  tag_name  = "terraform-emulator"
+  tag_value = %q
+  tag_color = "#B7DA9E"
+}
+`, value)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: emulatorProviderFactories(),
+		CheckDestroy: func(*terraform.State) error {
+			if tags := s.Entities("", "tags"); len(tags) > 0 {
+				return fmt.Errorf("%d tags left in the emulator", len(tags))
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("site24x7_tag.test", "id"),
+					resource.TestCheckResourceAttr("site24x7_tag.test", "tag_name", "terraform-emulator"),
+					resource.TestCheckResourceAttr("site24x7_tag.test", "tag_value", "foo"),
+					emulatorTagValue(s, "site24x7_tag.test", "foo"),
+				),
+			},
+			{
+				Config: config("bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("site24x7_tag.test", "tag_value", "bar"),
+					emulatorTagValue(s, "site24x7_tag.test", "bar"),
+				),
+			},
+			{
+				ResourceName:      "site24x7_tag.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// emulatorTagValue checks that the tag of the resource with name has value in
+// the emulator.
+func emulatorTagValue(s *emulator.Server, name, value string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", name)
+		}
+
+		tag, ok := s.Entity("", "tags", rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("tag %s not found in the emulator", rs.Primary.ID)
+		}
+		if tag["tag_value"] != value {
+			return fmt.Errorf("expected tag_value %q in the emulator, got %v", value, tag["tag_value"])
+		}
+
+		return nil
+	}
+}
@@ -1,13 +1,17 @@
 package provider
 
 import (
+	"context"
 	"os"
+	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
 	"github.com/site24x7/terraform-provider-site24x7/backoff"
+	"github.com/site24x7/terraform-provider-site24x7/oauth"
+	"github.com/site24x7/terraform-provider-site24x7/rest"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7/aws"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7/common"
@@ -16,8 +20,8 @@ import (
 	"github.com/site24x7/terraform-provider-site24x7/site24x7/msp"
 )
 
-func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+func Provider() *schema.Provider {
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"oauth2_client_id": {
 				Type:        schema.TypeString,
@@ -48,10 +52,72 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				Description: "Access token expiry in seconds",
 			},
+			"oauth2_token_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SITE24X7_OAUTH2_TOKEN_CACHE", false),
+				Description: "Cache OAuth access tokens on disk and share them between provider runs.",
+			},
+			"oauth2_token_cache_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SITE24X7_OAUTH2_TOKEN_CACHE_FILE", ""),
+				Description: "Path of the OAuth access token cache file. Defaults to a file in the user's cache directory.",
+			},
+			"skip_credentials_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip obtaining an access token at configure time to validate the OAuth credentials. Requires data_center, or api_base_url and token_url, to be set.",
+			},
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SITE24X7_HTTP_PROXY", ""),
+				Description: "URL of the proxy used for requests to the token endpoint and the Site24x7 API. Defaults to the HTTPS_PROXY and HTTP_PROXY environment variables.",
+			},
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SITE24X7_CA_BUNDLE_FILE", ""),
+				Description: "Path of a PEM file with CA certificates that are trusted in addition to the system roots, e.g. the CA of a TLS-inspecting proxy.",
+			},
+			"client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"client_key"},
+				Description:  "PEM encoded client certificate, or the path of a file containing it, presented to servers requesting mutual TLS.",
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"client_cert"},
+				Description:  "PEM encoded private key of client_cert, or the path of a file containing it.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Skip the verification of TLS server certificates. Only use this for debugging.",
+			},
 			"data_center": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Site24x7 data center.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SITE24X7_DATA_CENTER", ""),
+				Description: "Site24x7 data center. Detected from the OAuth token response if omitted.",
+			},
+			"api_base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SITE24X7_API_BASE_URL", ""),
+				Description: "Site24x7 API base URL. Overrides the URL derived from data_center.",
+			},
+			"token_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SITE24X7_TOKEN_URL", ""),
+				Description: "Zoho OAuth token URL. Overrides the URL derived from data_center.",
 			},
 			"zaaid": {
 				Type:        schema.TypeString,
@@ -76,6 +142,48 @@ func Provider() terraform.ResourceProvider {
 				Default:     4,
 				Description: "Maximum number of retries for Site24x7 API errors until giving up",
 			},
+			"retryable_error_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Site24x7 error codes which indicate throttling or temporary failures and are retried like server errors. Defaults to the documented error codes for throttling and temporary failures, of which there currently are none. An empty list disables retrying on error codes.",
+			},
+			"request_timeout": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     300,
+				Description: "Maximum time in seconds a single Site24x7 API call may take, including all retries. Set to 0 to disable the timeout.",
+			},
+			"log_sensitive_keys": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional JSON keys whose values are masked in debug logs of API requests and responses.",
+			},
+			"max_requests_per_second": {
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Default:     10,
+				Description: "Maximum number of Site24x7 API requests per second shared by all resources. The rate is lowered automatically when the API starts throttling. Set to 0 to disable the limit.",
+			},
+			"request_burst": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				Description: "Number of Site24x7 API requests that may be sent at once before max_requests_per_second applies.",
+			},
+			"max_concurrent_requests": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				Description: "Maximum number of concurrent Site24x7 API requests. Set to 0 to disable the limit.",
+			},
+			"prefetch_monitors": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("SITE24X7_PREFETCH_MONITORS", false),
+				Description: "Read all monitors with a single list call on the first refresh of a monitor instead of one API call per monitor. Defaults to false.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -125,34 +233,48 @@ func Provider() terraform.ResourceProvider {
 			"site24x7_monitor":              monitors.DataSourceSite24x7Monitor(),
 			"site24x7_monitors":             monitors.DataSourceSite24x7Monitors(),
 			"site24x7_location_profile":     site24x7.DataSourceSite24x7LocationProfile(),
+			"site24x7_locations":            site24x7.DataSourceSite24x7Locations(),
 			"site24x7_threshold_profile":    site24x7.DataSourceSite24x7ThresholdProfile(),
 			"site24x7_notification_profile": site24x7.DataSourceSite24x7NotificationProfile(),
 			"site24x7_monitor_group":        site24x7.DataSourceSite24x7MonitorGroup(),
-			// "site24x7_subgroup":             site24x7.DataSourceSite24x7Subgroup(),
-			"site24x7_user_group":         site24x7.DataSourceSite24x7UserGroup(),
-			"site24x7_user":               site24x7.DataSourceSite24x7User(),
-			"site24x7_it_automation":      site24x7.DataSourceSite24x7ITAutomation(),
-			"site24x7_tag":                site24x7.DataSourceSite24x7Tag(),
-			"site24x7_msp":                site24x7.DataSourceSite24x7MSP(),
-			"site24x7_aws_external_id":    aws.DataSourceSite24x7AWSExternalID(),
-			"site24x7_device_key":         common.DataSourceSite24x7DeviceKey(),
-			"site24x7_credential_profile": common.DataSourceSite24x7CredentialProfile(),
-			"site24x7_customer":           msp.DataSourceSite24x7Customer(),
-			"site24x7_oauth2_provider":    common.DataSourceSite24x7OAuth2Provider(),
+			"site24x7_current_status":       site24x7.DataSourceSite24x7CurrentStatus(),
+			"site24x7_subgroup":             site24x7.DataSourceSite24x7Subgroup(),
+			"site24x7_user_group":           site24x7.DataSourceSite24x7UserGroup(),
+			"site24x7_user":                 site24x7.DataSourceSite24x7User(),
+			"site24x7_it_automation":        site24x7.DataSourceSite24x7ITAutomation(),
+			"site24x7_tag":                  site24x7.DataSourceSite24x7Tag(),
+			"site24x7_msp":                  site24x7.DataSourceSite24x7MSP(),
+			"site24x7_aws_external_id":      aws.DataSourceSite24x7AWSExternalID(),
+			"site24x7_device_key":           common.DataSourceSite24x7DeviceKey(),
+			"site24x7_credential_profile":   common.DataSourceSite24x7CredentialProfile(),
+			"site24x7_customer":             msp.DataSourceSite24x7Customer(),
+			"site24x7_oauth2_provider":      common.DataSourceSite24x7OAuth2Provider(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
+	}
+
+	addWaitForStatus(p)
+	addCustomerTargeting(p)
+
+	return p
+}
+
+// addWaitForStatus adds the optional wait_for_status block to all monitor
+// resources.
+func addWaitForStatus(p *schema.Provider) {
+	for name, r := range p.ResourcesMap {
+		if strings.HasSuffix(name, "_monitor") {
+			site24x7.WithWaitForStatus(r)
+		}
 	}
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	tfLog := os.Getenv("TF_LOG")
 	if tfLog == "DEBUG" || tfLog == "TRACE" {
 		log.SetLevel(log.DebugLevel)
 	}
-	dataCenter := site24x7.GetDataCenter(d.Get("data_center").(string))
-	log.Println("GetAPIBaseURL : ", dataCenter.GetAPIBaseURL())
-	log.Println("GetTokenURL : ", dataCenter.GetTokenURL())
 	config := site24x7.Config{
 		ClientID:     d.Get("oauth2_client_id").(string),
 		ClientSecret: d.Get("oauth2_client_secret").(string),
@@ -160,14 +282,116 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		AccessToken:  d.Get("oauth2_access_token").(string),
 		Expiry:       d.Get("access_token_expiry").(string),
 		ZAAID:        d.Get("zaaid").(string),
-		APIBaseURL:   dataCenter.GetAPIBaseURL(),
-		TokenURL:     dataCenter.GetTokenURL(),
 		RetryConfig: &backoff.RetryConfig{
 			MinWait:    time.Duration(d.Get("retry_min_wait").(int)) * time.Second,
 			MaxWait:    time.Duration(d.Get("retry_max_wait").(int)) * time.Second,
 			MaxRetries: d.Get("max_retries").(int),
 		},
+		RequestTimeout:   time.Duration(d.Get("request_timeout").(int)) * time.Second,
+		PrefetchMonitors: d.Get("prefetch_monitors").(bool),
+		RateLimitConfig: &rest.RateLimitConfig{
+			RequestsPerSecond: d.Get("max_requests_per_second").(float64),
+			Burst:             d.Get("request_burst").(int),
+			MaxInFlight:       d.Get("max_concurrent_requests").(int),
+		},
+	}
+
+	transportConfig := site24x7.TransportConfig{
+		HTTPProxy:          d.Get("http_proxy").(string),
+		CABundleFile:       d.Get("ca_bundle_file").(string),
+		ClientCert:         d.Get("client_cert").(string),
+		ClientKey:          d.Get("client_key").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+	}
+	if transportConfig != (site24x7.TransportConfig{}) {
+		transport, err := site24x7.NewTransport(transportConfig)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		config.Transport = transport
+	}
+
+	config.RetryConfig.RetryableErrorCodes = retryableErrorCodes(d)
+
+	for _, key := range d.Get("log_sensitive_keys").([]interface{}) {
+		config.SensitiveKeys = append(config.SensitiveKeys, key.(string))
 	}
 
+	if d.Get("oauth2_token_cache").(bool) {
+		tokenCacheFile := d.Get("oauth2_token_cache_file").(string)
+		if tokenCacheFile == "" {
+			defaultPath, err := oauth.DefaultTokenCachePath()
+			if err != nil {
+				return nil, diag.Errorf("unable to determine OAuth token cache location, please set oauth2_token_cache_file: %v", err)
+			}
+			tokenCacheFile = defaultPath
+		}
+		config.TokenCacheFile = tokenCacheFile
+	}
+
+	dataCenterCode := d.Get("data_center").(string)
+	if dataCenterCode != "" {
+		dataCenter, err := site24x7.GetDataCenter(dataCenterCode)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		config.DataCenter = dataCenter.GetCode()
+		config.APIBaseURL = dataCenter.GetAPIBaseURL()
+		config.TokenURL = dataCenter.GetTokenURL()
+		config.APIDomain = dataCenter.GetAPIDomain()
+	}
+
+	if apiBaseURL := d.Get("api_base_url").(string); apiBaseURL != "" {
+		// The API domain of a custom region is unknown, so it cannot be
+		// checked against the token response.
+		config.APIBaseURL = apiBaseURL
+		config.APIDomain = ""
+	}
+	if tokenURL := d.Get("token_url").(string); tokenURL != "" {
+		config.TokenURL = tokenURL
+	}
+
+	skipCredentialsValidation := d.Get("skip_credentials_validation").(bool)
+	if config.APIBaseURL == "" || config.TokenURL == "" {
+		if skipCredentialsValidation {
+			// Detecting the data center requires an access token.
+			return nil, diag.Errorf("data_center, or api_base_url and token_url, must be set when skip_credentials_validation is enabled")
+		}
+		if err := detectDataCenter(ctx, &config); err != nil {
+			return nil, credentialsDiagnostics(err)
+		}
+	} else if !skipCredentialsValidation {
+		if err := checkCredentials(ctx, &config); err != nil {
+			return nil, credentialsDiagnostics(err)
+		}
+	}
+
+	log.Println("GetAPIBaseURL : ", config.APIBaseURL)
+	log.Println("GetTokenURL : ", config.TokenURL)
+
 	return site24x7.New(config), nil
 }
+
+// retryableErrorCodes returns the configured retryable_error_codes. It returns
+// nil if the argument is not set, so that the defaults apply, and an empty
+// slice if it is set to an empty list, which disables retrying on error codes.
+// d.GetOk cannot tell both apart, so the raw configuration is checked.
+func retryableErrorCodes(d *schema.ResourceData) []int {
+	codes := []int{}
+	for _, code := range d.Get("retryable_error_codes").([]interface{}) {
+		codes = append(codes, code.(int))
+	}
+	if len(codes) > 0 {
+		return codes
+	}
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	if raw := rawConfig.GetAttr("retryable_error_codes"); raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+
+	return codes
+}
@@ -1,28 +1,85 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-var testAccProviders map[string]terraform.ResourceProvider
+var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 
 func init() {
-	testAccProvider = Provider().(*schema.Provider)
-	testAccProviders = map[string]terraform.ResourceProvider{
+	testAccProvider = Provider()
+	testAccProviders = map[string]*schema.Provider{
 		"site24x7": testAccProvider,
 	}
 }
 
 func TestProvider(t *testing.T) {
-	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
 func TestProvider_impl(t *testing.T) {
-	var _ terraform.ResourceProvider = Provider()
+	var _ *schema.Provider = Provider()
+}
+
+func TestRetryableErrorCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    cty.Value
+		expected []int
+	}{
+		{
+			name:     "unset",
+			value:    cty.NullVal(cty.List(cty.Number)),
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			value:    cty.ListValEmpty(cty.Number),
+			expected: []int{},
+		},
+		{
+			name:     "codes",
+			value:    cty.ListVal([]cty.Value{cty.NumberIntVal(1007), cty.NumberIntVal(1015)}),
+			expected: []int{1007, 1015},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var codes []int
+
+			p := Provider()
+			p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				codes = retryableErrorCodes(d)
+				return nil, nil
+			}
+
+			block := schema.InternalMap(p.Schema).CoreConfigSchema()
+			attributes := map[string]cty.Value{}
+			for name, attributeType := range block.ImpliedType().AttributeTypes() {
+				attributes[name] = cty.NullVal(attributeType)
+			}
+			attributes["retryable_error_codes"] = test.value
+
+			// Like the gRPC server, pass the raw configuration along.
+			value := cty.ObjectVal(attributes)
+			config := terraform.NewResourceConfigShimmed(value, block)
+			config.CtyValue = value
+
+			diags := p.Configure(context.Background(), config)
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, test.expected, codes)
+		})
+	}
 }
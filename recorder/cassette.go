@@ -0,0 +1,146 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// recordedHeaders are the response headers that are stored in cassettes. All
+// other headers are dropped as they are irrelevant to the client and may
+// contain session cookies.
+var recordedHeaders = []string{
+	"Content-Type",
+	"Retry-After",
+}
+
+// Cassette holds the recorded HTTP interactions of a test.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Request  *RecordedRequest  `json:"request"`
+	Response *RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request with secrets scrubbed. The scheme and host of
+// the URL are not recorded so that cassettes can be replayed against any API
+// base URL.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   Body   `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response with secrets scrubbed.
+type RecordedResponse struct {
+	StatusCode int               `json:"status_code"`
+	Header     map[string]string `json:"header,omitempty"`
+	Body       Body              `json:"body,omitempty"`
+}
+
+// Body is a request or response body. JSON bodies are stored as JSON to keep
+// cassettes readable, all other bodies as string.
+type Body []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if len(b) == 0 {
+		return []byte(`""`), nil
+	}
+	if json.Valid(b) {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, b); err != nil {
+			return nil, err
+		}
+		// Wrap JSON bodies so that they can be told apart from text bodies
+		// which happen to be JSON strings.
+		return []byte(`{"json":` + buf.String() + `}`), nil
+	}
+
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*b = Body(text)
+		return nil
+	}
+
+	var wrapped struct {
+		JSON json.RawMessage `json:"json"`
+	}
+	if err := json.Unmarshal(data, &wrapped); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, wrapped.JSON); err != nil {
+		return err
+	}
+	*b = Body(buf.Bytes())
+
+	return nil
+}
+
+// LoadCassette reads the cassette stored at path.
+func LoadCassette(path string) (*Cassette, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := &Cassette{}
+	if err := json.Unmarshal(buf, cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+
+	return cassette, nil
+}
+
+// Save writes the cassette to path, creating parent directories as needed.
+func (c *Cassette) Save(path string) error {
+	buf, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(buf, '\n'), 0644)
+}
+
+// matches reports whether the recorded request matches req.
+func (r *RecordedRequest) matches(req *RecordedRequest) bool {
+	return r.Method == req.Method && r.URL == req.URL && bytes.Equal(r.Body, req.Body)
+}
+
+// httpResponse creates an *http.Response for req from the recorded response.
+func (r *RecordedResponse) httpResponse(req *http.Request) *http.Response {
+	header := make(http.Header, len(r.Header))
+	for key, value := range r.Header {
+		header.Set(key, value)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,256 @@
+// Package recorder implements an HTTP client that records interactions with
+// the Site24x7 API into cassette files and replays them offline.
+//
+// A *Recorder implements the HTTPClient interfaces of the rest and site24x7
+// packages and can thus be plugged into rest.NewClient and site24x7.NewClient.
+// In record mode it sends requests through an authenticated client and
+// captures the requests and responses with tokens and secrets scrubbed. In
+// replay mode it serves the recorded responses without network access and
+// fails requests that were not recorded.
+package recorder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/site24x7/terraform-provider-site24x7/rest"
+)
+
+// RecordEnvVar is the environment variable that switches ModeFromEnv to
+// record mode.
+const RecordEnvVar = "SITE24X7_RECORD"
+
+// Mode is the mode of a *Recorder.
+type Mode int
+
+const (
+	// ModeReplay serves responses from an existing cassette.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the API and records them into a new
+	// cassette.
+	ModeRecord
+)
+
+// ModeFromEnv returns ModeRecord if the SITE24X7_RECORD environment variable
+// is set to a non-empty value other than "0" or "false", and ModeReplay
+// otherwise.
+func ModeFromEnv() Mode {
+	switch strings.ToLower(os.Getenv(RecordEnvVar)) {
+	case "", "0", "false":
+		return ModeReplay
+	default:
+		return ModeRecord
+	}
+}
+
+// HTTPClient is the interface of an http client that is compatible with
+// *http.Client.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Options configure a *Recorder.
+type Options struct {
+	// Mode is the mode of the recorder.
+	Mode Mode
+
+	// Client sends requests to the API in record mode. It has to
+	// transparently handle the Site24x7 OAuth flow. Required in record mode.
+	Client HTTPClient
+
+	// SensitiveKeys are JSON keys and query parameters whose values are
+	// scrubbed in addition to rest.DefaultSensitiveKeys.
+	SensitiveKeys []string
+}
+
+// Recorder records and replays HTTP interactions.
+type Recorder struct {
+	path     string
+	mode     Mode
+	client   HTTPClient
+	redactor *rest.Redactor
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New creates a new *Recorder for the cassette at path. In replay mode the
+// cassette has to exist. In record mode it is overwritten by Stop.
+func New(path string, options Options) (*Recorder, error) {
+	r := &Recorder{
+		path:     path,
+		mode:     options.Mode,
+		client:   options.Client,
+		redactor: rest.NewRedactor(options.SensitiveKeys...),
+		cassette: &Cassette{},
+	}
+
+	switch r.mode {
+	case ModeRecord:
+		if r.client == nil {
+			return nil, errors.New("recorder: a client is required in record mode")
+		}
+	case ModeReplay:
+		cassette, err := LoadCassette(path)
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("recorder: cassette %s does not exist, record it by setting %s=1", path, RecordEnvVar)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		r.cassette = cassette
+		r.used = make([]bool, len(cassette.Interactions))
+	default:
+		return nil, fmt.Errorf("recorder: unknown mode %d", r.mode)
+	}
+
+	return r, nil
+}
+
+// Do implements HTTPClient.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := &RecordedRequest{
+		Method: req.Method,
+		URL:    r.redactor.URL(relativeURL(req)),
+		Body:   r.scrub(body),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	return r.record(req, recorded)
+}
+
+func (r *Recorder) replay(req *http.Request, recorded *RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !interaction.Request.matches(recorded) {
+			continue
+		}
+
+		r.used[i] = true
+		return interaction.Response.httpResponse(req), nil
+	}
+
+	return nil, fmt.Errorf("recorder: no interaction in cassette %s matches %s %s %s",
+		r.path, recorded.Method, recorded.URL, recorded.Body)
+}
+
+func (r *Recorder) record(req *http.Request, recorded *RecordedRequest) (*http.Response, error) {
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	header := make(map[string]string)
+	for _, key := range recordedHeaders {
+		if value := resp.Header.Get(key); value != "" {
+			header[key] = value
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: recorded,
+		Response: &RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       r.scrub(body),
+		},
+	})
+
+	return resp, nil
+}
+
+// Stop finishes the recording. In record mode it writes the cassette. In
+// replay mode it returns an error if not all recorded interactions were
+// replayed, as this indicates that the cassette is out of date.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeRecord {
+		return r.cassette.Save(r.path)
+	}
+
+	var unused []string
+	for i, interaction := range r.cassette.Interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction.Request.Method+" "+interaction.Request.URL)
+		}
+	}
+
+	if len(unused) > 0 {
+		return fmt.Errorf("recorder: %d interactions of cassette %s were not replayed: %s",
+			len(unused), r.path, strings.Join(unused, ", "))
+	}
+
+	return nil
+}
+
+// scrub masks secrets in a request or response body.
+func (r *Recorder) scrub(body []byte) Body {
+	if len(body) == 0 {
+		return nil
+	}
+
+	return Body(r.redactor.Body(body))
+}
+
+// readBody reads the body of req and replaces it so that it can be sent
+// again.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return body, nil
+}
+
+// relativeURL returns the URL of req without scheme and host.
+func relativeURL(req *http.Request) *url.URL {
+	return &url.URL{
+		Path:     req.URL.Path,
+		RawPath:  req.URL.RawPath,
+		RawQuery: req.URL.RawQuery,
+	}
+}
@@ -0,0 +1,148 @@
+package recorder
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/site24x7/terraform-provider-site24x7/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Zoho-oauthtoken 1000.secret-access-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.Header().Set("Set-Cookie", "session=secret-session")
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/integration/webhooks":
+			w.Write([]byte(`{"code":0,"message":"success","data":{"service_id":"123","name":"hook","auth_pass":"secret-password"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/device_key":
+			w.Write([]byte(`{"code":0,"message":"success","data":{"device_key":"secret-device-key"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_code":1002,"message":"not found"}`))
+		}
+	}))
+}
+
+// authClient mimics the authenticated client used in record mode.
+type authClient struct{}
+
+func (authClient) Do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "Zoho-oauthtoken 1000.secret-access-token")
+	return http.DefaultClient.Do(req)
+}
+
+func run(t *testing.T, c rest.Client) (webhook, deviceKey map[string]interface{}, notFound error) {
+	webhook = map[string]interface{}{}
+	err := c.Post().
+		Resource("integration/webhooks").
+		AddHeader("Content-Type", "application/json;charset=UTF-8").
+		Body(map[string]interface{}{"name": "hook", "auth_pass": "secret-password"}).
+		Do().
+		Parse(&webhook)
+	require.NoError(t, err)
+
+	deviceKey = map[string]interface{}{}
+	err = c.Get().
+		Resource("device_key").
+		QueryParams(&struct {
+			Token string `url:"token"`
+		}{Token: "secret-query-token"}).
+		Do().
+		Parse(&deviceKey)
+	require.NoError(t, err)
+
+	notFound = c.Get().Resource("tags").ResourceID("456").Do().Err()
+
+	return webhook, deviceKey, notFound
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	server := newServer(t)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	rec, err := New(path, Options{Mode: ModeRecord, Client: authClient{}})
+	require.NoError(t, err)
+
+	webhook, deviceKey, notFoundErr := run(t, rest.NewClient(rec, rest.ClientConfig{APIBaseURL: server.URL + "/api"}))
+	require.NoError(t, rec.Stop())
+
+	// The client sees the real responses while recording.
+	assert.Equal(t, "secret-password", webhook["auth_pass"])
+	assert.Equal(t, "secret-device-key", deviceKey["device_key"])
+	require.Error(t, notFoundErr)
+
+	buf, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{"secret-password", "secret-device-key", "secret-query-token", "secret-access-token", "secret-session", "Authorization"} {
+		assert.NotContains(t, string(buf), secret)
+	}
+
+	// Replay works without the server and against any base URL.
+	server.Close()
+
+	rec, err = New(path, Options{Mode: ModeReplay})
+	require.NoError(t, err)
+
+	webhook, deviceKey, replayedErr := run(t, rest.NewClient(rec, rest.ClientConfig{APIBaseURL: "https://www.site24x7.eu/api"}))
+	require.NoError(t, rec.Stop())
+
+	assert.Equal(t, "123", webhook["service_id"])
+	assert.Equal(t, "<redacted>", webhook["auth_pass"])
+	assert.Equal(t, "<redacted>", deviceKey["device_key"])
+	assert.Equal(t, notFoundErr.Error(), replayedErr.Error())
+}
+
+func TestReplayUnmatchedRequest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	cassette := &Cassette{
+		Interactions: []*Interaction{
+			{
+				Request:  &RecordedRequest{Method: "POST", URL: "/api/tags", Body: Body(`{"tag_name":"foo"}`)},
+				Response: &RecordedResponse{StatusCode: 200, Body: Body(`{"code":0,"message":"success","data":{"tag_id":"1"}}`)},
+			},
+		},
+	}
+	require.NoError(t, cassette.Save(path))
+
+	rec, err := New(path, Options{Mode: ModeReplay})
+	require.NoError(t, err)
+
+	c := rest.NewClient(rec, rest.ClientConfig{APIBaseURL: "https://www.site24x7.com/api"})
+
+	err = c.Post().Resource("tags").Body(map[string]string{"tag_name": "bar"}).Do().Err()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no interaction in cassette")
+
+	err = rec.Stop()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "POST /api/tags")
+
+	// Interactions are replayed only once.
+	rec, err = New(path, Options{Mode: ModeReplay})
+	require.NoError(t, err)
+
+	c = rest.NewClient(rec, rest.ClientConfig{APIBaseURL: "https://www.site24x7.com/api"})
+	require.NoError(t, c.Post().Resource("tags").Body(map[string]string{"tag_name": "foo"}).Do().Err())
+	require.Error(t, c.Post().Resource("tags").Body(map[string]string{"tag_name": "foo"}).Do().Err())
+	require.NoError(t, rec.Stop())
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), Options{Mode: ModeReplay})
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), RecordEnvVar), err.Error())
+
+	_, err = New(filepath.Join(t.TempDir(), "cassette.json"), Options{Mode: ModeRecord})
+	require.Error(t, err)
+}
@@ -1,6 +1,10 @@
 package rest
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"time"
+)
 
 // Client is the interface of a rest client that can build requests for the
 // different http verbs.
@@ -10,6 +14,15 @@ type Client interface {
 	Get() *Request
 	Put() *Request
 	Delete() *Request
+
+	// WithContext returns a copy of the client whose requests are bound to
+	// ctx. Cancelling ctx aborts in-flight requests.
+	WithContext(ctx context.Context) Client
+
+	// WithZAAID returns a copy of the client whose requests are sent on
+	// behalf of the MSP customer with the given zaaid. An empty zaaid
+	// restores the zaaid of the client config.
+	WithZAAID(zaaid string) Client
 }
 
 type ClientConfig struct {
@@ -18,6 +31,15 @@ type ClientConfig struct {
 	TokenURL   string
 	ZAAID      string
 	MSP        bool
+
+	// Timeout is the default maximum duration of a request including all
+	// retries. Zero means no timeout. It can be overridden per request via
+	// (*Request).Timeout.
+	Timeout time.Duration
+
+	// SensitiveKeys are JSON keys whose values are masked in debug logs in
+	// addition to DefaultSensitiveKeys.
+	SensitiveKeys []string
 }
 
 // HTTPClient is the interface of an http client that is compatible with
@@ -29,6 +51,8 @@ type HTTPClient interface {
 type client struct {
 	config     ClientConfig
 	httpClient HTTPClient
+	ctx        context.Context
+	zaaid      string
 }
 
 // New Client creates a new REST Client.
@@ -43,7 +67,14 @@ func NewClient(httpClient HTTPClient, clientConfig ClientConfig) Client {
 // or 'DELETE'.
 func (c *client) Verb(verb string) *Request {
 	c.config.Verb = verb
-	return NewRequest(c.httpClient, c.config)
+	r := NewRequest(c.httpClient, c.config)
+	if c.ctx != nil {
+		r.Context(c.ctx)
+	}
+	if c.zaaid != "" {
+		r.ZAAID(c.zaaid)
+	}
+	return r
 }
 
 // Get creates a new HTTP GET request.
@@ -65,3 +96,17 @@ func (c *client) Put() *Request {
 func (c *client) Delete() *Request {
 	return c.Verb("DELETE")
 }
+
+// WithContext implements Client.
+func (c *client) WithContext(ctx context.Context) Client {
+	c2 := *c
+	c2.ctx = ctx
+	return &c2
+}
+
+// WithZAAID implements Client.
+func (c *client) WithZAAID(zaaid string) Client {
+	c2 := *c
+	c2.zaaid = zaaid
+	return &c2
+}
@@ -1,9 +1,11 @@
 package rest
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClientVerbs(t *testing.T) {
@@ -21,3 +23,35 @@ func TestClientVerbs(t *testing.T) {
 	assert.Equal(t, "DELETE", c.Delete().verb)
 	assert.Equal(t, "OPTIONS", c.Verb("OPTIONS").verb)
 }
+
+func TestClientWithZAAID(t *testing.T) {
+	clientConfig := ClientConfig{
+		APIBaseURL: "http://localhost/api",
+		ZAAID:      "123",
+		MSP:        true,
+	}
+
+	c := NewClient(&fakeHTTPClient{}, clientConfig)
+
+	zaaidOf := func(r *Request) string {
+		req, err := r.buildRequest(context.Background())
+		require.NoError(t, err)
+
+		cookie, err := req.Cookie("zaaid")
+		if err != nil {
+			return ""
+		}
+		return cookie.Value
+	}
+
+	customer := c.WithZAAID("456")
+
+	assert.Equal(t, "456", zaaidOf(customer.Get()))
+	assert.Equal(t, "456", zaaidOf(customer.WithContext(context.Background()).Get()))
+	assert.Equal(t, "123", zaaidOf(c.Get()))
+	assert.Equal(t, "123", zaaidOf(customer.WithZAAID("").Get()))
+
+	// The zaaid can be overridden per request.
+	assert.Equal(t, "789", zaaidOf(c.Get().ZAAID("789")))
+	assert.Equal(t, "", zaaidOf(c.Get().ZAAID("")))
+}
@@ -0,0 +1,266 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/site24x7/terraform-provider-site24x7/backoff"
+)
+
+const (
+	// minRequestsPerSecond is the lower bound the adaptive rate will never
+	// drop below, even when the API keeps asking us to slow down.
+	minRequestsPerSecond = 0.1
+
+	// rateRecoverySteps is the number of successful responses it takes to
+	// recover from a throttled rate back to the configured rate.
+	rateRecoverySteps = 20
+)
+
+// RateLimitConfig configures the client-side rate limiting.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the steady rate at which requests are allowed to
+	// be sent. A value <= 0 disables the token bucket.
+	RequestsPerSecond float64
+
+	// Burst is the maximum number of requests that may be sent at once
+	// before RequestsPerSecond kicks in. Defaults to 1 if <= 0.
+	Burst int
+
+	// MaxInFlight is the maximum number of concurrent requests. A value <= 0
+	// disables the limit.
+	MaxInFlight int
+}
+
+// RateLimiter is a token-bucket rate limiter combined with a semaphore for
+// the number of requests in flight. It is safe for concurrent use and is
+// meant to be shared by all requests of a provider instance. The rate adapts
+// to throttling signals of the API: it is halved and paused for the duration
+// of the Retry-After header whenever the API responds with 429 and slowly
+// recovers on successful responses.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	maxRate      float64
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	pausedUntil  time.Time
+	lastThrottle time.Time
+
+	inFlight chan struct{}
+
+	now func() time.Time
+}
+
+// NewRateLimiter creates a new *RateLimiter from config.
+func NewRateLimiter(config RateLimitConfig) *RateLimiter {
+	burst := float64(config.Burst)
+	if burst <= 0 {
+		burst = 1
+	}
+
+	l := &RateLimiter{
+		maxRate: config.RequestsPerSecond,
+		rate:    config.RequestsPerSecond,
+		burst:   burst,
+		tokens:  burst,
+		now:     time.Now,
+	}
+
+	if config.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, config.MaxInFlight)
+	}
+
+	l.last = l.now()
+
+	return l
+}
+
+// Acquire blocks until a request may be sent or ctx is done. On success, the
+// returned release func must be called once the request completed.
+func (l *RateLimiter) Acquire(ctx context.Context) (func(), error) {
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	release := func() {
+		if l.inFlight != nil {
+			<-l.inFlight
+		}
+	}
+
+	if err := l.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// wait blocks until a token is available in the bucket.
+func (l *RateLimiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve takes a token from the bucket if one is available. Otherwise it
+// returns the duration to wait before trying again.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+
+	if l.maxRate <= 0 {
+		return 0
+	}
+
+	elapsed := now.Sub(l.last).Seconds()
+	l.last = now
+	l.tokens = math.Min(l.burst, l.tokens+elapsed*l.rate)
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	missing := 1 - l.tokens
+
+	return time.Duration(missing / l.rate * float64(time.Second))
+}
+
+// Observe adapts the rate based on resp. If the API signals throttling via
+// status code 429 or a Retry-After header, all requests are paused for the
+// advertised duration and the rate is halved. Successful responses slowly
+// restore the configured rate.
+func (l *RateLimiter) Observe(resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	retryAfter, hasRetryAfter := backoff.RetryAfter(resp)
+	throttled := resp.StatusCode == http.StatusTooManyRequests || (hasRetryAfter && retryAfter > 0)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	if !throttled {
+		if resp.StatusCode < 400 && l.rate < l.maxRate {
+			l.rate = math.Min(l.maxRate, l.rate+l.maxRate/rateRecoverySteps)
+		}
+		return
+	}
+
+	if hasRetryAfter && retryAfter > 0 {
+		if until := now.Add(retryAfter); until.After(l.pausedUntil) {
+			l.pausedUntil = until
+		}
+	}
+
+	// Multiple in-flight requests usually hit the limit at the same time.
+	// Only slow down once per throttling window to avoid collapsing the
+	// rate to the minimum.
+	window := retryAfter
+	if window < time.Second {
+		window = time.Second
+	}
+
+	if l.maxRate > 0 && now.Sub(l.lastThrottle) >= window {
+		l.rate = math.Max(minRequestsPerSecond, l.rate/2)
+		l.tokens = math.Min(l.tokens, 0)
+		l.lastThrottle = now
+
+		log.Debugf("[rate limit] API is throttling requests, reducing rate to %.2f requests/s", l.rate)
+	}
+}
+
+// Rate returns the current number of requests per second allowed by l.
+func (l *RateLimiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate
+}
+
+// RateLimitedTransport is an http.RoundTripper that gates every round trip
+// through a *RateLimiter. Placed below a retrying client, it throttles each
+// attempt individually and holds no in-flight slot while the retrying client
+// backs off between attempts.
+type RateLimitedTransport struct {
+	// Limiter throttles the round trips.
+	Limiter *RateLimiter
+
+	// Base is the underlying http.RoundTripper. If nil,
+	// http.DefaultTransport is used.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper. The in-flight slot is held until
+// the response body is closed, so that MaxInFlight bounds the transfers and
+// not only the time until the response headers arrive.
+func (t *RateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.Limiter.Acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	t.Limiter.Observe(resp)
+
+	if err != nil || resp == nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+// releasingBody releases an in-flight slot once the body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+// Close implements io.Closer.
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,142 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestRateLimiter(config RateLimitConfig) (*RateLimiter, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := NewRateLimiter(config)
+	l.now = clock.Now
+	l.last = clock.Now()
+	return l, clock
+}
+
+func TestRateLimiterBurstAndRefill(t *testing.T) {
+	l, clock := newTestRateLimiter(RateLimitConfig{RequestsPerSecond: 2, Burst: 2})
+
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, 500*time.Millisecond, l.reserve())
+
+	clock.Advance(500 * time.Millisecond)
+
+	assert.Equal(t, time.Duration(0), l.reserve())
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	l, _ := newTestRateLimiter(RateLimitConfig{})
+
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, time.Duration(0), l.reserve())
+	}
+}
+
+func TestRateLimiterObserveRetryAfter(t *testing.T) {
+	l, clock := newTestRateLimiter(RateLimitConfig{RequestsPerSecond: 10, Burst: 10})
+
+	resp := &http.Response{StatusCode: 429, Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+
+	l.Observe(resp)
+
+	assert.Equal(t, 5.0, l.Rate())
+	assert.Equal(t, 3*time.Second, l.reserve())
+
+	// concurrent requests that were throttled in the same window do not
+	// reduce the rate any further.
+	l.Observe(resp)
+	assert.Equal(t, 5.0, l.Rate())
+
+	clock.Advance(3 * time.Second)
+
+	assert.Equal(t, time.Duration(0), l.reserve())
+
+	for i := 0; i < rateRecoverySteps; i++ {
+		l.Observe(&http.Response{StatusCode: 200})
+	}
+
+	assert.Equal(t, 10.0, l.Rate())
+}
+
+func TestRateLimiterMaxInFlight(t *testing.T) {
+	l, _ := newTestRateLimiter(RateLimitConfig{MaxInFlight: 1})
+
+	release, err := l.Acquire(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = l.Acquire(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	release()
+
+	release, err = l.Acquire(context.Background())
+	require.NoError(t, err)
+	release()
+}
+
+func TestRateLimitedTransport(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	limiter, clock := newTestRateLimiter(RateLimitConfig{RequestsPerSecond: 4, Burst: 1, MaxInFlight: 1})
+
+	c := &http.Client{Transport: &RateLimitedTransport{Limiter: limiter}}
+
+	resp, err := c.Get(server.URL)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 2.0, limiter.Rate())
+
+	clock.Advance(2 * time.Second)
+
+	// The in-flight slot is held until the response body is closed.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = limiter.Acquire(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	resp.Body.Close()
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	release, err := limiter.Acquire(ctx)
+	require.NoError(t, err)
+	release()
+}
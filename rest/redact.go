@@ -0,0 +1,151 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// redacted replaces sensitive values in logged requests and responses.
+const redacted = "<redacted>"
+
+// DefaultSensitiveKeys are the JSON keys whose values are masked in debug
+// logs. In addition, every key containing "password" or "secret" is treated
+// as sensitive.
+var DefaultSensitiveKeys = []string{
+	"access_token",
+	"api_key",
+	"auth_pass",
+	"client_secret",
+	"device_key",
+	"password",
+	"private_key",
+	"refresh_token",
+	"service_key",
+	"token",
+}
+
+// sensitiveHeaders are the HTTP headers whose values are masked in debug
+// logs. They are also matched against the names of custom headers in request
+// bodies, e.g. custom_headers of website monitors and webhooks.
+var sensitiveHeaders = map[string]bool{
+	"authorization":       true,
+	"cookie":              true,
+	"proxy-authorization": true,
+	"set-cookie":          true,
+}
+
+// Redactor masks secrets in request and response data before it is logged.
+type Redactor struct {
+	keys map[string]bool
+}
+
+// NewRedactor creates a new *Redactor which masks the values of
+// DefaultSensitiveKeys and extraKeys. Keys are matched case-insensitively.
+func NewRedactor(extraKeys ...string) *Redactor {
+	keys := make(map[string]bool, len(DefaultSensitiveKeys)+len(extraKeys))
+	for _, key := range DefaultSensitiveKeys {
+		keys[key] = true
+	}
+	for _, key := range extraKeys {
+		keys[strings.ToLower(key)] = true
+	}
+
+	return &Redactor{keys: keys}
+}
+
+// Body returns body with the values of all sensitive JSON keys masked. Bodies
+// that are not valid JSON are returned unchanged.
+func (r *Redactor) Body(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return string(body)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return string(body)
+	}
+
+	buf, err := json.Marshal(r.redactValue(v))
+	if err != nil {
+		return redacted
+	}
+
+	return string(buf)
+}
+
+// Header returns a copy of header with the values of sensitive headers
+// masked.
+func (r *Redactor) Header(header http.Header) http.Header {
+	out := make(http.Header, len(header))
+	for key, values := range header {
+		if r.sensitiveHeader(key) {
+			out[key] = []string{redacted}
+			continue
+		}
+		out[key] = values
+	}
+
+	return out
+}
+
+// URL returns u as string with the values of sensitive query parameters
+// masked.
+func (r *Redactor) URL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+
+	query := u.Query()
+	for key := range query {
+		if r.sensitiveKey(key) {
+			query.Set(key, redacted)
+		}
+	}
+
+	u2 := *u
+	u2.RawQuery = query.Encode()
+
+	return u2.String()
+}
+
+func (r *Redactor) redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		// Custom headers are encoded as {"name": "...", "value": "..."}.
+		if name, ok := v["name"].(string); ok && r.sensitiveHeader(name) {
+			if _, ok := v["value"]; ok {
+				v["value"] = redacted
+			}
+		}
+
+		for key, value := range v {
+			if r.sensitiveKey(key) && value != nil && value != "" {
+				v[key] = redacted
+				continue
+			}
+			v[key] = r.redactValue(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = r.redactValue(value)
+		}
+	}
+
+	return v
+}
+
+func (r *Redactor) sensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+
+	return r.keys[key] || strings.Contains(key, "password") || strings.Contains(key, "secret")
+}
+
+func (r *Redactor) sensitiveHeader(name string) bool {
+	return sensitiveHeaders[strings.ToLower(name)]
+}
@@ -0,0 +1,175 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactorBody(t *testing.T) {
+	r := NewRedactor("Custom_Field")
+
+	body := r.Body([]byte(`{
+		"display_name": "foo",
+		"password": "hunter2",
+		"custom_field": "extra",
+		"client_certificate_password": "cert-pass",
+		"nested": [{"refresh_token": "1000.abc"}, {"name": "Authorization", "value": "Bearer xyz"}],
+		"empty_password": "",
+		"count": 12345678901234567890
+	}`))
+
+	assert.NotContains(t, body, "hunter2")
+	assert.NotContains(t, body, "extra")
+	assert.NotContains(t, body, "cert-pass")
+	assert.NotContains(t, body, "1000.abc")
+	assert.NotContains(t, body, "Bearer xyz")
+	assert.Contains(t, body, `"display_name":"foo"`)
+	assert.Contains(t, body, `"empty_password":""`)
+	assert.Contains(t, body, `"count":12345678901234567890`)
+}
+
+func TestRedactorBodyPassesThroughNonJSON(t *testing.T) {
+	r := NewRedactor()
+
+	assert.Equal(t, "", r.Body(nil))
+	assert.Equal(t, "<html>oops</html>", r.Body([]byte("<html>oops</html>")))
+}
+
+func TestRedactorHeader(t *testing.T) {
+	r := NewRedactor()
+
+	header := http.Header{}
+	header.Set("Authorization", "Zoho-oauthtoken 1000.secret")
+	header.Set("Cookie", "zaaid=123")
+	header.Set("Accept", "application/json; version=2.1")
+
+	redactedHeader := r.Header(header)
+
+	assert.Equal(t, redacted, redactedHeader.Get("Authorization"))
+	assert.Equal(t, redacted, redactedHeader.Get("Cookie"))
+	assert.Equal(t, "application/json; version=2.1", redactedHeader.Get("Accept"))
+	assert.Equal(t, "Zoho-oauthtoken 1000.secret", header.Get("Authorization"))
+}
+
+func TestRedactorURL(t *testing.T) {
+	r := NewRedactor()
+
+	u, err := url.Parse("https://www.site24x7.com/api/foos?access_token=abc&name=bar")
+	require.NoError(t, err)
+
+	redactedURL := r.URL(u)
+
+	assert.NotContains(t, redactedURL, "abc")
+	assert.Contains(t, redactedURL, "name=bar")
+}
+
+func TestRequestDoDoesNotLogSecrets(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    interface{}
+		secrets []string
+	}{
+		{
+			name: "credential profile",
+			body: &api.CredentialProfile{
+				CredentialType: 3,
+				CredentialName: "profile",
+				UserName:       "user",
+				Password:       "credential-profile-password",
+			},
+			secrets: []string{"credential-profile-password"},
+		},
+		{
+			name: "url action",
+			body: &api.URLAction{
+				ActionName:     "action",
+				ActionUrl:      "https://example.com",
+				AuthMethod:     "B",
+				Username:       "user",
+				Password:       "url-action-password",
+				OAuth2Provider: "123",
+			},
+			secrets: []string{"url-action-password"},
+		},
+		{
+			name: "webhook integration",
+			body: &api.WebhookIntegration{
+				Name:       "webhook",
+				URL:        "https://example.com",
+				AuthMethod: "B",
+				UserName:   "user",
+				Password:   "webhook-password",
+				CustomHeaders: []api.Header{
+					{Name: "Authorization", Value: "Bearer webhook-header-token"},
+					{Name: "X-Foo", Value: "bar"},
+				},
+			},
+			secrets: []string{"webhook-password", "webhook-header-token"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			defer captureLog(&buf)()
+
+			respBody, err := json.Marshal(map[string]interface{}{
+				"code":    0,
+				"message": "success",
+				"data":    test.body,
+			})
+			require.NoError(t, err)
+
+			c := newFakeHTTPClient().
+				WithStatusCode(200).
+				WithResponseBody(respBody)
+
+			clientConfig := ClientConfig{
+				Verb:  "POST",
+				MSP:   true,
+				ZAAID: "123",
+			}
+
+			err = NewRequest(c, clientConfig).
+				Resource("foos").
+				AddHeader("Authorization", "Zoho-oauthtoken 1000.access-token").
+				Body(test.body).
+				Do().
+				Err()
+			require.NoError(t, err)
+
+			logged := buf.String()
+
+			assert.Contains(t, logged, "<== POST")
+			assert.Contains(t, logged, "==> 200")
+			assert.NotContains(t, logged, "1000.access-token")
+			assert.NotContains(t, logged, "zaaid=123")
+			for _, secret := range test.secrets {
+				assert.NotContains(t, logged, secret)
+			}
+		})
+	}
+}
+
+// captureLog redirects debug logs to buf. The returned func restores the
+// previous logger settings.
+func captureLog(buf *bytes.Buffer) func() {
+	level := log.GetLevel()
+	out := log.StandardLogger().Out
+
+	log.SetLevel(log.DebugLevel)
+	log.SetOutput(buf)
+
+	return func() {
+		log.SetLevel(level)
+		log.SetOutput(out)
+	}
+}
@@ -2,21 +2,28 @@ package rest
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	log "github.com/sirupsen/logrus"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+	"github.com/site24x7/terraform-provider-site24x7/backoff"
 )
 
 // Request is a wrapper for preparing and sending a *http.Request. It provides
 // funtionality for encoding arbitrary types to the wire format and back.
 type Request struct {
+	ctx        context.Context
+	timeout    time.Duration
 	client     HTTPClient
 	baseURL    string
 	resource   string
@@ -24,6 +31,7 @@ type Request struct {
 	query      url.Values
 	header     http.Header
 	cookie     *http.Cookie
+	redactor   *Redactor
 	verb       string
 	body       []byte
 	err        error
@@ -33,19 +41,46 @@ type Request struct {
 // *http.Request.
 func NewRequest(client HTTPClient, config ClientConfig) *Request {
 	r := &Request{
-		client:  client,
-		baseURL: config.APIBaseURL,
-		verb:    config.Verb,
+		client:   client,
+		baseURL:  config.APIBaseURL,
+		verb:     config.Verb,
+		timeout:  config.Timeout,
+		redactor: NewRedactor(config.SensitiveKeys...),
 	}
 
 	if config.MSP {
-		r.cookie = &http.Cookie{Name: "zaaid", Value: config.ZAAID}
+		r.ZAAID(config.ZAAID)
 	}
 	r.AddHeader("Accept", "application/json; version=2.1")
 	r.AddHeader("User-Agent", "S24x7TerraformProvider/1.0.0")
 	return r
 }
 
+// ZAAID sets the zaaid cookie which makes the request act on behalf of the
+// MSP customer with the given account ID. An empty zaaid removes the cookie.
+func (r *Request) ZAAID(zaaid string) *Request {
+	r.cookie = nil
+	if zaaid != "" {
+		r.cookie = &http.Cookie{Name: "zaaid", Value: zaaid}
+	}
+	return r
+}
+
+// Context binds the request to ctx. The request is aborted once ctx is
+// cancelled or its deadline is exceeded.
+func (r *Request) Context(ctx context.Context) *Request {
+	r.ctx = ctx
+	return r
+}
+
+// Timeout sets the maximum duration of the request, including all retries
+// and the time spent waiting in between. A timeout of zero disables it. This
+// overrides the default timeout of the client.
+func (r *Request) Timeout(timeout time.Duration) *Request {
+	r.timeout = timeout
+	return r
+}
+
 // Resource sets the API resource which the request should be built for, e.g.
 // 'monitors'. The resulting API resource path for this would be
 // '/api/monitors'.
@@ -111,7 +146,20 @@ func (r *Request) Do() Response {
 		return Response{err: r.err}
 	}
 
-	req, err := r.buildRequest()
+	ctx := r.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// The deadline is attached to the request before it is handed to the
+	// retrying http client, so it bounds all attempts as a whole.
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	req, err := r.buildRequest(ctx)
 	if err != nil {
 		return Response{err: err}
 	}
@@ -119,7 +167,7 @@ func (r *Request) Do() Response {
 	return r.doRequest(req)
 }
 
-func (r *Request) buildRequest() (*http.Request, error) {
+func (r *Request) buildRequest(ctx context.Context) (*http.Request, error) {
 	url, err := url.Parse(r.buildRawURL())
 	if err != nil {
 		return nil, err
@@ -130,8 +178,16 @@ func (r *Request) buildRequest() (*http.Request, error) {
 		Header: r.header,
 		Body:   ioutil.NopCloser(bytes.NewReader(r.body)),
 		URL:    url,
+		// GetBody allows transports to replay the request, e.g. after
+		// obtaining a new access token.
+		GetBody: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(r.body)), nil
+		},
+		ContentLength: int64(len(r.body)),
 	}
 
+	req = req.WithContext(ctx)
+
 	if r.cookie != nil {
 		req.AddCookie(r.cookie)
 	}
@@ -144,10 +200,47 @@ func (r *Request) buildRequest() (*http.Request, error) {
 }
 
 func (r *Request) doRequest(req *http.Request) Response {
-	log.Debugf("<== %s %s %s %s", req.Method, req.URL, req.Header.Get("Accept"), string(r.body))
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.Debugf("<== %s %s %v %s", req.Method, r.redactor.URL(req.URL), r.redactor.Header(req.Header), r.redactor.Body(r.body))
+	}
 
 	resp, err := r.client.Do(req)
+
+	// The retrying client hands out the response of the last attempt
+	// alongside a *backoff.RetryError once it gives up. Decode the error
+	// response so that callers still get a StatusError, annotated with the
+	// number of attempts.
+	var retryErr *backoff.RetryError
+	if err != nil && resp != nil && errors.As(err, &retryErr) && retryErr.Err == nil {
+		defer resp.Body.Close()
+
+		body, _ := ioutil.ReadAll(resp.Body)
+
+		if log.IsLevelEnabled(log.DebugLevel) {
+			log.Debugf("==> %d: %s", resp.StatusCode, r.redactor.Body(body))
+		}
+
+		return Response{err: &backoff.RetryError{
+			Attempts: retryErr.Attempts,
+			Err:      createStatusError(resp.StatusCode, body),
+		}}
+	}
+
 	if err != nil {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		// The *url.Error returned by the http client includes the URL, which
+		// may carry secrets in its query.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+				urlErr.URL = r.redactor.URL(u)
+			}
+		}
+		if r.timeout > 0 && errors.Is(req.Context().Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("%s %s timed out after %s: %w", req.Method, r.redactor.URL(req.URL), r.timeout, err)
+		}
 		return Response{err: err}
 	}
 
@@ -155,10 +248,12 @@ func (r *Request) doRequest(req *http.Request) Response {
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return Response{err: err}
+		return Response{err: fmt.Errorf("%s %s: failed to read response body: %w", req.Method, r.redactor.URL(req.URL), err)}
 	}
 
-	log.Debugf("==> %d: %s", resp.StatusCode, string(body))
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.Debugf("==> %d: %s", resp.StatusCode, r.redactor.Body(body))
+	}
 
 	if resp.StatusCode > 0 && resp.StatusCode < 400 {
 		return Response{body: body}
@@ -2,12 +2,17 @@ package rest
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+	"github.com/site24x7/terraform-provider-site24x7/backoff"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -87,7 +92,7 @@ func TestRequestBuildRequest(t *testing.T) {
 		ResourceID("123").
 		Body(body)
 
-	req, err := r.buildRequest()
+	req, err := r.buildRequest(context.Background())
 
 	require.NoError(t, err)
 
@@ -99,6 +104,15 @@ func TestRequestBuildRequest(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, `{"foo":"bar"}`, string(buf))
+
+	body2, err := req.GetBody()
+	require.NoError(t, err)
+
+	buf, err = ioutil.ReadAll(body2)
+
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"foo":"bar"}`, string(buf))
 }
 
 func TestRequestDo(t *testing.T) {
@@ -168,6 +182,111 @@ func TestRequestDoFallsBackToStatusErrorIfErrorResponseBodyIsInvalid(t *testing.
 	assert.Equal(t, expectedErr, err)
 }
 
+func TestRequestDoTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	clientConfig := ClientConfig{
+		APIBaseURL: server.URL,
+		Verb:       "GET",
+		Timeout:    time.Minute,
+	}
+
+	start := time.Now()
+
+	err := NewRequest(server.Client(), clientConfig).
+		Resource("foos").
+		QueryParams(struct {
+			AccessToken string `url:"access_token"`
+		}{AccessToken: "secret-token"}).
+		Timeout(50 * time.Millisecond).
+		Do().
+		Err()
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Contains(t, err.Error(), "timed out after 50ms")
+	assert.NotContains(t, err.Error(), "secret-token")
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+}
+
+func TestRequestDoTimeoutCoversRetries(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	httpClient := backoff.WithRetries(server.Client(), &backoff.RetryConfig{
+		MinWait:    100 * time.Millisecond,
+		MaxWait:    100 * time.Millisecond,
+		MaxRetries: 100,
+	})
+
+	clientConfig := ClientConfig{
+		APIBaseURL: server.URL,
+		Verb:       "GET",
+		Timeout:    350 * time.Millisecond,
+	}
+
+	start := time.Now()
+
+	err := NewRequest(httpClient, clientConfig).
+		Resource("foos").
+		Do().
+		Err()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out after 350ms")
+	assert.Less(t, int64(time.Since(start)), int64(2*time.Second))
+	assert.Less(t, atomic.LoadInt32(&attempts), int32(10))
+}
+
+func TestRequestDoReportsAttemptsOfRetriedErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error_code":1015,"message":"please try again later"}`))
+	}))
+	defer server.Close()
+
+	httpClient := backoff.WithRetries(server.Client(), &backoff.RetryConfig{
+		MinWait:    time.Millisecond,
+		MaxWait:    time.Millisecond,
+		MaxRetries: 2,
+	})
+
+	err := NewRequest(httpClient, ClientConfig{APIBaseURL: server.URL, Verb: "GET"}).
+		Resource("foos").
+		Do().
+		Err()
+
+	require.Error(t, err)
+	assert.Equal(t, "giving up after 3 attempts due to: please try again later", err.Error())
+	assert.True(t, apierrors.HasStatusCode(err, http.StatusServiceUnavailable))
+
+	code, ok := apierrors.ErrorCode(err)
+	assert.True(t, ok)
+	assert.Equal(t, 1015, code)
+}
+
+func TestRequestDoHonorsContextCancellation(t *testing.T) {
+	c := newFakeHTTPClient().WithStatusCode(200)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	NewRequest(c, ClientConfig{Verb: "GET"}).
+		Context(ctx).
+		Resource("foos").
+		Do()
+
+	require.Len(t, c.calledWith, 1)
+	assert.Equal(t, context.Canceled, c.calledWith[0].Context().Err())
+}
+
 type fakeHTTPClient struct {
 	resp       *http.Response
 	err        error
@@ -1,7 +1,10 @@
 package aws
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
 )
@@ -10,18 +13,18 @@ var awsExternalIDDataSourceSchema = map[string]*schema.Schema{}
 
 func DataSourceSite24x7AWSExternalID() *schema.Resource {
 	return &schema.Resource{
-		Read:   awsExternalIDDataSourceRead,
-		Schema: awsExternalIDDataSourceSchema,
+		ReadContext: awsExternalIDDataSourceRead,
+		Schema:      awsExternalIDDataSourceSchema,
 	}
 }
 
 // awsExternalIDDataSourceRead fetches AWS External ID from Site24x7
-func awsExternalIDDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func awsExternalIDDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	awsExternalID, err := client.AWSExternalID().Get()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateAWSExternalIDDataSourceResourceData(d, awsExternalID)
@@ -0,0 +1,430 @@
+package site24x7
+
+import (
+	"context"
+	"sync"
+
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
+)
+
+// Keys of the lookup cache entries.
+const (
+	locationProfilesKey     = "location_profiles"
+	notificationProfilesKey = "notification_profiles"
+	thresholdProfilesKey    = "threshold_profiles"
+	userGroupsKey           = "user_groups"
+	tagsKey                 = "tags"
+	monitorGroupsKey        = "monitor_groups"
+	locationTemplateKey     = "location_template"
+)
+
+// lookupCache memoizes the results of list calls which are needed to resolve
+// profile, group and tag references of nearly every monitor. It lives as long
+// as the client, i.e. for the duration of a provider run. Entries are
+// invalidated whenever the client creates, updates or deletes an entity of the
+// listed kind, so changes made outside of the provider during a run are not
+// picked up.
+type lookupCache struct {
+	mu      sync.Mutex
+	entries map[string]*lookupCacheEntry
+}
+
+type lookupCacheEntry struct {
+	mu     sync.Mutex
+	value  interface{}
+	filled bool
+}
+
+func newLookupCache() *lookupCache {
+	return &lookupCache{
+		entries: make(map[string]*lookupCacheEntry),
+	}
+}
+
+// entry returns the entry for key, creating it if necessary.
+func (c *lookupCache) entry(key string) *lookupCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		e = &lookupCacheEntry{}
+		c.entries[key] = e
+	}
+
+	return e
+}
+
+// invalidate drops the entry for key. A list call that is in flight while the
+// entry is dropped does not repopulate the cache.
+func (c *lookupCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// cachedList returns the cached result for key or calls list to obtain it.
+// Concurrent callers wait for a single list call. Errors are not cached. The
+// returned slice is a copy, so callers may modify it without affecting the
+// cache.
+func cachedList[T any](c *lookupCache, key string, list func() ([]T, error)) ([]T, error) {
+	e := c.entry(key)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if !e.filled {
+		value, err := list()
+		if err != nil {
+			return nil, err
+		}
+
+		e.value = value
+		e.filled = true
+	}
+
+	return append([]T(nil), e.value.([]T)...), nil
+}
+
+// customerCaches holds the lookup caches and monitor snapshots of every MSP
+// customer the client acts on behalf of. The empty zaaid denotes the account
+// configured at the provider level.
+type customerCaches struct {
+	mu        sync.Mutex
+	prefetch  bool
+	caches    map[string]*lookupCache
+	snapshots map[string]*monitorSnapshots
+}
+
+func newCustomerCaches(prefetch bool) *customerCaches {
+	return &customerCaches{
+		prefetch:  prefetch,
+		caches:    make(map[string]*lookupCache),
+		snapshots: make(map[string]*monitorSnapshots),
+	}
+}
+
+// forCustomer returns the lookup cache and the monitor snapshots of the
+// customer with zaaid. The snapshots are nil if prefetching is disabled.
+func (c *customerCaches) forCustomer(zaaid string) (*lookupCache, *monitorSnapshots) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cache, ok := c.caches[zaaid]
+	if !ok {
+		cache = newLookupCache()
+		c.caches[zaaid] = cache
+	}
+
+	snapshots, ok := c.snapshots[zaaid]
+	if !ok && c.prefetch {
+		snapshots = newMonitorSnapshots()
+		c.snapshots[zaaid] = snapshots
+	}
+
+	return cache, snapshots
+}
+
+// cachedLocationTemplate caches the monitoring locations, which are needed to
+// validate and resolve the locations of location profiles.
+type cachedLocationTemplate struct {
+	endpoints.LocationTemplate
+	cache *lookupCache
+}
+
+func (c *cachedLocationTemplate) Get() (*api.LocationTemplate, error) {
+	return c.get(c.LocationTemplate.Get)
+}
+
+func (c *cachedLocationTemplate) GetContext(ctx context.Context) (*api.LocationTemplate, error) {
+	return c.get(func() (*api.LocationTemplate, error) {
+		return c.LocationTemplate.GetContext(ctx)
+	})
+}
+
+func (c *cachedLocationTemplate) get(get func() (*api.LocationTemplate, error)) (*api.LocationTemplate, error) {
+	locations, err := cachedList(c.cache, locationTemplateKey, func() ([]*api.Location, error) {
+		template, err := get()
+		if err != nil {
+			return nil, err
+		}
+		return template.Locations, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.LocationTemplate{Locations: locations}, nil
+}
+
+type cachedLocationProfiles struct {
+	endpoints.LocationProfiles
+	cache *lookupCache
+}
+
+func (c *cachedLocationProfiles) List() ([]*api.LocationProfile, error) {
+	return cachedList(c.cache, locationProfilesKey, c.LocationProfiles.List)
+}
+
+func (c *cachedLocationProfiles) ListContext(ctx context.Context) ([]*api.LocationProfile, error) {
+	return cachedList(c.cache, locationProfilesKey, func() ([]*api.LocationProfile, error) {
+		return c.LocationProfiles.ListContext(ctx)
+	})
+}
+
+func (c *cachedLocationProfiles) Create(profile *api.LocationProfile) (*api.LocationProfile, error) {
+	defer c.cache.invalidate(locationProfilesKey)
+	return c.LocationProfiles.Create(profile)
+}
+
+func (c *cachedLocationProfiles) CreateContext(ctx context.Context, profile *api.LocationProfile) (*api.LocationProfile, error) {
+	defer c.cache.invalidate(locationProfilesKey)
+	return c.LocationProfiles.CreateContext(ctx, profile)
+}
+
+func (c *cachedLocationProfiles) Update(profile *api.LocationProfile) (*api.LocationProfile, error) {
+	defer c.cache.invalidate(locationProfilesKey)
+	return c.LocationProfiles.Update(profile)
+}
+
+func (c *cachedLocationProfiles) UpdateContext(ctx context.Context, profile *api.LocationProfile) (*api.LocationProfile, error) {
+	defer c.cache.invalidate(locationProfilesKey)
+	return c.LocationProfiles.UpdateContext(ctx, profile)
+}
+
+func (c *cachedLocationProfiles) Delete(profileID string) error {
+	defer c.cache.invalidate(locationProfilesKey)
+	return c.LocationProfiles.Delete(profileID)
+}
+
+func (c *cachedLocationProfiles) DeleteContext(ctx context.Context, profileID string) error {
+	defer c.cache.invalidate(locationProfilesKey)
+	return c.LocationProfiles.DeleteContext(ctx, profileID)
+}
+
+type cachedNotificationProfiles struct {
+	endpoints.NotificationProfiles
+	cache *lookupCache
+}
+
+func (c *cachedNotificationProfiles) List() ([]*api.NotificationProfile, error) {
+	return cachedList(c.cache, notificationProfilesKey, c.NotificationProfiles.List)
+}
+
+func (c *cachedNotificationProfiles) ListContext(ctx context.Context) ([]*api.NotificationProfile, error) {
+	return cachedList(c.cache, notificationProfilesKey, func() ([]*api.NotificationProfile, error) {
+		return c.NotificationProfiles.ListContext(ctx)
+	})
+}
+
+func (c *cachedNotificationProfiles) Create(profile *api.NotificationProfile) (*api.NotificationProfile, error) {
+	defer c.cache.invalidate(notificationProfilesKey)
+	return c.NotificationProfiles.Create(profile)
+}
+
+func (c *cachedNotificationProfiles) CreateContext(ctx context.Context, profile *api.NotificationProfile) (*api.NotificationProfile, error) {
+	defer c.cache.invalidate(notificationProfilesKey)
+	return c.NotificationProfiles.CreateContext(ctx, profile)
+}
+
+func (c *cachedNotificationProfiles) Update(profile *api.NotificationProfile) (*api.NotificationProfile, error) {
+	defer c.cache.invalidate(notificationProfilesKey)
+	return c.NotificationProfiles.Update(profile)
+}
+
+func (c *cachedNotificationProfiles) UpdateContext(ctx context.Context, profile *api.NotificationProfile) (*api.NotificationProfile, error) {
+	defer c.cache.invalidate(notificationProfilesKey)
+	return c.NotificationProfiles.UpdateContext(ctx, profile)
+}
+
+func (c *cachedNotificationProfiles) Delete(profileID string) error {
+	defer c.cache.invalidate(notificationProfilesKey)
+	return c.NotificationProfiles.Delete(profileID)
+}
+
+func (c *cachedNotificationProfiles) DeleteContext(ctx context.Context, profileID string) error {
+	defer c.cache.invalidate(notificationProfilesKey)
+	return c.NotificationProfiles.DeleteContext(ctx, profileID)
+}
+
+type cachedThresholdProfiles struct {
+	endpoints.ThresholdProfiles
+	cache *lookupCache
+}
+
+func (c *cachedThresholdProfiles) List() ([]*api.ThresholdProfile, error) {
+	return cachedList(c.cache, thresholdProfilesKey, c.ThresholdProfiles.List)
+}
+
+func (c *cachedThresholdProfiles) ListContext(ctx context.Context) ([]*api.ThresholdProfile, error) {
+	return cachedList(c.cache, thresholdProfilesKey, func() ([]*api.ThresholdProfile, error) {
+		return c.ThresholdProfiles.ListContext(ctx)
+	})
+}
+
+func (c *cachedThresholdProfiles) Create(profile *api.ThresholdProfile) (*api.ThresholdProfile, error) {
+	defer c.cache.invalidate(thresholdProfilesKey)
+	return c.ThresholdProfiles.Create(profile)
+}
+
+func (c *cachedThresholdProfiles) CreateContext(ctx context.Context, profile *api.ThresholdProfile) (*api.ThresholdProfile, error) {
+	defer c.cache.invalidate(thresholdProfilesKey)
+	return c.ThresholdProfiles.CreateContext(ctx, profile)
+}
+
+func (c *cachedThresholdProfiles) Update(profile *api.ThresholdProfile) (*api.ThresholdProfile, error) {
+	defer c.cache.invalidate(thresholdProfilesKey)
+	return c.ThresholdProfiles.Update(profile)
+}
+
+func (c *cachedThresholdProfiles) UpdateContext(ctx context.Context, profile *api.ThresholdProfile) (*api.ThresholdProfile, error) {
+	defer c.cache.invalidate(thresholdProfilesKey)
+	return c.ThresholdProfiles.UpdateContext(ctx, profile)
+}
+
+func (c *cachedThresholdProfiles) Delete(profileID string) error {
+	defer c.cache.invalidate(thresholdProfilesKey)
+	return c.ThresholdProfiles.Delete(profileID)
+}
+
+func (c *cachedThresholdProfiles) DeleteContext(ctx context.Context, profileID string) error {
+	defer c.cache.invalidate(thresholdProfilesKey)
+	return c.ThresholdProfiles.DeleteContext(ctx, profileID)
+}
+
+type cachedUserGroups struct {
+	endpoints.UserGroups
+	cache *lookupCache
+}
+
+func (c *cachedUserGroups) List() ([]*api.UserGroup, error) {
+	return cachedList(c.cache, userGroupsKey, c.UserGroups.List)
+}
+
+func (c *cachedUserGroups) ListContext(ctx context.Context) ([]*api.UserGroup, error) {
+	return cachedList(c.cache, userGroupsKey, func() ([]*api.UserGroup, error) {
+		return c.UserGroups.ListContext(ctx)
+	})
+}
+
+func (c *cachedUserGroups) Create(group *api.UserGroup) (*api.UserGroup, error) {
+	defer c.cache.invalidate(userGroupsKey)
+	return c.UserGroups.Create(group)
+}
+
+func (c *cachedUserGroups) CreateContext(ctx context.Context, group *api.UserGroup) (*api.UserGroup, error) {
+	defer c.cache.invalidate(userGroupsKey)
+	return c.UserGroups.CreateContext(ctx, group)
+}
+
+func (c *cachedUserGroups) Update(group *api.UserGroup) (*api.UserGroup, error) {
+	defer c.cache.invalidate(userGroupsKey)
+	return c.UserGroups.Update(group)
+}
+
+func (c *cachedUserGroups) UpdateContext(ctx context.Context, group *api.UserGroup) (*api.UserGroup, error) {
+	defer c.cache.invalidate(userGroupsKey)
+	return c.UserGroups.UpdateContext(ctx, group)
+}
+
+func (c *cachedUserGroups) Delete(groupID string) error {
+	defer c.cache.invalidate(userGroupsKey)
+	return c.UserGroups.Delete(groupID)
+}
+
+func (c *cachedUserGroups) DeleteContext(ctx context.Context, groupID string) error {
+	defer c.cache.invalidate(userGroupsKey)
+	return c.UserGroups.DeleteContext(ctx, groupID)
+}
+
+type cachedTags struct {
+	endpoints.Tags
+	cache *lookupCache
+}
+
+func (c *cachedTags) List() ([]*api.Tag, error) {
+	return cachedList(c.cache, tagsKey, c.Tags.List)
+}
+
+func (c *cachedTags) ListContext(ctx context.Context) ([]*api.Tag, error) {
+	return cachedList(c.cache, tagsKey, func() ([]*api.Tag, error) {
+		return c.Tags.ListContext(ctx)
+	})
+}
+
+func (c *cachedTags) Create(tag *api.Tag) (*api.Tag, error) {
+	defer c.cache.invalidate(tagsKey)
+	return c.Tags.Create(tag)
+}
+
+func (c *cachedTags) CreateContext(ctx context.Context, tag *api.Tag) (*api.Tag, error) {
+	defer c.cache.invalidate(tagsKey)
+	return c.Tags.CreateContext(ctx, tag)
+}
+
+func (c *cachedTags) Update(tag *api.Tag) (*api.Tag, error) {
+	defer c.cache.invalidate(tagsKey)
+	return c.Tags.Update(tag)
+}
+
+func (c *cachedTags) UpdateContext(ctx context.Context, tag *api.Tag) (*api.Tag, error) {
+	defer c.cache.invalidate(tagsKey)
+	return c.Tags.UpdateContext(ctx, tag)
+}
+
+func (c *cachedTags) Delete(tagID string) error {
+	defer c.cache.invalidate(tagsKey)
+	return c.Tags.Delete(tagID)
+}
+
+func (c *cachedTags) DeleteContext(ctx context.Context, tagID string) error {
+	defer c.cache.invalidate(tagsKey)
+	return c.Tags.DeleteContext(ctx, tagID)
+}
+
+type cachedMonitorGroups struct {
+	endpoints.MonitorGroups
+	cache *lookupCache
+}
+
+func (c *cachedMonitorGroups) List() ([]*api.MonitorGroup, error) {
+	return cachedList(c.cache, monitorGroupsKey, c.MonitorGroups.List)
+}
+
+func (c *cachedMonitorGroups) ListContext(ctx context.Context) ([]*api.MonitorGroup, error) {
+	return cachedList(c.cache, monitorGroupsKey, func() ([]*api.MonitorGroup, error) {
+		return c.MonitorGroups.ListContext(ctx)
+	})
+}
+
+func (c *cachedMonitorGroups) Create(group *api.MonitorGroup) (*api.MonitorGroup, error) {
+	defer c.cache.invalidate(monitorGroupsKey)
+	return c.MonitorGroups.Create(group)
+}
+
+func (c *cachedMonitorGroups) CreateContext(ctx context.Context, group *api.MonitorGroup) (*api.MonitorGroup, error) {
+	defer c.cache.invalidate(monitorGroupsKey)
+	return c.MonitorGroups.CreateContext(ctx, group)
+}
+
+func (c *cachedMonitorGroups) Update(group *api.MonitorGroup) (*api.MonitorGroup, error) {
+	defer c.cache.invalidate(monitorGroupsKey)
+	return c.MonitorGroups.Update(group)
+}
+
+func (c *cachedMonitorGroups) UpdateContext(ctx context.Context, group *api.MonitorGroup) (*api.MonitorGroup, error) {
+	defer c.cache.invalidate(monitorGroupsKey)
+	return c.MonitorGroups.UpdateContext(ctx, group)
+}
+
+func (c *cachedMonitorGroups) Delete(groupID string) error {
+	defer c.cache.invalidate(monitorGroupsKey)
+	return c.MonitorGroups.Delete(groupID)
+}
+
+func (c *cachedMonitorGroups) DeleteContext(ctx context.Context, groupID string) error {
+	defer c.cache.invalidate(monitorGroupsKey)
+	return c.MonitorGroups.DeleteContext(ctx, groupID)
+}
@@ -0,0 +1,141 @@
+package site24x7
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCachesListCalls(t *testing.T) {
+	listCalls := 0
+	failList := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/tags":
+			listCalls++
+			if failList {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"code":1,"message":"internal error"}`))
+				return
+			}
+			w.Write([]byte(`{"code":0,"message":"success","data":[{"tag_id":"1","tag_name":"foo"}]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/tags":
+			w.Write([]byte(`{"code":0,"message":"success","data":{"tag_id":"2","tag_name":"bar"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(http.DefaultClient, Config{APIBaseURL: server.URL})
+
+	tags, err := c.Tags().List()
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+
+	// Modifying the result does not affect the cache.
+	tags[0] = nil
+
+	tags, err = WithContext(context.Background(), c).Tags().ListContext(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "foo", tags[0].TagName)
+	assert.Equal(t, 1, listCalls)
+
+	// Other clients have their own cache.
+	_, err = NewClient(http.DefaultClient, Config{APIBaseURL: server.URL}).Tags().List()
+	require.NoError(t, err)
+	assert.Equal(t, 2, listCalls)
+
+	_, err = c.Tags().Create(&api.Tag{TagName: "bar"})
+	require.NoError(t, err)
+
+	// Errors are not cached.
+	failList = true
+	_, err = c.Tags().List()
+	require.Error(t, err)
+	assert.Equal(t, 3, listCalls)
+
+	failList = false
+	_, err = c.Tags().List()
+	require.NoError(t, err)
+	_, err = c.Tags().List()
+	require.NoError(t, err)
+	assert.Equal(t, 4, listCalls)
+}
+
+func TestLookupCacheInvalidateDuringList(t *testing.T) {
+	cache := newLookupCache()
+
+	values, err := cachedList(cache, "foo", func() ([]string, error) {
+		// The result of a list call that raced with a modification must
+		// not be cached.
+		cache.invalidate("foo")
+		return []string{"stale"}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"stale"}, values)
+
+	values, err = cachedList(cache, "foo", func() ([]string, error) {
+		return []string{"fresh"}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fresh"}, values)
+}
+
+func TestClientCachesListCallsPerCustomer(t *testing.T) {
+	listCalls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		zaaid := ""
+		if cookie, err := r.Cookie("zaaid"); err == nil {
+			zaaid = cookie.Value
+		}
+		listCalls[zaaid]++
+		w.Write([]byte(`{"code":0,"message":"success","data":[{"tag_id":"` + zaaid + `"}]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(http.DefaultClient, Config{APIBaseURL: server.URL})
+
+	for i := 0; i < 2; i++ {
+		tags, err := c.Tags().List()
+		require.NoError(t, err)
+		assert.Equal(t, "", tags[0].TagID)
+
+		tags, err = WithZAAID("123", c).Tags().List()
+		require.NoError(t, err)
+		assert.Equal(t, "123", tags[0].TagID)
+
+		tags, err = WithZAAID("456", WithContext(context.Background(), c)).Tags().List()
+		require.NoError(t, err)
+		assert.Equal(t, "456", tags[0].TagID)
+	}
+
+	assert.Equal(t, map[string]int{"": 1, "123": 1, "456": 1}, listCalls)
+}
+
+func TestClientCachesLocationTemplate(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"code":0,"message":"success","data":{"locations":[{"location_id":"58","display_name":"Frankfurt - DE"}]}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(http.DefaultClient, Config{APIBaseURL: server.URL})
+
+	template, err := c.LocationTemplate().Get()
+	require.NoError(t, err)
+	require.Len(t, template.Locations, 1)
+
+	template, err = WithContext(context.Background(), c).LocationTemplate().GetContext(context.Background())
+	require.NoError(t, err)
+	require.Len(t, template.Locations, 1)
+	assert.Equal(t, "Frankfurt - DE", template.Locations[0].DisplayName)
+	assert.Equal(t, 1, calls)
+}
@@ -3,7 +3,9 @@ package site24x7
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/aws"
 	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/common"
@@ -45,6 +47,14 @@ type Config struct {
 	// Application Account ID of the customer.
 	ZAAID string
 
+	// DataCenter is the data center code (e.g. US, EU) the OAuth client
+	// credentials belong to.
+	DataCenter string
+
+	// TokenCacheFile is the path of a file in which access tokens are cached
+	// and shared between provider runs. The cache is disabled if empty.
+	TokenCacheFile string
+
 	// APIBaseURL allows overriding the default API base URL (https://www.site24x7.com/api).
 	// See https://www.site24x7.com/help/api/index.html#introduction for options of data centers for top level domain.
 	APIBaseURL string
@@ -53,20 +63,80 @@ type Config struct {
 	// See https://www.site24x7.com/help/api/index.html#authentication for options of data centers for top level domain.
 	TokenURL string
 
+	// APIDomain is the Zoho API domain of the data center, e.g.
+	// https://www.zohoapis.com. If set, access tokens issued for another
+	// data center are rejected.
+	APIDomain string
+
+	// TokenSource allows passing in an already created token source, e.g.
+	// one that was used to check the credentials upfront. If nil, a new one
+	// is created from the OAuth credentials.
+	TokenSource oauth.InvalidatingTokenSource
+
+	// Transport is the http.RoundTripper used for requests to the token
+	// endpoint and the API, e.g. one created with NewTransport. If nil,
+	// http.DefaultTransport is used.
+	Transport http.RoundTripper
+
 	// RetryConfig contains the configuration of the backoff-retry behavior. If
 	// nil, backoff.DefaultRetryConfig will be used.
 	RetryConfig *backoff.RetryConfig
+
+	// RequestTimeout is the maximum duration of a single API call including
+	// all retries. Zero means no timeout.
+	RequestTimeout time.Duration
+
+	// SensitiveKeys are additional JSON keys whose values are masked when
+	// requests and responses are logged.
+	SensitiveKeys []string
+
+	// PrefetchMonitors enables serving monitor reads from a single list call
+	// shared by all monitor types instead of one API call per monitor.
+	PrefetchMonitors bool
+
+	// RateLimitConfig contains the configuration of the client-side rate
+	// limiting that is shared by all API requests. If nil, requests are not
+	// rate limited. Only used by New.
+	RateLimitConfig *rest.RateLimitConfig
 }
 
-// OAuthClient creates a new *http.Client from c that transparently obtains and
-// attaches OAuth access tokens to every request.
-func (c *Config) OAuthClient(ctx context.Context) *http.Client {
+// OAuthConfig creates the *oauth.Config for the credentials in c.
+func (c *Config) OAuthConfig() *oauth.Config {
 	oauthConfig := oauth.NewConfig(c.ClientID, c.ClientSecret, c.RefreshToken, c.AccessToken, c.Expiry)
 	if c.TokenURL != "" {
 		oauthConfig.Endpoint.TokenURL = c.TokenURL
 	}
+	oauthConfig.APIDomain = c.APIDomain
+	oauthConfig.Transport = c.Transport
+	if c.TokenCacheFile != "" {
+		// Without a data center code the token URL identifies the region.
+		dataCenter := c.DataCenter
+		if dataCenter == "" {
+			dataCenter = oauthConfig.Endpoint.TokenURL
+		}
+		oauthConfig.TokenCache = &oauth.TokenCacheConfig{
+			Path:       c.TokenCacheFile,
+			DataCenter: dataCenter,
+		}
+	}
 
-	return oauthConfig.Client(ctx)
+	return oauthConfig
+}
+
+// OAuthClient creates a new *http.Client from c that transparently obtains and
+// attaches OAuth access tokens to every request.
+func (c *Config) OAuthClient(ctx context.Context) *http.Client {
+	tokenSource := c.TokenSource
+	if tokenSource == nil {
+		tokenSource = c.OAuthConfig().TokenSource(ctx)
+	}
+
+	return &http.Client{
+		Transport: &oauth.Transport{
+			Source: tokenSource,
+			Base:   c.Transport,
+		},
+	}
 }
 
 // HTTPClient is the interface of an http client that is compatible with
@@ -129,33 +199,55 @@ type Client interface {
 
 type client struct {
 	restClient rest.Client
+	customers  *customerCaches
+	cache      *lookupCache
+	snapshots  *monitorSnapshots
 }
 
 // New creates a new Site24x7 API Client with Config c.
 func New(c Config) Client {
-	httpClient := backoff.WithRetries(
-		c.OAuthClient(context.Background()),
-		c.RetryConfig,
-	)
+	httpClient := c.OAuthClient(context.Background())
+	if c.RateLimitConfig != nil {
+		// The rate limiter sits below the retrying client, so that every
+		// attempt is throttled and observed, and no in-flight slot is held
+		// while backing off between attempts.
+		httpClient.Transport = &rest.RateLimitedTransport{
+			Limiter: rest.NewRateLimiter(*c.RateLimitConfig),
+			Base:    httpClient.Transport,
+		}
+	}
 
-	return NewClient(httpClient, c)
+	return newClient(backoff.WithRetries(httpClient, c.RetryConfig), c)
 }
 
 // NewClient creates a new Site24x7 API Client from httpClient with default API base URL.
 // This can be used to provide a custom http client for use with the API. The custom http
-// client has to transparently handle the Site24x7 OAuth flow.
+// client has to transparently handle the Site24x7 OAuth flow. It is also
+// responsible for rate limiting, e.g. by using a *rest.RateLimitedTransport,
+// c.RateLimitConfig is ignored.
 func NewClient(httpClient HTTPClient, c Config) Client {
+	return newClient(httpClient, c)
+}
 
+func newClient(httpClient HTTPClient, c Config) Client {
 	clientConfig := rest.ClientConfig{
-		APIBaseURL: c.APIBaseURL,
-		TokenURL:   c.TokenURL,
-		ZAAID:      c.ZAAID,
+		APIBaseURL:    c.APIBaseURL,
+		TokenURL:      c.TokenURL,
+		ZAAID:         c.ZAAID,
+		Timeout:       c.RequestTimeout,
+		SensitiveKeys: c.SensitiveKeys,
 	}
 	if c.ZAAID != "" {
 		clientConfig.MSP = true
 	}
+	customers := newCustomerCaches(c.PrefetchMonitors)
+	cache, snapshots := customers.forCustomer("")
+
 	return &client{
 		restClient: rest.NewClient(httpClient, clientConfig),
+		customers:  customers,
+		cache:      cache,
+		snapshots:  snapshots,
 	}
 
 }
@@ -169,6 +261,71 @@ func NewClient(httpClient HTTPClient, c Config) Client {
 // 	}
 // }
 
+// contextBinder is implemented by clients whose API requests can be bound to
+// a context.
+type contextBinder interface {
+	WithContext(ctx context.Context) Client
+}
+
+// WithContext returns a copy of c whose API requests are bound to ctx, so that
+// cancelling ctx aborts in-flight requests. Clients that do not support
+// contexts, e.g. fakes, are returned unchanged.
+func WithContext(ctx context.Context, c Client) Client {
+	if binder, ok := c.(contextBinder); ok {
+		return binder.WithContext(ctx)
+	}
+
+	return c
+}
+
+// WithContext returns a copy of c whose API requests are bound to ctx. The
+// copy shares the lookup cache and monitor snapshots of c.
+func (c *client) WithContext(ctx context.Context) Client {
+	return &client{
+		restClient: c.restClient.WithContext(ctx),
+		customers:  c.customers,
+		cache:      c.cache,
+		snapshots:  c.snapshots,
+	}
+}
+
+// customerBinder is implemented by clients which can send API requests on
+// behalf of MSP customers. The value returned by WithZAAID must implement
+// Client. It is not typed as such, so that fakes can implement the interface
+// without importing this package.
+type customerBinder interface {
+	WithZAAID(zaaid string) interface{}
+}
+
+// WithZAAID returns a copy of c whose API requests are sent on behalf of the
+// MSP customer with zaaid, overriding the zaaid of the provider
+// configuration. If zaaid is empty or c does not support MSP customers, c is
+// returned unchanged.
+func WithZAAID(zaaid string, c Client) Client {
+	if zaaid == "" {
+		return c
+	}
+
+	if binder, ok := c.(customerBinder); ok {
+		return binder.WithZAAID(zaaid).(Client)
+	}
+
+	return c
+}
+
+// WithZAAID returns a copy of c whose API requests are sent on behalf of the
+// MSP customer with zaaid. Customers do not share cached lookups.
+func (c *client) WithZAAID(zaaid string) interface{} {
+	cache, snapshots := c.customers.forCustomer(zaaid)
+
+	return &client{
+		restClient: c.restClient.WithZAAID(zaaid),
+		customers:  c.customers,
+		cache:      cache,
+		snapshots:  snapshots,
+	}
+}
+
 // CurrentStatus implements Client.
 func (c *client) CurrentStatus() endpoints.CurrentStatus {
 	return endpoints.NewCurrentStatus(c.restClient)
@@ -176,7 +333,10 @@ func (c *client) CurrentStatus() endpoints.CurrentStatus {
 
 // LocationProfiles implements Client.
 func (c *client) LocationProfiles() endpoints.LocationProfiles {
-	return endpoints.NewLocationProfiles(c.restClient)
+	return &cachedLocationProfiles{
+		LocationProfiles: endpoints.NewLocationProfiles(c.restClient),
+		cache:            c.cache,
+	}
 }
 
 // ScheduleMaintenance implements Client.
@@ -190,107 +350,113 @@ func (c *client) ScheduleReport() common.ScheduleReport {
 
 // LocationTemplate implements Client.
 func (c *client) LocationTemplate() endpoints.LocationTemplate {
-	return endpoints.NewLocationTemplate(c.restClient)
+	return &cachedLocationTemplate{
+		LocationTemplate: endpoints.NewLocationTemplate(c.restClient),
+		cache:            c.cache,
+	}
 }
 
 // AmazonMonitors implements Client.
 func (c *client) AmazonMonitors() monitors.AmazonMonitors {
-	return monitors.NewAmazonMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewAmazonMonitors(c.restClient), func(m *api.AmazonMonitor) string { return m.MonitorID })
 }
 
 // GCPMonitors implements Client.
 func (c *client) GCPMonitors() monitors.GCPMonitors {
-	return monitors.NewGCPMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewGCPMonitors(c.restClient), func(m *api.GCPMonitor) string { return m.MonitorID })
 }
 
 // AzureMonitors implements Client.
 func (c *client) AzureMonitors() monitors.AzureMonitors {
-	return monitors.NewAzureMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewAzureMonitors(c.restClient), func(m *api.AzureMonitor) string { return m.MonitorID })
 }
 
 // WebsiteMonitors implements Client.
 func (c *client) WebsiteMonitors() monitors.WebsiteMonitors {
-	return monitors.NewMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewMonitors(c.restClient), func(m *api.WebsiteMonitor) string { return m.MonitorID })
 }
 
 // DNSServerMonitors implements Client.
 func (c *client) DNSServerMonitors() monitors.DNSServerMonitors {
-	return monitors.NewDNSServerMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewDNSServerMonitors(c.restClient), func(m *api.DNSServerMonitor) string { return m.MonitorID })
 }
 
 // WebPageSpeedMonitors implements Client.
 func (c *client) WebPageSpeedMonitors() monitors.WebPageSpeedMonitors {
-	return monitors.NewWebPageSpeedMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewWebPageSpeedMonitors(c.restClient), func(m *api.WebPageSpeedMonitor) string { return m.MonitorID })
 }
 
 // SSLMonitors implements Client.
 func (c *client) SSLMonitors() monitors.SSLMonitors {
-	return monitors.NewSSLMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewSSLMonitors(c.restClient), func(m *api.SSLMonitor) string { return m.MonitorID })
 }
 
 // PINGMonitors implements Client.
 func (c *client) PINGMonitors() monitors.PINGMonitors {
-	return monitors.NewPINGMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewPINGMonitors(c.restClient), func(m *api.PINGMonitor) string { return m.MonitorID })
 }
 
 // SOAPMonitors implements Client.
 func (c *client) SOAPMonitors() monitors.SOAPMonitors {
-	return monitors.NewSOAPMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewSOAPMonitors(c.restClient), func(m *api.SOAPMonitor) string { return m.MonitorID })
 }
 
 // PortMonitors implements Client.
 func (c *client) PortMonitors() monitors.PortMonitors {
-	return monitors.NewPortMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewPortMonitors(c.restClient), func(m *api.PortMonitor) string { return m.MonitorID })
 }
 
 // CronMonitors implements Client.
 func (c *client) CronMonitors() monitors.CronMonitors {
-	return monitors.NewCronMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewCronMonitors(c.restClient), func(m *api.CronMonitor) string { return m.MonitorID })
 }
 
 // HeartbeatMonitors implements Client.
 func (c *client) HeartbeatMonitors() monitors.HeartbeatMonitors {
-	return monitors.NewHeartbeatMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewHeartbeatMonitors(c.restClient), func(m *api.HeartbeatMonitor) string { return m.MonitorID })
 }
 
 // FTPTransferMonitors implements Client.
 func (c *client) FTPTransferMonitors() monitors.FTPTransferMonitors {
-	return monitors.NewFTPTransferMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewFTPTransferMonitors(c.restClient), func(m *api.FTPTransferMonitor) string { return m.MonitorID })
 }
 
 // FTPTransferMonitors implements Client.
 func (c *client) ISPMonitors() monitors.ISPMonitors {
-	return monitors.NewISPMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewISPMonitors(c.restClient), func(m *api.ISPMonitor) string { return m.MonitorID })
 }
 
 // ServerMonitors implements Client.
 func (c *client) ServerMonitors() monitors.ServerMonitors {
-	return monitors.NewServerMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewServerMonitors(c.restClient), func(m *api.ServerMonitor) string { return m.MonitorID })
 }
 
 // DomainExpiryMonitors implements Client.
 func (c *client) DomainExpiryMonitors() monitors.DomainExpiryMonitors {
-	return monitors.NewDomainExpiryMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewDomainExpiryMonitors(c.restClient), func(m *api.DomainExpiryMonitor) string { return m.MonitorID })
 }
 
 // WebTransactionBrowserMonitor implements Client.
 func (c *client) WebTransactionBrowserMonitors() monitors.WebTransactionBrowserMonitors {
-	return monitors.NewWebTransactionBrowserMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewWebTransactionBrowserMonitors(c.restClient), func(m *api.WebTransactionBrowserMonitor) string { return m.MonitorID })
 }
 
 // RestApiMonitors implements Client.
 func (c *client) RestApiMonitors() monitors.RestApiMonitors {
-	return monitors.NewRestApiMonitors(c.restClient)
+	return prefetchMonitors(c.snapshots, c.restClient, monitors.NewRestApiMonitors(c.restClient), func(m *api.RestApiMonitor) string { return m.MonitorID })
 }
 
 // RestApiTransactionMonitors implements Client.
 func (c *client) RestApiTransactionMonitors() monitors.RestApiTransactionMonitors {
-	return monitors.NewRestApiTransactionMonitors(c.restClient)
+	return prefetchRestApiTransactionMonitors(c.snapshots, c.restClient, monitors.NewRestApiTransactionMonitors(c.restClient))
 }
 
 // MonitorGroups implements Client.
 func (c *client) MonitorGroups() endpoints.MonitorGroups {
-	return endpoints.NewMonitorGroups(c.restClient)
+	return &cachedMonitorGroups{
+		MonitorGroups: endpoints.NewMonitorGroups(c.restClient),
+		cache:         c.cache,
+	}
 }
 
 // Subgroups implements Client.
@@ -300,17 +466,26 @@ func (c *client) Subgroups() endpoints.Subgroups {
 
 // Tags implements Client.
 func (c *client) Tags() endpoints.Tags {
-	return endpoints.NewTags(c.restClient)
+	return &cachedTags{
+		Tags:  endpoints.NewTags(c.restClient),
+		cache: c.cache,
+	}
 }
 
 // NotificationProfiles implements Client.
 func (c *client) NotificationProfiles() endpoints.NotificationProfiles {
-	return endpoints.NewNotificationProfiles(c.restClient)
+	return &cachedNotificationProfiles{
+		NotificationProfiles: endpoints.NewNotificationProfiles(c.restClient),
+		cache:                c.cache,
+	}
 }
 
 // ThresholdProfiles implements Client.
 func (c *client) ThresholdProfiles() endpoints.ThresholdProfiles {
-	return endpoints.NewThresholdProfiles(c.restClient)
+	return &cachedThresholdProfiles{
+		ThresholdProfiles: endpoints.NewThresholdProfiles(c.restClient),
+		cache:             c.cache,
+	}
 }
 
 // UserGroups implements Client.
@@ -320,7 +495,10 @@ func (c *client) Users() endpoints.Users {
 
 // UserGroups implements Client.
 func (c *client) UserGroups() endpoints.UserGroups {
-	return endpoints.NewUserGroups(c.restClient)
+	return &cachedUserGroups{
+		UserGroups: endpoints.NewUserGroups(c.restClient),
+		cache:      c.cache,
+	}
 }
 
 // ItAutomations implements Client.
@@ -0,0 +1,61 @@
+package site24x7
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/site24x7/terraform-provider-site24x7/backoff"
+	"github.com/site24x7/terraform-provider-site24x7/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReleasesRateLimitDuringBackoff(t *testing.T) {
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	firstAttempt := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts[r.URL.Path]++
+		n := attempts[r.URL.Path]
+		mu.Unlock()
+
+		if r.URL.Path == "/monitors/1" && n == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"code":1,"message":"try again"}`))
+			close(firstAttempt)
+			return
+		}
+		w.Write([]byte(`{"code":0,"message":"success","data":{"monitor_id":"` + r.URL.Path[len("/monitors/"):] + `"}}`))
+	}))
+	defer server.Close()
+
+	c := New(Config{
+		AccessToken:     "token",
+		Expiry:          "3600",
+		APIBaseURL:      server.URL,
+		RetryConfig:     &backoff.RetryConfig{MinWait: 500 * time.Millisecond, MaxWait: 500 * time.Millisecond, MaxRetries: 1},
+		RateLimitConfig: &rest.RateLimitConfig{MaxInFlight: 1},
+	})
+
+	retried := make(chan time.Time, 1)
+	go func() {
+		_, err := c.WebsiteMonitors().Get("1")
+		assert.NoError(t, err)
+		retried <- time.Now()
+	}()
+
+	<-firstAttempt
+
+	// The only in-flight slot is free while the first request backs off.
+	_, err := c.WebsiteMonitors().Get("2")
+	require.NoError(t, err)
+	secondDone := time.Now()
+
+	assert.True(t, secondDone.Before(<-retried))
+	assert.Equal(t, map[string]int{"/monitors/1": 2, "/monitors/2": 1}, attempts)
+}
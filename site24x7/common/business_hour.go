@@ -1,11 +1,13 @@
 package common
 
 import (
+	"context"
 	"fmt"
 
 	log "github.com/sirupsen/logrus"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -50,20 +52,19 @@ var BusinessHourSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7BusinessHour() *schema.Resource {
 	return &schema.Resource{
-		Create: businessHourCreate,
-		Read:   businessHourRead,
-		Update: businessHourUpdate,
-		Delete: businessHourDelete,
-		Exists: businessHourExists,
+		CreateContext: businessHourCreate,
+		ReadContext:   businessHourRead,
+		UpdateContext: businessHourUpdate,
+		DeleteContext: businessHourDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: BusinessHourSchema,
 	}
 }
 
-func businessHourCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func businessHourCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	log.Println("[INFO] Creating new BusinessHour resource")
 
@@ -72,7 +73,7 @@ func businessHourCreate(d *schema.ResourceData, meta interface{}) error {
 	businessHour, err := client.BusinessHour().Create(businessHour)
 	if err != nil {
 		log.Printf("[ERROR] Failed to create BusinessHour: %v", err)
-		return fmt.Errorf("failed to create business hour: %w", err)
+		return site24x7.APIErrorDiagnostics(fmt.Errorf("failed to create business hour: %w", err), d)
 	}
 	log.Printf("[DEBUG] Created BusinessHour with ID: %s, DisplayName: %s", businessHour.ID, businessHour.DisplayName)
 	d.SetId(businessHour.ID)
@@ -80,14 +81,14 @@ func businessHourCreate(d *schema.ResourceData, meta interface{}) error {
 	// Check for errors when setting fields in the schema
 	if err := d.Set("display_name", businessHour.DisplayName); err != nil {
 		log.Printf("[ERROR] Failed to set display_name in state: %v", err)
-		return fmt.Errorf("error setting display_name in state after creation: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting display_name in state after creation: %w", err))
 	}
 
 	return nil
 }
 
-func businessHourRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func businessHourRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	businessHour, err := client.BusinessHour().Get(d.Id())
 	if apierrors.IsNotFound(err) {
@@ -97,7 +98,7 @@ func businessHourRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	if err != nil {
 		log.Printf("[ERROR] Failed to read BusinessHour with ID %s: %v", d.Id(), err)
-		return fmt.Errorf("failed to read business hour with ID %s: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("failed to read business hour with ID %s: %w", d.Id(), err))
 	}
 	log.Printf("[DEBUG] Retrieved BusinessHour with ID: %s, DisplayName: %s", businessHour.ID, businessHour.DisplayName)
 	updateBusinessHourResourceData(d, businessHour)
@@ -105,15 +106,15 @@ func businessHourRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func businessHourUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func businessHourUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 	log.Printf("[INFO] Updating BusinessHour with ID: %s", d.Id())
 	businessHour := resourceDataToBusinessHour(d)
 
 	businessHour, err := client.BusinessHour().Update(businessHour)
 	if err != nil {
 		log.Printf("[ERROR] Failed to update BusinessHour with ID %s: %v", d.Id(), err)
-		return fmt.Errorf("failed to update business hour with ID %s: %w", businessHour.ID, err)
+		return site24x7.APIErrorDiagnostics(fmt.Errorf("failed to update business hour with ID %s: %w", businessHour.ID, err), d)
 	}
 	log.Printf("[DEBUG] Updated BusinessHour with ID: %s, DisplayName: %s", businessHour.ID, businessHour.DisplayName)
 	d.SetId(businessHour.ID)
@@ -121,40 +122,26 @@ func businessHourUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Handle potential errors in state updates
 	if err := d.Set("display_name", businessHour.DisplayName); err != nil {
 		log.Printf("[ERROR] Failed to set display_name in state after update: %v", err)
-		return fmt.Errorf("error setting display_name in state after update: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting display_name in state after update: %w", err))
 	}
 
 	return nil
 }
 
-func businessHourDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func businessHourDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.BusinessHour().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("failed to delete business hour with ID %s: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("failed to delete business hour with ID %s: %w", d.Id(), err))
 	}
 
 	return nil
 }
 
-func businessHourExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.BusinessHour().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-	if err != nil {
-		return false, fmt.Errorf("failed to check existence of business hour with ID %s: %w", d.Id(), err)
-	}
-
-	return true, nil
-}
-
 func resourceDataToBusinessHour(d *schema.ResourceData) *api.BusinessHour {
 	var timeConfig []api.TimeSlot
 	for _, tc := range d.Get("time_config").([]interface{}) {
@@ -1,9 +1,11 @@
 package common
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -25,12 +27,12 @@ func TestBusinessHourCreate(t *testing.T) {
 	}
 
 	c.FakeBusinesshour.On("Create", a).Return(a, nil).Once()
-	require.NoError(t, businessHourCreate(d, c))
+	require.Nil(t, businessHourCreate(context.Background(), d, c))
 
 	c.FakeBusinesshour.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
-	err := businessHourCreate(d, c)
+	err := businessHourCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestBusinessHourUpdate(t *testing.T) {
@@ -48,12 +50,12 @@ func TestBusinessHourUpdate(t *testing.T) {
 	}
 
 	c.FakeBusinesshour.On("Update", a).Return(a, nil).Once()
-	require.NoError(t, businessHourUpdate(d, c))
+	require.Nil(t, businessHourUpdate(context.Background(), d, c))
 
 	c.FakeBusinesshour.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
-	err := businessHourUpdate(d, c)
+	err := businessHourUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestBusinessHourRead(t *testing.T) {
@@ -62,12 +64,12 @@ func TestBusinessHourRead(t *testing.T) {
 	c := fake.NewClient()
 
 	c.FakeBusinesshour.On("Get", "123").Return(&api.BusinessHour{}, nil).Once()
-	require.NoError(t, businessHourRead(d, c))
+	require.Nil(t, businessHourRead(context.Background(), d, c))
 
 	c.FakeBusinesshour.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-	err := businessHourRead(d, c)
+	err := businessHourRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestBusinessHourDelete(t *testing.T) {
@@ -76,34 +78,21 @@ func TestBusinessHourDelete(t *testing.T) {
 	c := fake.NewClient()
 
 	c.FakeBusinesshour.On("Delete", "123").Return(nil).Once()
-	require.NoError(t, businessHourDelete(d, c))
+	require.Nil(t, businessHourDelete(context.Background(), d, c))
 
 	c.FakeBusinesshour.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
-	require.NoError(t, businessHourDelete(d, c))
+	require.Nil(t, businessHourDelete(context.Background(), d, c))
 }
 
-func TestBusinessHourExists(t *testing.T) {
+func TestBusinessHourReadNotFound(t *testing.T) {
 	d := businessHourTestResourceData(t)
 	d.SetId("123")
 	c := fake.NewClient()
 
-	c.FakeBusinesshour.On("Get", "123").Return(&api.BusinessHour{}, nil).Once()
-
-	exists, err := businessHourExists(d, c)
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeBusinesshour.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = businessHourExists(d, c)
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeBusinesshour.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = businessHourExists(d, c)
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, businessHourRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func businessHourTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,10 +1,12 @@
 package common
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
 )
@@ -39,18 +41,18 @@ var credentialProfileDataSourcceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7CredentialProfile() *schema.Resource {
 	return &schema.Resource{
-		Read:   credentialProfileDataSourceRead,
-		Schema: credentialProfileDataSourcceSchema,
+		ReadContext: credentialProfileDataSourceRead,
+		Schema:      credentialProfileDataSourcceSchema,
 	}
 }
 
 // monitorDataSourceRead fetches all server monitors from Site24x7
-func credentialProfileDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func credentialProfileDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	allWebCredentials, err := client.CredentialProfile().ListWebCredentials()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var genericCredentialProfile *api.CredentialProfile
@@ -72,7 +74,7 @@ func credentialProfileDataSourceRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if genericCredentialProfile == nil {
-		return errors.New("Unable to find monitor matching the name : \"" + d.Get("name_regex").(string))
+		return diag.FromErr(errors.New("Unable to find monitor matching the name : \"" + d.Get("name_regex").(string)))
 	}
 
 	updateResourceData(d, genericCredentialProfile)
@@ -1,7 +1,10 @@
 package common
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
 )
@@ -31,29 +34,29 @@ var credentialProfileSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7CredentialProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSite24x7CredentialProfileCreate,
-		Read:   resourceSite24x7CredentialProfileRead,
-		Update: resourceSite24x7CredentialProfileUpdate,
-		Delete: resourceSite24x7CredentialProfileDelete,
+		CreateContext: resourceSite24x7CredentialProfileCreate,
+		ReadContext:   resourceSite24x7CredentialProfileRead,
+		UpdateContext: resourceSite24x7CredentialProfileUpdate,
+		DeleteContext: resourceSite24x7CredentialProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: credentialProfileSchema,
 	}
 }
 
-func resourceSite24x7CredentialProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client) // Replace with the actual Site24x7 client initialization
+func resourceSite24x7CredentialProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client)) // Replace with the actual Site24x7 client initialization
 
 	credentilProfile, err := resourceDataToCredentialProfile(d)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	credentialProfile, err := client.CredentialProfile().Create(credentilProfile)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(credentialProfile.ID)
@@ -61,15 +64,15 @@ func resourceSite24x7CredentialProfileCreate(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceSite24x7CredentialProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client) // Replace with the actual Site24x7 client initialization
+func resourceSite24x7CredentialProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client)) // Replace with the actual Site24x7 client initialization
 
 	// Read the resource ID from the Terraform state
 	credentilProfileID := d.Id()
 
 	credentilProfile, err := client.CredentialProfile().Get(credentilProfileID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("credential_type", credentilProfile.CredentialType)
@@ -80,27 +83,27 @@ func resourceSite24x7CredentialProfileRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceSite24x7CredentialProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client) // Replace with the actual Site24x7 client initialization
+func resourceSite24x7CredentialProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client)) // Replace with the actual Site24x7 client initialization
 
 	credentilProfile, err := resourceDataToCredentialProfile(d)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	credentilProfile, err = client.CredentialProfile().Update(credentilProfile)
 
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 	d.SetId(credentilProfile.ID)
 
 	return nil
 }
 
-func resourceSite24x7CredentialProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client) // Replace with the actual Site24x7 client initialization
+func resourceSite24x7CredentialProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client)) // Replace with the actual Site24x7 client initialization
 
 	// Read the resource ID from the Terraform state
 	credentilProfileID := d.Id()
@@ -109,7 +112,7 @@ func resourceSite24x7CredentialProfileDelete(d *schema.ResourceData, meta interf
 	// Example:
 	err := client.CredentialProfile().Delete(credentilProfileID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Mark the resource as deleted
@@ -1,9 +1,11 @@
 package common
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -25,13 +27,13 @@ func TestCredentialProfileCreate(t *testing.T) {
 
 	c.FakeCredentialProfile.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, resourceSite24x7CredentialProfileCreate(d, c))
+	require.Nil(t, resourceSite24x7CredentialProfileCreate(context.Background(), d, c))
 
 	c.FakeCredentialProfile.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := resourceSite24x7CredentialProfileCreate(d, c)
+	err := resourceSite24x7CredentialProfileCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestCredentialProfileUpdate(t *testing.T) {
@@ -50,13 +52,13 @@ func TestCredentialProfileUpdate(t *testing.T) {
 
 	c.FakeRestApiMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, resourceSite24x7CredentialProfileUpdate(d, c))
+	require.Nil(t, resourceSite24x7CredentialProfileUpdate(context.Background(), d, c))
 
 	c.FakeRestApiMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := resourceSite24x7CredentialProfileUpdate(d, c)
+	err := resourceSite24x7CredentialProfileUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestRestApiMonitorRead(t *testing.T) {
@@ -67,13 +69,13 @@ func TestRestApiMonitorRead(t *testing.T) {
 
 	c.FakeRestApiMonitors.On("Get", "123").Return(&api.RestApiMonitor{}, nil).Once()
 
-	require.NoError(t, resourceSite24x7CredentialProfileRead(d, c))
+	require.Nil(t, resourceSite24x7CredentialProfileRead(context.Background(), d, c))
 
 	c.FakeRestApiMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := resourceSite24x7CredentialProfileRead(d, c)
+	err := resourceSite24x7CredentialProfileRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestRestApiMonitorDelete(t *testing.T) {
@@ -84,11 +86,11 @@ func TestRestApiMonitorDelete(t *testing.T) {
 
 	c.FakeRestApiMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, resourceSite24x7CredentialProfileDelete(d, c))
+	require.Nil(t, resourceSite24x7CredentialProfileDelete(context.Background(), d, c))
 
 	c.FakeRestApiMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, resourceSite24x7CredentialProfileDelete(d, c))
+	require.Nil(t, resourceSite24x7CredentialProfileDelete(context.Background(), d, c))
 }
 
 func credentialProfileTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,7 +1,10 @@
 package common
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
 )
@@ -10,18 +13,18 @@ var deviceKeyDataSourceSchema = map[string]*schema.Schema{}
 
 func DataSourceSite24x7DeviceKey() *schema.Resource {
 	return &schema.Resource{
-		Read:   deviceKeyDataSourceRead,
-		Schema: deviceKeyDataSourceSchema,
+		ReadContext: deviceKeyDataSourceRead,
+		Schema:      deviceKeyDataSourceSchema,
 	}
 }
 
 // deviceKeyDataSourceRead fetches the device key from Site24x7
-func deviceKeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func deviceKeyDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	deviceKey, err := client.DeviceKey().Get()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateDeviceKeyDataSourceResourceData(d, deviceKey)
@@ -1,7 +1,10 @@
 package common
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
 )
@@ -125,64 +128,64 @@ var oauth2ProviderSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7OAuth2Provider() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSite24x7OAuth2ProviderCreate,
-		Read:   resourceSite24x7OAuth2ProviderRead,
-		Update: resourceSite24x7OAuth2ProviderUpdate,
-		Delete: resourceSite24x7OAuth2ProviderDelete,
+		CreateContext: resourceSite24x7OAuth2ProviderCreate,
+		ReadContext:   resourceSite24x7OAuth2ProviderRead,
+		UpdateContext: resourceSite24x7OAuth2ProviderUpdate,
+		DeleteContext: resourceSite24x7OAuth2ProviderDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: oauth2ProviderSchema,
 	}
 }
 
-func resourceSite24x7OAuth2ProviderCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func resourceSite24x7OAuth2ProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	oauth2Provider := resourceDataToOAuth2Provider(d)
 
 	provider, err := client.OAuth2Provider().Create(oauth2Provider)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(provider.ProviderID)
-	return resourceSite24x7OAuth2ProviderRead(d, meta)
+	return resourceSite24x7OAuth2ProviderRead(ctx, d, meta)
 }
 
-func resourceSite24x7OAuth2ProviderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func resourceSite24x7OAuth2ProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	provider, err := client.OAuth2Provider().Get(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateOAuth2ProviderResourceData(d, provider)
 	return nil
 }
 
-func resourceSite24x7OAuth2ProviderUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func resourceSite24x7OAuth2ProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	oauth2Provider := resourceDataToOAuth2Provider(d)
 	oauth2Provider.ProviderID = d.Id()
 
 	provider, err := client.OAuth2Provider().Update(oauth2Provider)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(provider.ProviderID)
-	return resourceSite24x7OAuth2ProviderRead(d, meta)
+	return resourceSite24x7OAuth2ProviderRead(ctx, d, meta)
 }
 
-func resourceSite24x7OAuth2ProviderDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func resourceSite24x7OAuth2ProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.OAuth2Provider().Delete(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -1,7 +1,10 @@
 package common
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
 )
 
@@ -73,19 +76,19 @@ var oauth2ProviderDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7OAuth2Provider() *schema.Resource {
 	return &schema.Resource{
-		Read:   dataSourceSite24x7OAuth2ProviderRead,
-		Schema: oauth2ProviderDataSourceSchema,
+		ReadContext: dataSourceSite24x7OAuth2ProviderRead,
+		Schema:      oauth2ProviderDataSourceSchema,
 	}
 }
 
-func dataSourceSite24x7OAuth2ProviderRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func dataSourceSite24x7OAuth2ProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	providerID := d.Get("provider_id").(string)
 
 	provider, err := client.OAuth2Provider().Get(providerID)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(provider.ProviderID)
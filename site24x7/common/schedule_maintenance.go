@@ -1,7 +1,10 @@
 package common
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -42,8 +45,8 @@ var ScheduleMaintenanceSchema = map[string]*schema.Schema{
 	},
 
 	"time_zone": {
-		Type:     schema.TypeString,
-		Optional: true,
+		Type:        schema.TypeString,
+		Optional:    true,
 		Description: "Time zone for your scheduled maintenance. Default is account timezone.",
 	},
 
@@ -55,32 +58,32 @@ var ScheduleMaintenanceSchema = map[string]*schema.Schema{
 
 	// ---------- Once ----------
 	"start_date": {
-		Type:     schema.TypeString,
-		Optional: true,
+		Type:        schema.TypeString,
+		Optional:    true,
 		Description: "Required for once maintenance. Format: yyyy-mm-dd",
 	},
 
 	"end_date": {
-		Type:     schema.TypeString,
-		Optional: true,
+		Type:        schema.TypeString,
+		Optional:    true,
 		Description: "Required for once maintenance. Format: yyyy-mm-dd",
 	},
 
 	// ---------- Weekly ----------
 	"start_day": {
-		Type:     schema.TypeInt,
-		Optional: true,
+		Type:        schema.TypeInt,
+		Optional:    true,
 		Description: "Start day for weekly maintenance (1=Sun ... 7=Sat)",
 	},
 	"end_day": {
-		Type:     schema.TypeInt,
-		Optional: true,
+		Type:        schema.TypeInt,
+		Optional:    true,
 		Description: "End day for weekly maintenance (1=Sun ... 7=Sat)",
 	},
 
 	"duration": {
-		Type:     schema.TypeInt,
-		Optional: true,
+		Type:        schema.TypeInt,
+		Optional:    true,
 		Description: "Duration in minutes (required for weekly maintenance)",
 	},
 
@@ -94,122 +97,112 @@ var ScheduleMaintenanceSchema = map[string]*schema.Schema{
 	},
 
 	"execute_every": {
-		Type:     schema.TypeInt,
-		Optional: true,
-		Default:  1,
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     1,
 		Description: "Interval at which weekly maintenance recurs (1–4)",
 	},
 
 	"maintenance_start_on": {
-		Type:     schema.TypeString,
-		Optional: true,
+		Type:        schema.TypeString,
+		Optional:    true,
 		Description: "Date on which weekly maintenance should start. Format: yyyy-mm-dd",
 	},
 
 	// ---------- Resource Selection ----------
 	"selection_type": {
-		Type:     schema.TypeInt,
-		Optional: true,
-		Default:  2,
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     2,
 		Description: "1=Monitor Groups, 2=Monitors, 3=Tags",
 	},
 
 	"monitors": {
 		Type:     schema.TypeList,
 		Optional: true,
-		Elem: &schema.Schema{Type: schema.TypeString},
+		Elem:     &schema.Schema{Type: schema.TypeString},
 	},
 
 	"monitor_groups": {
 		Type:     schema.TypeList,
 		Optional: true,
-		Elem: &schema.Schema{Type: schema.TypeString},
+		Elem:     &schema.Schema{Type: schema.TypeString},
 	},
 
 	"tags": {
 		Type:     schema.TypeList,
 		Optional: true,
-		Elem: &schema.Schema{Type: schema.TypeString},
+		Elem:     &schema.Schema{Type: schema.TypeString},
 	},
 }
 
 func ResourceSite24x7ScheduleMaintenance() *schema.Resource {
 	return &schema.Resource{
-		Create: scheduleMaintenanceCreate,
-		Read:   scheduleMaintenanceRead,
-		Update: scheduleMaintenanceUpdate,
-		Delete: scheduleMaintenanceDelete,
-		Exists: scheduleMaintenanceExists,
+		CreateContext: scheduleMaintenanceCreate,
+		ReadContext:   scheduleMaintenanceRead,
+		UpdateContext: scheduleMaintenanceUpdate,
+		DeleteContext: scheduleMaintenanceDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: ScheduleMaintenanceSchema,
 	}
 }
 
-func scheduleMaintenanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func scheduleMaintenanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	scheduleMaintenance := resourceDataToScheduleMaintenance(d)
 
 	scheduleMaintenance, err := client.ScheduleMaintenance().Create(scheduleMaintenance)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(scheduleMaintenance.MaintenanceID)
 	return nil
 }
 
-func scheduleMaintenanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func scheduleMaintenanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	scheduleMaintenance, err := client.ScheduleMaintenance().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateScheduleMaintenanceResourceData(d, scheduleMaintenance)
 	return nil
 }
 
-func scheduleMaintenanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func scheduleMaintenanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	scheduleMaintenance := resourceDataToScheduleMaintenance(d)
 
 	scheduleMaintenance, err := client.ScheduleMaintenance().Update(scheduleMaintenance)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(scheduleMaintenance.MaintenanceID)
 	return nil
 }
 
-func scheduleMaintenanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func scheduleMaintenanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ScheduleMaintenance().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
-	return err
-}
-
-func scheduleMaintenanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.ScheduleMaintenance().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-	if err != nil {
-		return false, err
-	}
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToScheduleMaintenance(d *schema.ResourceData) *api.ScheduleMaintenance {
@@ -317,4 +310,4 @@ func updateScheduleMaintenanceResourceData(d *schema.ResourceData, sm *api.Sched
 			d.Set("maintenance_start_on", sm.MaintenanceStartOn)
 		}
 	}
-}
\ No newline at end of file
+}
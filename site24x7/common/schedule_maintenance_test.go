@@ -1,9 +1,11 @@
 package common
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -32,13 +34,13 @@ func TestScheduleMaintenanceCreate(t *testing.T) {
 
 	c.FakeScheduleMaintenance.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, scheduleMaintenanceCreate(d, c))
+	require.Nil(t, scheduleMaintenanceCreate(context.Background(), d, c))
 
 	c.FakeScheduleMaintenance.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := scheduleMaintenanceCreate(d, c)
+	err := scheduleMaintenanceCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestScheduleMaintenanceUpdate(t *testing.T) {
@@ -64,13 +66,13 @@ func TestScheduleMaintenanceUpdate(t *testing.T) {
 
 	c.FakeScheduleMaintenance.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, scheduleMaintenanceUpdate(d, c))
+	require.Nil(t, scheduleMaintenanceUpdate(context.Background(), d, c))
 
 	c.FakeScheduleMaintenance.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := scheduleMaintenanceUpdate(d, c)
+	err := scheduleMaintenanceUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestScheduleMaintenanceRead(t *testing.T) {
@@ -81,13 +83,13 @@ func TestScheduleMaintenanceRead(t *testing.T) {
 
 	c.FakeScheduleMaintenance.On("Get", "123").Return(&api.ScheduleMaintenance{}, nil).Once()
 
-	require.NoError(t, scheduleMaintenanceRead(d, c))
+	require.Nil(t, scheduleMaintenanceRead(context.Background(), d, c))
 
 	c.FakeScheduleMaintenance.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := scheduleMaintenanceRead(d, c)
+	err := scheduleMaintenanceRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestScheduleMaintenanceDelete(t *testing.T) {
@@ -98,39 +100,23 @@ func TestScheduleMaintenanceDelete(t *testing.T) {
 
 	c.FakeScheduleMaintenance.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, scheduleMaintenanceDelete(d, c))
+	require.Nil(t, scheduleMaintenanceDelete(context.Background(), d, c))
 
 	c.FakeScheduleMaintenance.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, scheduleMaintenanceDelete(d, c))
+	require.Nil(t, scheduleMaintenanceDelete(context.Background(), d, c))
 }
 
-func TestScheduleMaintenanceExists(t *testing.T) {
+func TestScheduleMaintenanceReadNotFound(t *testing.T) {
 	d := scheduleMaintenanceTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeScheduleMaintenance.On("Get", "123").Return(&api.ScheduleMaintenance{}, nil).Once()
-
-	exists, err := scheduleMaintenanceExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeScheduleMaintenance.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = scheduleMaintenanceExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeScheduleMaintenance.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = scheduleMaintenanceExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, scheduleMaintenanceRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func scheduleMaintenanceTestResourceData(t *testing.T) *schema.ResourceData {
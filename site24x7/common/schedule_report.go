@@ -1,7 +1,10 @@
 package common
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -20,9 +23,9 @@ var ScheduleReportSchema = map[string]*schema.Schema{
 		Description: "Report type constant. Summary Report = 17.",
 	},
 	"selection_type": {
-		Type:         schema.TypeInt,
-		Required:     true,
-		Description:  "Resource type for the report. Allowed values: 0 (All Monitors), 2 (Monitors), 3 (Tags), 4 (Monitor Type).",
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "Resource type for the report. Allowed values: 0 (All Monitors), 2 (Monitors), 3 (Tags), 4 (Monitor Type).",
 	},
 	"report_format": {
 		Type:        schema.TypeInt,
@@ -57,36 +60,35 @@ var ScheduleReportSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7ScheduleReport() *schema.Resource {
 	return &schema.Resource{
-		Create: scheduleReportCreate,
-		Read:   scheduleReportRead,
-		Update: scheduleReportUpdate,
-		Delete: scheduleReportDelete,
-		Exists: scheduleReportExists,
+		CreateContext: scheduleReportCreate,
+		ReadContext:   scheduleReportRead,
+		UpdateContext: scheduleReportUpdate,
+		DeleteContext: scheduleReportDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: ScheduleReportSchema,
 	}
 }
 
-func scheduleReportCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func scheduleReportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	sr := resourceDataToScheduleReport(d)
 
 	created, err := client.ScheduleReport().Create(sr)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(created.ReportID)
 	return nil
 }
 
-func scheduleReportRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func scheduleReportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	sr, err := client.ScheduleReport().Get(d.Id())
 	if apierrors.IsNotFound(err) {
@@ -94,7 +96,7 @@ func scheduleReportRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateScheduleReportResourceData(d, sr)
@@ -112,10 +114,10 @@ type scheduleReportUpdatePayload struct {
 	ScheduledDay    int      `json:"scheduled_day"`
 }
 
-func scheduleReportUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func scheduleReportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
-		var userGroups []string
+	var userGroups []string
 	for _, e := range d.Get("user_groups").([]interface{}) {
 		userGroups = append(userGroups, e.(string))
 	}
@@ -133,34 +135,21 @@ func scheduleReportUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	updated, err := client.ScheduleReport().UpdateRaw(d.Id(), payload)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(updated.ReportID)
 	return nil
 }
 
-func scheduleReportDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func scheduleReportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ScheduleReport().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
-	return err
-}
-
-func scheduleReportExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.ScheduleReport().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-	if err != nil {
-		return false, err
-	}
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToScheduleReport(d *schema.ResourceData) *api.ScheduleReport {
@@ -171,7 +160,7 @@ func resourceDataToScheduleReport(d *schema.ResourceData) *api.ScheduleReport {
 	}
 
 	return &api.ScheduleReport{
-		ReportID:         d.Id(), // ✅ CRITICAL FIX
+		ReportID:        d.Id(), // ✅ CRITICAL FIX
 		DisplayName:     d.Get("display_name").(string),
 		ReportType:      d.Get("report_type").(int),
 		SelectionType:   d.Get("selection_type").(int),
@@ -1,9 +1,11 @@
 package common
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -32,14 +34,14 @@ func TestScheduleReportCreate(t *testing.T) {
 
 	c.FakeScheduleReport.On("Create", a).Return(&created, nil).Once()
 
-	require.NoError(t, scheduleReportCreate(d, c))
+	require.Nil(t, scheduleReportCreate(context.Background(), d, c))
 	assert.Equal(t, "sr-123", d.Id())
 
 	c.FakeScheduleReport.On("Create", a).
 		Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := scheduleReportCreate(d, c)
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	err := scheduleReportCreate(context.Background(), d, c)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestScheduleReportUpdate(t *testing.T) {
@@ -75,14 +77,14 @@ func TestScheduleReportUpdate(t *testing.T) {
 		On("UpdateRaw", "sr-123", payload).
 		Return(updated, nil).Once()
 
-	require.NoError(t, scheduleReportUpdate(d, c))
+	require.Nil(t, scheduleReportUpdate(context.Background(), d, c))
 
 	c.FakeScheduleReport.
 		On("UpdateRaw", "sr-123", payload).
 		Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := scheduleReportUpdate(d, c)
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	err := scheduleReportUpdate(context.Background(), d, c)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestScheduleReportRead(t *testing.T) {
@@ -104,7 +106,7 @@ func TestScheduleReportRead(t *testing.T) {
 	}
 
 	c.FakeScheduleReport.On("Get", "sr-123").Return(sr, nil).Once()
-	require.NoError(t, scheduleReportRead(d, c))
+	require.Nil(t, scheduleReportRead(context.Background(), d, c))
 
 	assert.Equal(t, "Daily Summary", d.Get("display_name"))
 
@@ -112,8 +114,8 @@ func TestScheduleReportRead(t *testing.T) {
 		On("Get", "sr-123").
 		Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := scheduleReportRead(d, c)
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	err := scheduleReportRead(context.Background(), d, c)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestScheduleReportDelete(t *testing.T) {
@@ -123,44 +125,27 @@ func TestScheduleReportDelete(t *testing.T) {
 	c := fake.NewClient()
 
 	c.FakeScheduleReport.On("Delete", "sr-123").Return(nil).Once()
-	require.NoError(t, scheduleReportDelete(d, c))
+	require.Nil(t, scheduleReportDelete(context.Background(), d, c))
 
 	c.FakeScheduleReport.
 		On("Delete", "sr-123").
 		Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, scheduleReportDelete(d, c))
+	require.Nil(t, scheduleReportDelete(context.Background(), d, c))
 }
 
-func TestScheduleReportExists(t *testing.T) {
+func TestScheduleReportReadNotFound(t *testing.T) {
 	d := scheduleReportTestResourceData(t)
 	d.SetId("sr-123")
 
 	c := fake.NewClient()
 
-	c.FakeScheduleReport.
-		On("Get", "sr-123").
-		Return(&api.ScheduleReport{}, nil).Once()
-
-	exists, err := scheduleReportExists(d, c)
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeScheduleReport.
 		On("Get", "sr-123").
 		Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = scheduleReportExists(d, c)
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeScheduleReport.
-		On("Get", "sr-123").
-		Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = scheduleReportExists(d, c)
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, scheduleReportRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func scheduleReportTestResourceData(t *testing.T) *schema.ResourceData {
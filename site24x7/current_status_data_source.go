@@ -0,0 +1,320 @@
+package site24x7
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+)
+
+// monitorStatusNames maps monitor statuses to the names used in
+// configurations.
+var monitorStatusNames = map[api.Status]string{
+	api.Down:               "down",
+	api.Up:                 "up",
+	api.Trouble:            "trouble",
+	api.Critical:           "critical",
+	api.Suspended:          "suspended",
+	api.Maintenance:        "maintenance",
+	api.Discovery:          "discovery",
+	api.ConfigurationError: "configuration_error",
+}
+
+// statusName returns the name of a monitor status, or its number if the
+// status is unknown.
+func statusName(status api.Status) string {
+	if name, ok := monitorStatusNames[status]; ok {
+		return name
+	}
+
+	return fmt.Sprint(int(status))
+}
+
+func validStatusNames() []string {
+	var names []string
+	for _, name := range monitorStatusNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+var locationStatusSchema = map[string]*schema.Schema{
+	"location_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the monitoring location.",
+	},
+	"status": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Status of the monitor in the location.",
+	},
+	"status_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the status of the monitor in the location, e.g. up or down.",
+	},
+	"last_polled_time": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time the monitor was last polled from the location.",
+	},
+	"down_reason": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Reason the monitor is down in the location.",
+	},
+	"outage_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the ongoing outage in the location.",
+	},
+}
+
+var monitorStatusSchema = map[string]*schema.Schema{
+	"monitor_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the monitor.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Display name of the monitor.",
+	},
+	"monitor_type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the monitor.",
+	},
+	"status": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Status of the monitor.",
+	},
+	"status_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the status of the monitor, e.g. up or down.",
+	},
+	"last_polled_time": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Time the monitor was last polled.",
+	},
+	"down_reason": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Reason the monitor is down.",
+	},
+	"outage_id": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the ongoing outage.",
+	},
+	"duration": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Duration the monitor has been in its current status.",
+	},
+	"tags": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Tags of the monitor.",
+	},
+	"locations": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Resource{Schema: locationStatusSchema},
+		Description: "Status of the monitor per monitoring location.",
+	},
+}
+
+var currentStatusDataSourceSchema = map[string]*schema.Schema{
+	"monitor_id": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		ConflictsWith: []string{"monitor_group_id"},
+		Description:   "ID of the monitor to retrieve the status of.",
+	},
+	"monitor_group_id": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "ID of the monitor group to retrieve the statuses of the monitors of.",
+	},
+	"monitor_type": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Type of the monitors to retrieve the statuses of, e.g. URL.",
+	},
+	"tag_ids": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "IDs of tags. Only monitors having at least one of the tags are included.",
+	},
+	"statuses": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(validStatusNames(), false),
+		},
+		Description: "Names of statuses, e.g. down or trouble. Only monitors having one of the statuses are included.",
+	},
+	"monitor_ids": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "IDs of the matching monitors.",
+	},
+	"monitors": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Resource{Schema: monitorStatusSchema},
+		Description: "Current statuses of the matching monitors.",
+	},
+}
+
+func DataSourceSite24x7CurrentStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: currentStatusDataSourceRead,
+		Schema:      currentStatusDataSourceSchema,
+	}
+}
+
+// currentStatusDataSourceRead fetches the current statuses of the monitors
+// matching the filters. The most specific of the current status endpoints is
+// used, the remaining filters are applied to its result.
+func currentStatusDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
+
+	statuses, err := fetchCurrentStatuses(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	monitorType := d.Get("monitor_type").(string)
+	tagIDs := d.Get("tag_ids").(*schema.Set)
+	statusNames := d.Get("statuses").(*schema.Set)
+
+	var monitorIDs []string
+	var monitors []interface{}
+	for _, status := range statuses {
+		if monitorType != "" && status.MonitorType != monitorType {
+			continue
+		}
+		if tagIDs.Len() > 0 && !hasAnyTag(status.Tags, tagIDs) {
+			continue
+		}
+		if statusNames.Len() > 0 && !statusNames.Contains(statusName(status.Status)) {
+			continue
+		}
+
+		monitorIDs = append(monitorIDs, status.MonitorID)
+		monitors = append(monitors, flattenMonitorStatus(status))
+	}
+
+	d.SetId(currentStatusDataSourceID(d))
+	d.Set("monitor_ids", monitorIDs)
+	d.Set("monitors", monitors)
+
+	return nil
+}
+
+func fetchCurrentStatuses(client Client, d *schema.ResourceData) ([]*api.MonitorStatus, error) {
+	if monitorID, ok := d.GetOk("monitor_id"); ok {
+		status, err := client.CurrentStatus().Get(monitorID.(string))
+		if err != nil {
+			return nil, err
+		}
+		return []*api.MonitorStatus{status}, nil
+	}
+
+	var statuses *api.MonitorsStatus
+	var err error
+	if groupID, ok := d.GetOk("monitor_group_id"); ok {
+		statuses, err = client.CurrentStatus().ListGroup(groupID.(string))
+	} else if monitorType, ok := d.GetOk("monitor_type"); ok {
+		statuses, err = client.CurrentStatus().ListType(monitorType.(string))
+	} else {
+		// Without group_required=false, monitors belonging to a monitor group
+		// are nested in the groups instead of listed with the others.
+		statuses, err = client.CurrentStatus().List(&api.CurrentStatusListOptions{
+			GroupRequired:     api.Bool(false),
+			SuspendedRequired: api.Bool(true),
+			LocationsRequired: api.Bool(true),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses.Monitors, nil
+}
+
+func hasAnyTag(tags []string, tagIDs *schema.Set) bool {
+	for _, tag := range tags {
+		if tagIDs.Contains(tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenMonitorStatus(status *api.MonitorStatus) map[string]interface{} {
+	var locations []interface{}
+	for _, location := range status.Locations {
+		locations = append(locations, map[string]interface{}{
+			"location_name":    location.LocationName,
+			"status":           int(location.Status),
+			"status_name":      statusName(location.Status),
+			"last_polled_time": location.LastPolledTime,
+			"down_reason":      location.DownReason,
+			"outage_id":        location.OutageID,
+		})
+	}
+
+	return map[string]interface{}{
+		"monitor_id":       status.MonitorID,
+		"name":             status.Name,
+		"monitor_type":     status.MonitorType,
+		"status":           int(status.Status),
+		"status_name":      statusName(status.Status),
+		"last_polled_time": status.LastPolledTime,
+		"down_reason":      status.DownReason,
+		"outage_id":        status.OutageID,
+		"duration":         status.Duration,
+		"tags":             status.Tags,
+		"locations":        locations,
+	}
+}
+
+// currentStatusDataSourceID derives a stable ID from the filters.
+func currentStatusDataSourceID(d *schema.ResourceData) string {
+	filters := []string{
+		d.Get("monitor_id").(string),
+		d.Get("monitor_group_id").(string),
+		d.Get("monitor_type").(string),
+		strings.Join(setToSortedStrings(d.Get("tag_ids").(*schema.Set)), ","),
+		strings.Join(setToSortedStrings(d.Get("statuses").(*schema.Set)), ","),
+	}
+
+	return fmt.Sprintf("%d", schema.HashString(strings.Join(filters, "/")))
+}
+
+func setToSortedStrings(set *schema.Set) []string {
+	values := setToStrings(set)
+	sort.Strings(values)
+
+	return values
+}
@@ -0,0 +1,112 @@
+package site24x7
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+	"github.com/site24x7/terraform-provider-site24x7/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMonitorsStatus = &api.MonitorsStatus{
+	Monitors: []*api.MonitorStatus{
+		{
+			MonitorID:      "1",
+			Name:           "foo",
+			MonitorType:    "URL",
+			Status:         api.Up,
+			LastPolledTime: "2026-10-18T10:00:00+0000",
+			Tags:           []string{"10"},
+			Locations: []*api.LocationStatus{
+				{LocationName: "London - UK", Status: api.Up},
+				{LocationName: "Dallas - US", Status: api.Down, DownReason: "Connection timed out", OutageID: "99"},
+			},
+		},
+		{
+			MonitorID:   "2",
+			Name:        "bar",
+			MonitorType: "URL",
+			Status:      api.Down,
+			DownReason:  "Connection timed out",
+			OutageID:    "100",
+			Tags:        []string{"20"},
+		},
+		{
+			MonitorID:   "3",
+			Name:        "baz",
+			MonitorType: "SSL_CERT",
+			Status:      api.Trouble,
+			Tags:        []string{"20"},
+		},
+	},
+}
+
+func TestCurrentStatusDataSourceRead(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeCurrentStatus.On("List", &api.CurrentStatusListOptions{
+		GroupRequired:     api.Bool(false),
+		SuspendedRequired: api.Bool(true),
+		LocationsRequired: api.Bool(true),
+	}).Return(testMonitorsStatus, nil)
+
+	d := currentStatusTestResourceData(t, map[string]interface{}{})
+	require.Nil(t, currentStatusDataSourceRead(context.Background(), d, c))
+
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, []interface{}{"1", "2", "3"}, d.Get("monitor_ids"))
+	assert.Equal(t, "up", d.Get("monitors.0.status_name"))
+	assert.Equal(t, "2026-10-18T10:00:00+0000", d.Get("monitors.0.last_polled_time"))
+	assert.Equal(t, "Dallas - US", d.Get("monitors.0.locations.1.location_name"))
+	assert.Equal(t, "down", d.Get("monitors.0.locations.1.status_name"))
+	assert.Equal(t, "99", d.Get("monitors.0.locations.1.outage_id"))
+	assert.Equal(t, 0, d.Get("monitors.1.status"))
+	assert.Equal(t, "Connection timed out", d.Get("monitors.1.down_reason"))
+	assert.Equal(t, "100", d.Get("monitors.1.outage_id"))
+
+	d = currentStatusTestResourceData(t, map[string]interface{}{
+		"tag_ids":  []interface{}{"20"},
+		"statuses": []interface{}{"down", "up"},
+	})
+	require.Nil(t, currentStatusDataSourceRead(context.Background(), d, c))
+
+	assert.Equal(t, []interface{}{"2"}, d.Get("monitor_ids"))
+}
+
+func TestCurrentStatusDataSourceReadEndpoints(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeCurrentStatus.On("Get", "1").Return(testMonitorsStatus.Monitors[0], nil)
+	c.FakeCurrentStatus.On("ListGroup", "5").Return(testMonitorsStatus, nil)
+	c.FakeCurrentStatus.On("ListType", "SSL_CERT").Return(&api.MonitorsStatus{Monitors: testMonitorsStatus.Monitors[2:]}, nil)
+
+	d := currentStatusTestResourceData(t, map[string]interface{}{"monitor_id": "1"})
+	require.Nil(t, currentStatusDataSourceRead(context.Background(), d, c))
+	assert.Equal(t, []interface{}{"1"}, d.Get("monitor_ids"))
+
+	d = currentStatusTestResourceData(t, map[string]interface{}{"monitor_group_id": "5", "monitor_type": "URL"})
+	require.Nil(t, currentStatusDataSourceRead(context.Background(), d, c))
+	assert.Equal(t, []interface{}{"1", "2"}, d.Get("monitor_ids"))
+
+	d = currentStatusTestResourceData(t, map[string]interface{}{"monitor_type": "SSL_CERT"})
+	require.Nil(t, currentStatusDataSourceRead(context.Background(), d, c))
+	assert.Equal(t, []interface{}{"3"}, d.Get("monitor_ids"))
+	assert.Equal(t, "trouble", d.Get("monitors.0.status_name"))
+}
+
+func TestCurrentStatusDataSourceReadError(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeCurrentStatus.On("Get", "1").Return(nil, apierrors.NewStatusError(500, "error"))
+
+	d := currentStatusTestResourceData(t, map[string]interface{}{"monitor_id": "1"})
+	err := currentStatusDataSourceRead(context.Background(), d, c)
+
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
+}
+
+func currentStatusTestResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, DataSourceSite24x7CurrentStatus().Schema, raw)
+}
@@ -1,47 +1,65 @@
 package site24x7
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/site24x7/terraform-provider-site24x7/oauth"
+)
+
+// dataCenterCodes lists the data center codes in the order in which they are
+// probed when detecting the data center of a refresh token.
+var dataCenterCodes = []string{"US", "EU", "IN", "AU", "CN", "JP", "CA"}
+
 var dataCenter = map[string]DataCenter{
 	"US": {
 		displayName:          "United States",
 		code:                 "US",
 		site24x7APIBaseURL:   "https://www.site24x7.com/api",
 		zohoAccountsTokenURL: "https://accounts.zoho.com/oauth/v2/token",
+		zohoAPIDomain:        "https://www.zohoapis.com",
 	},
 	"EU": {
 		displayName:          "Europe",
 		code:                 "EU",
 		site24x7APIBaseURL:   "https://www.site24x7.eu/api",
 		zohoAccountsTokenURL: "https://accounts.zoho.eu/oauth/v2/token",
+		zohoAPIDomain:        "https://www.zohoapis.eu",
 	},
 	"IN": {
 		displayName:          "India",
 		code:                 "IN",
 		site24x7APIBaseURL:   "https://www.site24x7.in/api",
 		zohoAccountsTokenURL: "https://accounts.zoho.in/oauth/v2/token",
+		zohoAPIDomain:        "https://www.zohoapis.in",
 	},
 	"AU": {
 		displayName:          "Australia",
 		code:                 "AU",
 		site24x7APIBaseURL:   "https://www.site24x7.net.au/api",
 		zohoAccountsTokenURL: "https://accounts.zoho.com.au/oauth/v2/token",
+		zohoAPIDomain:        "https://www.zohoapis.com.au",
 	},
 	"CN": {
 		displayName:          "China",
 		code:                 "CN",
 		site24x7APIBaseURL:   "https://www.site24x7.cn/api",
 		zohoAccountsTokenURL: "https://accounts.zoho.com.cn/oauth/v2/token",
+		zohoAPIDomain:        "https://www.zohoapis.com.cn",
 	},
 	"JP": {
 		displayName:          "Japan",
 		code:                 "JP",
-		site24x7APIBaseURL:   "https://www.site24x7.jp//api",
+		site24x7APIBaseURL:   "https://www.site24x7.jp/api",
 		zohoAccountsTokenURL: "https://accounts.zoho.jp/oauth/v2/token",
+		zohoAPIDomain:        "https://www.zohoapis.jp",
 	},
 	"CA": {
 		displayName:          "Canada",
 		code:                 "CA",
 		site24x7APIBaseURL:   "https://www.site24x7.ca/api",
 		zohoAccountsTokenURL: "https://accounts.zohocloud.ca/oauth/v2/token",
+		zohoAPIDomain:        "https://www.zohoapis.ca",
 	},
 }
 
@@ -50,6 +68,7 @@ type DataCenter struct {
 	code                 string
 	site24x7APIBaseURL   string
 	zohoAccountsTokenURL string
+	zohoAPIDomain        string
 }
 
 func (dc *DataCenter) GetAPIBaseURL() string {
@@ -60,6 +79,42 @@ func (dc *DataCenter) GetTokenURL() string {
 	return dc.zohoAccountsTokenURL
 }
 
-func GetDataCenter(dataCenterCode string) DataCenter {
-	return dataCenter[dataCenterCode]
+// GetAPIDomain returns the Zoho API domain which the token endpoint reports
+// in the api_domain field for accounts of this data center.
+func (dc *DataCenter) GetAPIDomain() string {
+	return dc.zohoAPIDomain
+}
+
+// GetCode returns the data center code, e.g. US.
+func (dc *DataCenter) GetCode() string {
+	return dc.code
+}
+
+// DataCenterCodes returns the codes of all known data centers.
+func DataCenterCodes() []string {
+	return append([]string(nil), dataCenterCodes...)
+}
+
+// GetDataCenter returns the DataCenter for dataCenterCode. It returns an error
+// if the code is unknown.
+func GetDataCenter(dataCenterCode string) (DataCenter, error) {
+	dc, ok := dataCenter[strings.ToUpper(dataCenterCode)]
+	if !ok {
+		return DataCenter{}, fmt.Errorf("unknown data center %q, valid values are: %s", dataCenterCode, strings.Join(dataCenterCodes, ", "))
+	}
+
+	return dc, nil
+}
+
+// GetDataCenterByAPIDomain returns the DataCenter whose Zoho API domain
+// matches apiDomain, as reported in the api_domain field of token responses.
+func GetDataCenterByAPIDomain(apiDomain string) (DataCenter, error) {
+	for _, code := range dataCenterCodes {
+		dc := dataCenter[code]
+		if oauth.SameHost(dc.zohoAPIDomain, apiDomain) {
+			return dc, nil
+		}
+	}
+
+	return DataCenter{}, fmt.Errorf("unknown API domain %q, please configure data_center or api_base_url and token_url explicitly", apiDomain)
 }
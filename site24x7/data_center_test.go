@@ -0,0 +1,62 @@
+package site24x7
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDataCenter(t *testing.T) {
+	dc, err := GetDataCenter("eu")
+	require.NoError(t, err)
+
+	assert.Equal(t, "EU", dc.GetCode())
+	assert.Equal(t, "https://www.site24x7.eu/api", dc.GetAPIBaseURL())
+	assert.Equal(t, "https://accounts.zoho.eu/oauth/v2/token", dc.GetTokenURL())
+
+	_, err = GetDataCenter("XX")
+	require.Error(t, err)
+	assert.Equal(t, `unknown data center "XX", valid values are: US, EU, IN, AU, CN, JP, CA`, err.Error())
+}
+
+func TestDataCenterURLs(t *testing.T) {
+	expected := map[string][3]string{
+		"US": {"https://www.site24x7.com/api", "https://accounts.zoho.com/oauth/v2/token", "https://www.zohoapis.com"},
+		"EU": {"https://www.site24x7.eu/api", "https://accounts.zoho.eu/oauth/v2/token", "https://www.zohoapis.eu"},
+		"IN": {"https://www.site24x7.in/api", "https://accounts.zoho.in/oauth/v2/token", "https://www.zohoapis.in"},
+		"AU": {"https://www.site24x7.net.au/api", "https://accounts.zoho.com.au/oauth/v2/token", "https://www.zohoapis.com.au"},
+		"CN": {"https://www.site24x7.cn/api", "https://accounts.zoho.com.cn/oauth/v2/token", "https://www.zohoapis.com.cn"},
+		"JP": {"https://www.site24x7.jp/api", "https://accounts.zoho.jp/oauth/v2/token", "https://www.zohoapis.jp"},
+		"CA": {"https://www.site24x7.ca/api", "https://accounts.zohocloud.ca/oauth/v2/token", "https://www.zohoapis.ca"},
+	}
+
+	require.ElementsMatch(t, DataCenterCodes(), []string{"US", "EU", "IN", "AU", "CN", "JP", "CA"})
+
+	for _, code := range DataCenterCodes() {
+		t.Run(code, func(t *testing.T) {
+			dc, err := GetDataCenter(code)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected[code], [3]string{dc.GetAPIBaseURL(), dc.GetTokenURL(), dc.GetAPIDomain()})
+		})
+	}
+}
+
+func TestGetDataCenterByAPIDomain(t *testing.T) {
+	for _, code := range DataCenterCodes() {
+		expected, err := GetDataCenter(code)
+		require.NoError(t, err)
+
+		dc, err := GetDataCenterByAPIDomain(expected.GetAPIDomain())
+		require.NoError(t, err)
+		assert.Equal(t, expected, dc)
+	}
+
+	dc, err := GetDataCenterByAPIDomain("https://www.zohoapis.com.au/")
+	require.NoError(t, err)
+	assert.Equal(t, "AU", dc.GetCode())
+
+	_, err = GetDataCenterByAPIDomain("https://www.zohoapis.sa")
+	require.Error(t, err)
+}
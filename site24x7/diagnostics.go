@@ -0,0 +1,43 @@
+package site24x7
+
+import (
+	"errors"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+)
+
+// nameAttributes are the attributes holding the name of a resource, in the
+// order they are looked up.
+var nameAttributes = []string{"display_name", "profile_name", "tag_name", "name"}
+
+// APIErrorDiagnostics turns an error returned by a create or update call into
+// diagnostics. Duplicate names are attached to the name attribute of d, so
+// that Terraform points at the corresponding line of the configuration.
+func APIErrorDiagnostics(err error, d *schema.ResourceData) diag.Diagnostics {
+	diagnostic := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+	}
+
+	if errors.Is(err, apierrors.ErrDuplicateName) {
+		diagnostic.Detail = "Another resource with the same name already exists. Choose a unique name or import the existing resource."
+		diagnostic.AttributePath = configuredAttributePath(d, nameAttributes...)
+	}
+
+	return diag.Diagnostics{diagnostic}
+}
+
+// configuredAttributePath returns the path of the first of attributes that is
+// set in d, or nil if none is.
+func configuredAttributePath(d *schema.ResourceData, attributes ...string) cty.Path {
+	for _, attribute := range attributes {
+		if _, ok := d.GetOk(attribute); ok {
+			return cty.GetAttrPath(attribute)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package site24x7
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"display_name": {Type: schema.TypeString, Optional: true},
+		"tag_name":     {Type: schema.TypeString, Optional: true},
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"display_name": "foo",
+	})
+
+	tests := []struct {
+		name     string
+		err      error
+		expected cty.Path
+	}{
+		{
+			name:     "duplicate name",
+			err:      fmt.Errorf("giving up: %w", apierrors.NewExtendedStatusError(400, "display name already exists", apierrors.CodeDuplicateName, nil)),
+			expected: cty.GetAttrPath("display_name"),
+		},
+		{
+			name: "validation failure",
+			err:  apierrors.NewExtendedStatusError(400, "invalid parameter", apierrors.CodeInvalidParameter, nil),
+		},
+		{
+			name: "other error",
+			err:  errors.New("boom"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := APIErrorDiagnostics(test.err, d)
+			require.Len(t, diags, 1)
+			assert.True(t, diags.HasError())
+			assert.Equal(t, test.err.Error(), diags[0].Summary)
+			assert.Equal(t, test.expected, diags[0].AttributePath)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package site24x7
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+)
+
+const (
+	// importNamePrefix prefixes import IDs that hold the display name of
+	// the object to import instead of its ID.
+	importNamePrefix = "name:"
+
+	// importTypePrefix prefixes the monitor type in monitor import IDs of
+	// the form type:<monitor type>/name:<display name>.
+	importTypePrefix = "type:"
+)
+
+// Finder returns the IDs of the objects with the given name.
+type Finder func(c Client, name string) ([]string, error)
+
+// ImportByName returns an importer that accepts import IDs of the form
+// name:<display name> in addition to plain IDs. The name is resolved with
+// find, which usually filters the result of a list call. kind describes the
+// imported objects in errors, e.g. "location profile".
+func ImportByName(kind string, find Finder) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		name, ok := strings.CutPrefix(d.Id(), importNamePrefix)
+		if !ok {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		id, err := resolveName(WithContext(ctx, meta.(Client)), kind, name, find)
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// ImportMonitorByName returns an importer for monitors of the given types
+// that accepts import IDs of the form name:<display name> and
+// type:<monitor type>/name:<display name> in addition to plain IDs.
+func ImportMonitorByName(monitorTypes ...api.MonitorType) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		importID := d.Id()
+		types := monitorTypes
+
+		if rest, ok := strings.CutPrefix(importID, importTypePrefix); ok {
+			monitorType, name, ok := strings.Cut(rest, "/")
+			if !ok || !strings.HasPrefix(name, importNamePrefix) {
+				return nil, fmt.Errorf("invalid import ID %q, expected type:<monitor type>/name:<display name>", importID)
+			}
+			if !containsMonitorType(monitorTypes, api.MonitorType(monitorType)) {
+				return nil, fmt.Errorf("invalid import ID %q, the resource manages monitors of type %s", importID, joinMonitorTypes(monitorTypes))
+			}
+
+			types = []api.MonitorType{api.MonitorType(monitorType)}
+			importID = name
+		}
+
+		name, ok := strings.CutPrefix(importID, importNamePrefix)
+		if !ok {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		id, err := resolveName(WithContext(ctx, meta.(Client)), joinMonitorTypes(types)+" monitor", name, findMonitors(types...))
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// ImportIntegrationByName returns an importer for third party integrations
+// that accepts import IDs of the form name:<display name> in addition to
+// plain IDs. Integrations of all types are listed together, so only those
+// managed by resourceType are matched by name.
+func ImportIntegrationByName(kind, resourceType string) schema.StateContextFunc {
+	return ImportByName(kind, func(c Client, name string) ([]string, error) {
+		integrations, err := c.ThirdPartyIntegrations().List()
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, integration := range integrations {
+			if integration.Name != name {
+				continue
+			}
+
+			t, err := IntegrationResourceType(c, integration)
+			if err != nil {
+				return nil, err
+			}
+			if t == resourceType {
+				ids = append(ids, integration.ServiceID)
+			}
+		}
+
+		return ids, nil
+	})
+}
+
+// findMonitors returns a Finder for monitors of the given types.
+func findMonitors(monitorTypes ...api.MonitorType) Finder {
+	return func(c Client, name string) ([]string, error) {
+		// The list endpoint of any monitor type returns monitors of all
+		// types.
+		monitors, err := c.WebsiteMonitors().List()
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, monitor := range monitors {
+			if monitor.DisplayName == name && containsMonitorType(monitorTypes, api.MonitorType(monitor.Type)) {
+				ids = append(ids, monitor.MonitorID)
+			}
+		}
+
+		return ids, nil
+	}
+}
+
+func findLocationProfiles(c Client, name string) ([]string, error) {
+	profiles, err := c.LocationProfiles().List()
+	return matching(profiles, err, name, func(p *api.LocationProfile) (string, string) { return p.ProfileID, p.ProfileName }), err
+}
+
+func findNotificationProfiles(c Client, name string) ([]string, error) {
+	profiles, err := c.NotificationProfiles().List()
+	return matching(profiles, err, name, func(p *api.NotificationProfile) (string, string) { return p.ProfileID, p.ProfileName }), err
+}
+
+func findThresholdProfiles(c Client, name string) ([]string, error) {
+	profiles, err := c.ThresholdProfiles().List()
+	return matching(profiles, err, name, func(p *api.ThresholdProfile) (string, string) { return p.ProfileID, p.ProfileName }), err
+}
+
+func findMonitorGroups(c Client, name string) ([]string, error) {
+	groups, err := c.MonitorGroups().List()
+	return matching(groups, err, name, func(g *api.MonitorGroup) (string, string) { return g.GroupID, g.DisplayName }), err
+}
+
+func findUserGroups(c Client, name string) ([]string, error) {
+	groups, err := c.UserGroups().List()
+	return matching(groups, err, name, func(g *api.UserGroup) (string, string) { return g.UserGroupID, g.DisplayName }), err
+}
+
+func findTags(c Client, name string) ([]string, error) {
+	tags, err := c.Tags().List()
+	return matching(tags, err, name, func(t *api.Tag) (string, string) { return t.TagID, t.TagName }), err
+}
+
+// matching returns the IDs of the items with the given name. attrs returns
+// the ID and name of an item.
+func matching[T any](items []T, err error, name string, attrs func(T) (string, string)) []string {
+	if err != nil {
+		return nil
+	}
+
+	var ids []string
+	for _, item := range items {
+		if id, itemName := attrs(item); itemName == name {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// resolveName returns the ID of the only object with the given name.
+func resolveName(c Client, kind, name string, find Finder) (string, error) {
+	ids, err := find(c, name)
+	if err != nil {
+		return "", fmt.Errorf("unable to look up %s %q: %w", kind, name, err)
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s named %q found", kind, name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d %ss named %q found (IDs %s), import one of them by ID instead", len(ids), kind, name, strings.Join(ids, ", "))
+	}
+}
+
+func containsMonitorType(monitorTypes []api.MonitorType, monitorType api.MonitorType) bool {
+	for _, t := range monitorTypes {
+		if t == monitorType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func joinMonitorTypes(monitorTypes []api.MonitorType) string {
+	types := make([]string, len(monitorTypes))
+	for i, t := range monitorTypes {
+		types[i] = string(t)
+	}
+
+	return strings.Join(types, "/")
+}
@@ -0,0 +1,120 @@
+package site24x7
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+	"github.com/site24x7/terraform-provider-site24x7/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func importID(t *testing.T, importer schema.StateContextFunc, id string, c Client) (string, error) {
+	d := schema.TestResourceDataRaw(t, MonitorGroupSchema, map[string]interface{}{})
+	d.SetId(id)
+
+	result, err := importer(context.Background(), d, c)
+	if err != nil {
+		return "", err
+	}
+	require.Len(t, result, 1)
+
+	return result[0].Id(), nil
+}
+
+func TestImportByName(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeMonitorGroups.On("List").Return([]*api.MonitorGroup{
+		{GroupID: "1", DisplayName: "foo"},
+		{GroupID: "2", DisplayName: "bar"},
+		{GroupID: "3", DisplayName: "bar"},
+	}, nil)
+
+	importer := ResourceSite24x7MonitorGroup().Importer.StateContext
+
+	id, err := importID(t, importer, "name:foo", c)
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+
+	id, err = importID(t, importer, "123", c)
+	require.NoError(t, err)
+	assert.Equal(t, "123", id)
+
+	_, err = importID(t, importer, "name:baz", c)
+	assert.EqualError(t, err, `no monitor group named "baz" found`)
+
+	_, err = importID(t, importer, "name:bar", c)
+	assert.EqualError(t, err, `2 monitor groups named "bar" found (IDs 2, 3), import one of them by ID instead`)
+}
+
+func TestImportByNameListError(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeTags.On("List").Return(nil, apierrors.NewStatusError(500, "error"))
+
+	_, err := importID(t, ResourceSite24x7Tag().Importer.StateContext, "name:foo", c)
+	assert.EqualError(t, err, `unable to look up tag "foo": error`)
+}
+
+func TestImportMonitorByName(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeWebsiteMonitors.On("List").Return([]*api.WebsiteMonitor{
+		{MonitorID: "1", DisplayName: "foo", Type: string(api.URL)},
+		{MonitorID: "2", DisplayName: "foo", Type: string(api.SSL_CERT)},
+		{MonitorID: "3", DisplayName: "bar", Type: string(api.SSL_CERT)},
+	}, nil)
+
+	importer := ImportMonitorByName(api.URL)
+
+	id, err := importID(t, importer, "name:foo", c)
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+
+	id, err = importID(t, importer, "type:URL/name:foo", c)
+	require.NoError(t, err)
+	assert.Equal(t, "1", id)
+
+	_, err = importID(t, importer, "name:bar", c)
+	assert.EqualError(t, err, `no URL monitor named "bar" found`)
+
+	_, err = importID(t, importer, "type:SSL_CERT/name:bar", c)
+	assert.EqualError(t, err, `invalid import ID "type:SSL_CERT/name:bar", the resource manages monitors of type URL`)
+
+	_, err = importID(t, importer, "type:URL/foo", c)
+	assert.EqualError(t, err, `invalid import ID "type:URL/foo", expected type:<monitor type>/name:<display name>`)
+}
+
+func TestImportIntegrationByName(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeThirdPartyIntegrations.On("List").Return([]*api.ThirdPartyIntegrations{
+		{ServiceID: "1", Name: "alerts", Type: 21},
+		{ServiceID: "2", Name: "alerts", Type: 5},
+		{ServiceID: "3", Name: "hook"},
+		{ServiceID: "4", Name: "hook"},
+	}, nil)
+	c.FakePagerDutyIntegration.On("Get", "3").Return(&api.PagerDutyIntegration{ServiceID: "3"}, nil)
+	c.FakePagerDutyIntegration.On("Get", "4").Return(nil, apierrors.NewStatusError(404, "not found"))
+	c.FakeServiceNowIntegration.On("Get", "4").Return(nil, apierrors.NewStatusError(404, "not found"))
+	c.FakeWebhookIntegration.On("Get", "4").Return(&api.WebhookIntegration{ServiceID: "4"}, nil)
+
+	id, err := importID(t, ImportIntegrationByName("Slack integration", "site24x7_slack_integration"), "name:alerts", c)
+	require.NoError(t, err)
+	assert.Equal(t, "2", id)
+
+	id, err = importID(t, ImportIntegrationByName("webhook integration", "site24x7_webhook_integration"), "name:hook", c)
+	require.NoError(t, err)
+	assert.Equal(t, "4", id)
+}
+
+func TestImportIntegrationByNameReadError(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeThirdPartyIntegrations.On("List").Return([]*api.ThirdPartyIntegrations{
+		{ServiceID: "1", Name: "hook"},
+	}, nil)
+	c.FakePagerDutyIntegration.On("Get", "1").Return(nil, apierrors.NewStatusError(403, "forbidden"))
+
+	_, err := importID(t, ImportIntegrationByName("webhook integration", "site24x7_webhook_integration"), "name:hook", c)
+	assert.EqualError(t, err, `unable to look up webhook integration "hook": forbidden`)
+}
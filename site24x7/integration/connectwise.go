@@ -1,7 +1,10 @@
 package integration
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -116,29 +119,28 @@ var ConnectwiseIntegrationSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7ConnectwiseIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: connectwiseIntegrationCreate,
-		Read:   connectwiseIntegrationRead,
-		Update: connectwiseIntegrationUpdate,
-		Delete: connectwiseIntegrationDelete,
-		Exists: connectwiseIntegrationExists,
+		CreateContext: connectwiseIntegrationCreate,
+		ReadContext:   connectwiseIntegrationRead,
+		UpdateContext: connectwiseIntegrationUpdate,
+		DeleteContext: connectwiseIntegrationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportIntegrationByName("ConnectWise integration", "site24x7_connectwise_integration"),
 		},
 		Schema: ConnectwiseIntegrationSchema,
 	}
 }
 
-func connectwiseIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func connectwiseIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	connectwiseIntegration, err := resourceDataToConnectwiseIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	connectwiseIntegration, err = client.ConnectwiseIntegration().Create(connectwiseIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(connectwiseIntegration.ServiceID)
@@ -146,12 +148,16 @@ func connectwiseIntegrationCreate(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func connectwiseIntegrationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func connectwiseIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	connectwiseIntegration, err := client.ConnectwiseIntegration().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateConnectwiseIntegrationResourceData(d, connectwiseIntegration)
@@ -159,17 +165,17 @@ func connectwiseIntegrationRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func connectwiseIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func connectwiseIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	connectwiseIntegration, err := resourceDataToConnectwiseIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	connectwiseIntegration, err = client.ConnectwiseIntegration().Update(connectwiseIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(connectwiseIntegration.ServiceID)
@@ -177,30 +183,15 @@ func connectwiseIntegrationUpdate(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func connectwiseIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func connectwiseIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ThirdPartyIntegrations().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func connectwiseIntegrationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.ConnectwiseIntegration().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToConnectwiseIntegration(d *schema.ResourceData) (*api.ConnectwiseIntegration, error) {
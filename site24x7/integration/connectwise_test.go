@@ -1,9 +1,11 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -19,10 +21,10 @@ func TestConnectwiseIntegrationCreate(t *testing.T) {
 	a := &api.ConnectwiseIntegration{
 		Name:          "foo",
 		URL:           "https://wefvsefv.connectwisedev.com/",
-		Company: 	   "zylker_c",
-		PublicKey: 	   "KefwvwfrmAb",
-    	PrivateKey:    "wegraaeagt",
-    	CompanyId: 	   "GreenInc",
+		Company:       "zylker_c",
+		PublicKey:     "KefwvwfrmAb",
+		PrivateKey:    "wegraaeagt",
+		CompanyId:     "GreenInc",
 		CloseStatus:   "Closed (resolved)",
 		SelectionType: 0,
 		TroubleAlert:  true,
@@ -33,13 +35,13 @@ func TestConnectwiseIntegrationCreate(t *testing.T) {
 
 	c.FakeConnectwiseIntegration.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, connectwiseIntegrationCreate(d, c))
+	require.Nil(t, connectwiseIntegrationCreate(context.Background(), d, c))
 
 	c.FakeConnectwiseIntegration.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := connectwiseIntegrationCreate(d, c)
+	err := connectwiseIntegrationCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestConnectwiseDutyIntegrationUpdate(t *testing.T) {
@@ -52,10 +54,10 @@ func TestConnectwiseDutyIntegrationUpdate(t *testing.T) {
 		ServiceID:     "123",
 		Name:          "foo",
 		URL:           "https://wefvsefv.connectwisedev.com/",
-		Company: 	   "zylker_c",
-		PublicKey: 	   "KefwvwfrmAb",
-    	PrivateKey:    "wegraaeagt",
-    	CompanyId: 	   "GreenInc",
+		Company:       "zylker_c",
+		PublicKey:     "KefwvwfrmAb",
+		PrivateKey:    "wegraaeagt",
+		CompanyId:     "GreenInc",
 		CloseStatus:   "Closed (resolved)",
 		SelectionType: 0,
 		TroubleAlert:  true,
@@ -66,13 +68,13 @@ func TestConnectwiseDutyIntegrationUpdate(t *testing.T) {
 
 	c.FakeConnectwiseIntegration.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, connectwiseIntegrationUpdate(d, c))
+	require.Nil(t, connectwiseIntegrationUpdate(context.Background(), d, c))
 
 	c.FakeConnectwiseIntegration.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := connectwiseIntegrationUpdate(d, c)
+	err := connectwiseIntegrationUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestConnectwiseIntegrationRead(t *testing.T) {
@@ -83,13 +85,13 @@ func TestConnectwiseIntegrationRead(t *testing.T) {
 
 	c.FakeConnectwiseIntegration.On("Get", "123").Return(&api.ConnectwiseIntegration{}, nil).Once()
 
-	require.NoError(t, connectwiseIntegrationRead(d, c))
+	require.Nil(t, connectwiseIntegrationRead(context.Background(), d, c))
 
 	c.FakeConnectwiseIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := connectwiseIntegrationRead(d, c)
+	err := connectwiseIntegrationRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestConnectwiseIntegrationDelete(t *testing.T) {
@@ -100,39 +102,23 @@ func TestConnectwiseIntegrationDelete(t *testing.T) {
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, connectwiseIntegrationDelete(d, c))
+	require.Nil(t, connectwiseIntegrationDelete(context.Background(), d, c))
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, connectwiseIntegrationDelete(d, c))
+	require.Nil(t, connectwiseIntegrationDelete(context.Background(), d, c))
 }
 
-func TestConnectwiseIntegrationExists(t *testing.T) {
+func TestConnectwiseIntegrationReadNotFound(t *testing.T) {
 	d := connectwiseIntegrationTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeConnectwiseIntegration.On("Get", "123").Return(&api.ConnectwiseIntegration{}, nil).Once()
-
-	exists, err := connectwiseIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeConnectwiseIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = connectwiseIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeConnectwiseIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = connectwiseIntegrationExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, connectwiseIntegrationRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func connectwiseIntegrationTestResourceData(t *testing.T) *schema.ResourceData {
@@ -140,10 +126,10 @@ func connectwiseIntegrationTestResourceData(t *testing.T) *schema.ResourceData {
 		"name":           "foo",
 		"url":            "https://wefvsefv.connectwisedev.com/",
 		"selection_type": 0,
-		"company": 		  "zylker_c",
-		"public_key": 	  "KefwvwfrmAb",
-    	"private_key": 	  "wegraaeagt",
-    	"company_id": 	  "GreenInc",
+		"company":        "zylker_c",
+		"public_key":     "KefwvwfrmAb",
+		"private_key":    "wegraaeagt",
+		"company_id":     "GreenInc",
 		"close_status":   "Closed (resolved)",
 		"trouble_alert":  true,
 		"critical_alert": true,
@@ -153,4 +139,4 @@ func connectwiseIntegrationTestResourceData(t *testing.T) *schema.ResourceData {
 			"456",
 		},
 	})
-}
\ No newline at end of file
+}
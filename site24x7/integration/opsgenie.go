@@ -1,7 +1,10 @@
 package integration
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -83,29 +86,28 @@ var OpsgenieIntegrationSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7OpsgenieIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: opsgenieIntegrationCreate,
-		Read:   opsgenieIntegrationRead,
-		Update: opsgenieIntegrationUpdate,
-		Delete: opsgenieIntegrationDelete,
-		Exists: opsgenieIntegrationExists,
+		CreateContext: opsgenieIntegrationCreate,
+		ReadContext:   opsgenieIntegrationRead,
+		UpdateContext: opsgenieIntegrationUpdate,
+		DeleteContext: opsgenieIntegrationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportIntegrationByName("Opsgenie integration", "site24x7_opsgenie_integration"),
 		},
 		Schema: OpsgenieIntegrationSchema,
 	}
 }
 
-func opsgenieIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func opsgenieIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	opsgenieIntegration, err := resourceDataToOpsgenieIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	opsgenieIntegration, err = client.OpsgenieIntegration().Create(opsgenieIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(opsgenieIntegration.ServiceID)
@@ -113,12 +115,16 @@ func opsgenieIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func opsgenieIntegrationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func opsgenieIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	opsgenieIntegration, err := client.OpsgenieIntegration().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateOpsgenieIntegrationResourceData(d, opsgenieIntegration)
@@ -126,17 +132,17 @@ func opsgenieIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func opsgenieIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func opsgenieIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	opsgenieIntegration, err := resourceDataToOpsgenieIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	opsgenieIntegration, err = client.OpsgenieIntegration().Update(opsgenieIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(opsgenieIntegration.ServiceID)
@@ -144,30 +150,15 @@ func opsgenieIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func opsgenieIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func opsgenieIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ThirdPartyIntegrations().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func opsgenieIntegrationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.OpsgenieIntegration().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToOpsgenieIntegration(d *schema.ResourceData) (*api.OpsgenieIntegration, error) {
@@ -1,9 +1,11 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -28,13 +30,13 @@ func TestOpsgenieIntegrationCreate(t *testing.T) {
 
 	c.FakeOpsgenieIntegration.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, opsgenieIntegrationCreate(d, c))
+	require.Nil(t, opsgenieIntegrationCreate(context.Background(), d, c))
 
 	c.FakeOpsgenieIntegration.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := opsgenieIntegrationCreate(d, c)
+	err := opsgenieIntegrationCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestOpsgenieIntegrationUpdate(t *testing.T) {
@@ -57,13 +59,13 @@ func TestOpsgenieIntegrationUpdate(t *testing.T) {
 
 	c.FakeOpsgenieIntegration.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, opsgenieIntegrationUpdate(d, c))
+	require.Nil(t, opsgenieIntegrationUpdate(context.Background(), d, c))
 
 	c.FakeOpsgenieIntegration.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := opsgenieIntegrationUpdate(d, c)
+	err := opsgenieIntegrationUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestOpsgenieIntegrationRead(t *testing.T) {
@@ -74,13 +76,13 @@ func TestOpsgenieIntegrationRead(t *testing.T) {
 
 	c.FakeOpsgenieIntegration.On("Get", "123").Return(&api.OpsgenieIntegration{}, nil).Once()
 
-	require.NoError(t, opsgenieIntegrationRead(d, c))
+	require.Nil(t, opsgenieIntegrationRead(context.Background(), d, c))
 
 	c.FakeOpsgenieIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := opsgenieIntegrationRead(d, c)
+	err := opsgenieIntegrationRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestOpsgenieIntegrationDelete(t *testing.T) {
@@ -91,39 +93,23 @@ func TestOpsgenieIntegrationDelete(t *testing.T) {
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, opsgenieIntegrationDelete(d, c))
+	require.Nil(t, opsgenieIntegrationDelete(context.Background(), d, c))
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, opsgenieIntegrationDelete(d, c))
+	require.Nil(t, opsgenieIntegrationDelete(context.Background(), d, c))
 }
 
-func TestOpsgenieIntegrationExists(t *testing.T) {
+func TestOpsgenieIntegrationReadNotFound(t *testing.T) {
 	d := opsgenieIntegrationTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeOpsgenieIntegration.On("Get", "123").Return(&api.OpsgenieIntegration{}, nil).Once()
-
-	exists, err := opsgenieIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeOpsgenieIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = opsgenieIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeOpsgenieIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = opsgenieIntegrationExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, opsgenieIntegrationRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func opsgenieIntegrationTestResourceData(t *testing.T) *schema.ResourceData {
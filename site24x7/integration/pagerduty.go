@@ -1,7 +1,10 @@
 package integration
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -93,29 +96,28 @@ var pagerDutyIntegrationSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7PagerDutyIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: pagerDutyIntegrationCreate,
-		Read:   pagerDutyIntegrationRead,
-		Update: pagerDutyIntegrationUpdate,
-		Delete: pagerDutyIntegrationDelete,
-		Exists: pagerDutyIntegrationExists,
+		CreateContext: pagerDutyIntegrationCreate,
+		ReadContext:   pagerDutyIntegrationRead,
+		UpdateContext: pagerDutyIntegrationUpdate,
+		DeleteContext: pagerDutyIntegrationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportIntegrationByName("PagerDuty integration", "site24x7_pagerduty_integration"),
 		},
 		Schema: pagerDutyIntegrationSchema,
 	}
 }
 
-func pagerDutyIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func pagerDutyIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	pagerDutyIntegration, err := resourceDataToPagerDutyIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	pagerDutyIntegration, err = client.PagerDutyIntegration().Create(pagerDutyIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(pagerDutyIntegration.ServiceID)
@@ -123,12 +125,16 @@ func pagerDutyIntegrationCreate(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func pagerDutyIntegrationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func pagerDutyIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	pagerDutyIntegration, err := client.PagerDutyIntegration().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updatePagerDutyIntegrationResourceData(d, pagerDutyIntegration)
@@ -136,17 +142,17 @@ func pagerDutyIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func pagerDutyIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func pagerDutyIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	pagerDutyIntegration, err := resourceDataToPagerDutyIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	pagerDutyIntegration, err = client.PagerDutyIntegration().Update(pagerDutyIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(pagerDutyIntegration.ServiceID)
@@ -154,30 +160,15 @@ func pagerDutyIntegrationUpdate(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func pagerDutyIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func pagerDutyIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ThirdPartyIntegrations().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func pagerDutyIntegrationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.PagerDutyIntegration().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToPagerDutyIntegration(d *schema.ResourceData) (*api.PagerDutyIntegration, error) {
@@ -1,9 +1,11 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -30,13 +32,13 @@ func TestPagerDutyIntegrationCreate(t *testing.T) {
 
 	c.FakePagerDutyIntegration.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, pagerDutyIntegrationCreate(d, c))
+	require.Nil(t, pagerDutyIntegrationCreate(context.Background(), d, c))
 
 	c.FakePagerDutyIntegration.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := pagerDutyIntegrationCreate(d, c)
+	err := pagerDutyIntegrationCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestPagerDutyIntegrationUpdate(t *testing.T) {
@@ -60,13 +62,13 @@ func TestPagerDutyIntegrationUpdate(t *testing.T) {
 
 	c.FakePagerDutyIntegration.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, pagerDutyIntegrationUpdate(d, c))
+	require.Nil(t, pagerDutyIntegrationUpdate(context.Background(), d, c))
 
 	c.FakePagerDutyIntegration.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := pagerDutyIntegrationUpdate(d, c)
+	err := pagerDutyIntegrationUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestPagerDutyIntegrationRead(t *testing.T) {
@@ -77,13 +79,13 @@ func TestPagerDutyIntegrationRead(t *testing.T) {
 
 	c.FakePagerDutyIntegration.On("Get", "123").Return(&api.PagerDutyIntegration{}, nil).Once()
 
-	require.NoError(t, pagerDutyIntegrationRead(d, c))
+	require.Nil(t, pagerDutyIntegrationRead(context.Background(), d, c))
 
 	c.FakePagerDutyIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := pagerDutyIntegrationRead(d, c)
+	err := pagerDutyIntegrationRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestPagerDutyIntegrationDelete(t *testing.T) {
@@ -94,39 +96,23 @@ func TestPagerDutyIntegrationDelete(t *testing.T) {
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, pagerDutyIntegrationDelete(d, c))
+	require.Nil(t, pagerDutyIntegrationDelete(context.Background(), d, c))
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, pagerDutyIntegrationDelete(d, c))
+	require.Nil(t, pagerDutyIntegrationDelete(context.Background(), d, c))
 }
 
-func TestPagerDutyIntegrationExists(t *testing.T) {
+func TestPagerDutyIntegrationReadNotFound(t *testing.T) {
 	d := pagerDutyIntegrationTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakePagerDutyIntegration.On("Get", "123").Return(&api.PagerDutyIntegration{}, nil).Once()
-
-	exists, err := pagerDutyIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakePagerDutyIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = pagerDutyIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakePagerDutyIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = pagerDutyIntegrationExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, pagerDutyIntegrationRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func pagerDutyIntegrationTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,9 +1,11 @@
 package integration
 
 import (
+	"context"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -115,29 +117,28 @@ var serviceNowIntegrationSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7ServiceNowIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: serviceNowIntegrationCreate,
-		Read:   serviceNowIntegrationRead,
-		Update: serviceNowIntegrationUpdate,
-		Delete: serviceNowIntegrationDelete,
-		Exists: serviceNowIntegrationExists,
+		CreateContext: serviceNowIntegrationCreate,
+		ReadContext:   serviceNowIntegrationRead,
+		UpdateContext: serviceNowIntegrationUpdate,
+		DeleteContext: serviceNowIntegrationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportIntegrationByName("ServiceNow integration", "site24x7_servicenow_integration"),
 		},
 		Schema: serviceNowIntegrationSchema,
 	}
 }
 
-func serviceNowIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func serviceNowIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	serviceNowIntegration, err := resourceDataToServiceNowIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceNowIntegration, err = client.ServiceNowIntegration().Create(serviceNowIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(serviceNowIntegration.ServiceID)
@@ -145,12 +146,16 @@ func serviceNowIntegrationCreate(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func serviceNowIntegrationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func serviceNowIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	serviceNowIntegration, err := client.ServiceNowIntegration().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateServiceNowIntegrationResourceData(d, serviceNowIntegration)
@@ -158,17 +163,17 @@ func serviceNowIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func serviceNowIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func serviceNowIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	serviceNowIntegration, err := resourceDataToServiceNowIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceNowIntegration, err = client.ServiceNowIntegration().Update(serviceNowIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(serviceNowIntegration.ServiceID)
@@ -176,30 +181,15 @@ func serviceNowIntegrationUpdate(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func serviceNowIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func serviceNowIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ThirdPartyIntegrations().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func serviceNowIntegrationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.ServiceNowIntegration().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToServiceNowIntegration(d *schema.ResourceData) (*api.ServiceNowIntegration, error) {
@@ -1,9 +1,11 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -32,13 +34,13 @@ func TestServiceNowIntegrationCreate(t *testing.T) {
 
 	c.FakeServiceNowIntegration.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, serviceNowIntegrationCreate(d, c))
+	require.Nil(t, serviceNowIntegrationCreate(context.Background(), d, c))
 
 	c.FakeServiceNowIntegration.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := serviceNowIntegrationCreate(d, c)
+	err := serviceNowIntegrationCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestServiceNowIntegrationUpdate(t *testing.T) {
@@ -65,13 +67,13 @@ func TestServiceNowIntegrationUpdate(t *testing.T) {
 
 	c.FakeServiceNowIntegration.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, serviceNowIntegrationUpdate(d, c))
+	require.Nil(t, serviceNowIntegrationUpdate(context.Background(), d, c))
 
 	c.FakeServiceNowIntegration.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := serviceNowIntegrationUpdate(d, c)
+	err := serviceNowIntegrationUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestServiceNowIntegrationRead(t *testing.T) {
@@ -82,13 +84,13 @@ func TestServiceNowIntegrationRead(t *testing.T) {
 
 	c.FakeServiceNowIntegration.On("Get", "123").Return(&api.ServiceNowIntegration{}, nil).Once()
 
-	require.NoError(t, serviceNowIntegrationRead(d, c))
+	require.Nil(t, serviceNowIntegrationRead(context.Background(), d, c))
 
 	c.FakeServiceNowIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := serviceNowIntegrationRead(d, c)
+	err := serviceNowIntegrationRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestServiceNowIntegrationDelete(t *testing.T) {
@@ -99,39 +101,23 @@ func TestServiceNowIntegrationDelete(t *testing.T) {
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, serviceNowIntegrationDelete(d, c))
+	require.Nil(t, serviceNowIntegrationDelete(context.Background(), d, c))
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, serviceNowIntegrationDelete(d, c))
+	require.Nil(t, serviceNowIntegrationDelete(context.Background(), d, c))
 }
 
-func TestServiceNowIntegrationExists(t *testing.T) {
+func TestServiceNowIntegrationReadNotFound(t *testing.T) {
 	d := serviceNowIntegrationTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeServiceNowIntegration.On("Get", "123").Return(&api.ServiceNowIntegration{}, nil).Once()
-
-	exists, err := serviceNowIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeServiceNowIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = serviceNowIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeServiceNowIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = serviceNowIntegrationExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, serviceNowIntegrationRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func serviceNowIntegrationTestResourceData(t *testing.T) *schema.ResourceData {
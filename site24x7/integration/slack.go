@@ -1,7 +1,10 @@
 package integration
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -78,29 +81,28 @@ var SlackIntegrationSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7SlackIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: slackIntegrationCreate,
-		Read:   slackIntegrationRead,
-		Update: slackIntegrationUpdate,
-		Delete: slackIntegrationDelete,
-		Exists: slackIntegrationExists,
+		CreateContext: slackIntegrationCreate,
+		ReadContext:   slackIntegrationRead,
+		UpdateContext: slackIntegrationUpdate,
+		DeleteContext: slackIntegrationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportIntegrationByName("Slack integration", "site24x7_slack_integration"),
 		},
 		Schema: SlackIntegrationSchema,
 	}
 }
 
-func slackIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func slackIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	slackIntegration, err := resourceDataToSlackIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	slackIntegration, err = client.SlackIntegration().Create(slackIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(slackIntegration.ServiceID)
@@ -108,12 +110,16 @@ func slackIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func slackIntegrationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func slackIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	slackIntegration, err := client.SlackIntegration().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateSlackIntegrationResourceData(d, slackIntegration)
@@ -121,17 +127,17 @@ func slackIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func slackIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func slackIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	slackIntegration, err := resourceDataToSlackIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	slackIntegration, err = client.SlackIntegration().Update(slackIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(slackIntegration.ServiceID)
@@ -139,30 +145,15 @@ func slackIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func slackIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func slackIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ThirdPartyIntegrations().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func slackIntegrationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.SlackIntegration().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToSlackIntegration(d *schema.ResourceData) (*api.SlackIntegration, error) {
@@ -202,11 +193,11 @@ func resourceDataToSlackIntegration(d *schema.ResourceData) (*api.SlackIntegrati
 
 func updateSlackIntegrationResourceData(d *schema.ResourceData, slackIntegration *api.SlackIntegration) {
 	d.Set("name", slackIntegration.Name)
-	
+
 	if slackIntegration.URL != "" {
 		d.Set("url", slackIntegration.URL)
 	}
-	
+
 	d.Set("sender_name", slackIntegration.SenderName)
 	d.Set("title", slackIntegration.Title)
 	d.Set("selection_type", slackIntegration.SelectionType)
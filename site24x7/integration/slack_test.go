@@ -1,9 +1,11 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -31,13 +33,13 @@ func TestSlackIntegrationCreate(t *testing.T) {
 
 	c.FakeSlackIntegration.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, slackIntegrationCreate(d, c))
+	require.Nil(t, slackIntegrationCreate(context.Background(), d, c))
 
 	c.FakeSlackIntegration.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := slackIntegrationCreate(d, c)
+	err := slackIntegrationCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSlackIntegrationUpdate(t *testing.T) {
@@ -63,13 +65,13 @@ func TestSlackIntegrationUpdate(t *testing.T) {
 
 	c.FakeSlackIntegration.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, slackIntegrationUpdate(d, c))
+	require.Nil(t, slackIntegrationUpdate(context.Background(), d, c))
 
 	c.FakeSlackIntegration.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := slackIntegrationUpdate(d, c)
+	err := slackIntegrationUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSlackIntegrationRead(t *testing.T) {
@@ -80,13 +82,13 @@ func TestSlackIntegrationRead(t *testing.T) {
 
 	c.FakeSlackIntegration.On("Get", "123").Return(&api.SlackIntegration{}, nil).Once()
 
-	require.NoError(t, slackIntegrationRead(d, c))
+	require.Nil(t, slackIntegrationRead(context.Background(), d, c))
 
 	c.FakeSlackIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := slackIntegrationRead(d, c)
+	err := slackIntegrationRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSlackIntegrationDelete(t *testing.T) {
@@ -97,39 +99,23 @@ func TestSlackIntegrationDelete(t *testing.T) {
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, slackIntegrationDelete(d, c))
+	require.Nil(t, slackIntegrationDelete(context.Background(), d, c))
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, slackIntegrationDelete(d, c))
+	require.Nil(t, slackIntegrationDelete(context.Background(), d, c))
 }
 
-func TestSlackIntegrationExists(t *testing.T) {
+func TestSlackIntegrationReadNotFound(t *testing.T) {
 	d := slackIntegrationTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeSlackIntegration.On("Get", "123").Return(&api.SlackIntegration{}, nil).Once()
-
-	exists, err := slackIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeSlackIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = slackIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeSlackIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = slackIntegrationExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, slackIntegrationRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func slackIntegrationTestResourceData(t *testing.T) *schema.ResourceData {
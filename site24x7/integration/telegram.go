@@ -1,7 +1,10 @@
 package integration
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -79,29 +82,28 @@ var TelegramIntegrationSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7TelegramIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: telegramIntegrationCreate,
-		Read:   telegramIntegrationRead,
-		Update: telegramIntegrationUpdate,
-		Delete: telegramIntegrationDelete,
-		Exists: telegramIntegrationExists,
+		CreateContext: telegramIntegrationCreate,
+		ReadContext:   telegramIntegrationRead,
+		UpdateContext: telegramIntegrationUpdate,
+		DeleteContext: telegramIntegrationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportIntegrationByName("Telegram integration", "site24x7_telegram_integration"),
 		},
 		Schema: TelegramIntegrationSchema,
 	}
 }
 
-func telegramIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func telegramIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	telegramIntegration, err := resourceDataToTelegramIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	telegramIntegration, err = client.TelegramIntegration().Create(telegramIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(telegramIntegration.ServiceID)
@@ -109,12 +111,16 @@ func telegramIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func telegramIntegrationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func telegramIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	telegramIntegration, err := client.TelegramIntegration().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateTelegramIntegrationResourceData(d, telegramIntegration)
@@ -122,17 +128,17 @@ func telegramIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func telegramIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func telegramIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	telegramIntegration, err := resourceDataToTelegramIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	telegramIntegration, err = client.TelegramIntegration().Update(telegramIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(telegramIntegration.ServiceID)
@@ -140,30 +146,15 @@ func telegramIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func telegramIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func telegramIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ThirdPartyIntegrations().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func telegramIntegrationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.TelegramIntegration().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToTelegramIntegration(d *schema.ResourceData) (*api.TelegramIntegration, error) {
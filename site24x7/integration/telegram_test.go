@@ -1,9 +1,11 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -31,13 +33,13 @@ func TestTelegramIntegrationCreate(t *testing.T) {
 
 	c.FakeTelegramIntegration.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, telegramIntegrationCreate(d, c))
+	require.Nil(t, telegramIntegrationCreate(context.Background(), d, c))
 
 	c.FakeTelegramIntegration.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := telegramIntegrationCreate(d, c)
+	err := telegramIntegrationCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestTelegramIntegrationUpdate(t *testing.T) {
@@ -63,13 +65,13 @@ func TestTelegramIntegrationUpdate(t *testing.T) {
 
 	c.FakeTelegramIntegration.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, telegramIntegrationUpdate(d, c))
+	require.Nil(t, telegramIntegrationUpdate(context.Background(), d, c))
 
 	c.FakeTelegramIntegration.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := telegramIntegrationUpdate(d, c)
+	err := telegramIntegrationUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestTelegramIntegrationRead(t *testing.T) {
@@ -80,13 +82,13 @@ func TestTelegramIntegrationRead(t *testing.T) {
 
 	c.FakeTelegramIntegration.On("Get", "123").Return(&api.TelegramIntegration{}, nil).Once()
 
-	require.NoError(t, telegramIntegrationRead(d, c))
+	require.Nil(t, telegramIntegrationRead(context.Background(), d, c))
 
 	c.FakeTelegramIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := telegramIntegrationRead(d, c)
+	err := telegramIntegrationRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestTelegramIntegrationDelete(t *testing.T) {
@@ -97,46 +99,30 @@ func TestTelegramIntegrationDelete(t *testing.T) {
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, telegramIntegrationDelete(d, c))
+	require.Nil(t, telegramIntegrationDelete(context.Background(), d, c))
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, telegramIntegrationDelete(d, c))
+	require.Nil(t, telegramIntegrationDelete(context.Background(), d, c))
 }
 
-func TestTelegramIntegrationExists(t *testing.T) {
+func TestTelegramIntegrationReadNotFound(t *testing.T) {
 	d := telegramIntegrationTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeTelegramIntegration.On("Get", "123").Return(&api.TelegramIntegration{}, nil).Once()
-
-	exists, err := telegramIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeTelegramIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = telegramIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeTelegramIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = telegramIntegrationExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, telegramIntegrationRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func telegramIntegrationTestResourceData(t *testing.T) *schema.ResourceData {
 	return schema.TestResourceDataRaw(t, TelegramIntegrationSchema, map[string]interface{}{
 		"name":           "foo",
-		"channel_url":            "www.test.tld",
-		"token":		  "uojvsdoijsodijdsioj",
+		"channel_url":    "www.test.tld",
+		"token":          "uojvsdoijsodijdsioj",
 		"selection_type": 0,
 		"title":          "test-title",
 		"monitors": []interface{}{
@@ -1,9 +1,11 @@
 package integration
 
 import (
+	"context"
 	"sort"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -212,29 +214,28 @@ var WebhookIntegrationSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7WebhookIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create: webhookIntegrationCreate,
-		Read:   webhookIntegrationRead,
-		Update: webhookIntegrationUpdate,
-		Delete: webhookIntegrationDelete,
-		Exists: webhookIntegrationExists,
+		CreateContext: webhookIntegrationCreate,
+		ReadContext:   webhookIntegrationRead,
+		UpdateContext: webhookIntegrationUpdate,
+		DeleteContext: webhookIntegrationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportIntegrationByName("webhook integration", "site24x7_webhook_integration"),
 		},
 		Schema: WebhookIntegrationSchema,
 	}
 }
 
-func webhookIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webhookIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	webhookIntegration, err := resourceDataToWebhookIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	webhookIntegration, err = client.WebhookIntegration().Create(webhookIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(webhookIntegration.ServiceID)
@@ -242,12 +243,16 @@ func webhookIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func webhookIntegrationRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webhookIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	webhookIntegration, err := client.WebhookIntegration().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateWebhookIntegrationResourceData(d, webhookIntegration)
@@ -255,17 +260,17 @@ func webhookIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func webhookIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webhookIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	webhookIntegration, err := resourceDataToWebhookIntegration(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	webhookIntegration, err = client.WebhookIntegration().Update(webhookIntegration)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(webhookIntegration.ServiceID)
@@ -273,30 +278,15 @@ func webhookIntegrationUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func webhookIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webhookIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ThirdPartyIntegrations().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func webhookIntegrationExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.WebhookIntegration().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToWebhookIntegration(d *schema.ResourceData) (*api.WebhookIntegration, error) {
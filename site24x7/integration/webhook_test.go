@@ -1,9 +1,11 @@
 package integration
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -52,13 +54,13 @@ func TestWebhookIntegrationCreate(t *testing.T) {
 
 	c.FakeWebhookIntegration.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, webhookIntegrationCreate(d, c))
+	require.Nil(t, webhookIntegrationCreate(context.Background(), d, c))
 
 	c.FakeWebhookIntegration.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := webhookIntegrationCreate(d, c)
+	err := webhookIntegrationCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebhookIntegrationUpdate(t *testing.T) {
@@ -104,13 +106,13 @@ func TestWebhookIntegrationUpdate(t *testing.T) {
 
 	c.FakeWebhookIntegration.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, webhookIntegrationUpdate(d, c))
+	require.Nil(t, webhookIntegrationUpdate(context.Background(), d, c))
 
 	c.FakeWebhookIntegration.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := webhookIntegrationUpdate(d, c)
+	err := webhookIntegrationUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebhookIntegrationRead(t *testing.T) {
@@ -121,13 +123,13 @@ func TestWebhookIntegrationRead(t *testing.T) {
 
 	c.FakeWebhookIntegration.On("Get", "123").Return(&api.WebhookIntegration{}, nil).Once()
 
-	require.NoError(t, webhookIntegrationRead(d, c))
+	require.Nil(t, webhookIntegrationRead(context.Background(), d, c))
 
 	c.FakeWebhookIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := webhookIntegrationRead(d, c)
+	err := webhookIntegrationRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebhookIntegrationDelete(t *testing.T) {
@@ -138,39 +140,23 @@ func TestWebhookIntegrationDelete(t *testing.T) {
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, webhookIntegrationDelete(d, c))
+	require.Nil(t, webhookIntegrationDelete(context.Background(), d, c))
 
 	c.FakeThirdPartyIntegrations.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, webhookIntegrationDelete(d, c))
+	require.Nil(t, webhookIntegrationDelete(context.Background(), d, c))
 }
 
-func TestWebhookIntegrationExists(t *testing.T) {
+func TestWebhookIntegrationReadNotFound(t *testing.T) {
 	d := webhookIntegrationTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeWebhookIntegration.On("Get", "123").Return(&api.WebhookIntegration{}, nil).Once()
-
-	exists, err := webhookIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeWebhookIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = webhookIntegrationExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeWebhookIntegration.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = webhookIntegrationExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, webhookIntegrationRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func webhookIntegrationTestResourceData(t *testing.T) *schema.ResourceData {
@@ -0,0 +1,66 @@
+package site24x7
+
+import (
+	"sort"
+
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+)
+
+// integrationResourceTypes maps the type of third party integrations, as
+// returned by the list endpoint, to the resources managing them. The types are
+// taken from API responses recorded in api/endpoints/testdata.
+var integrationResourceTypes = map[int]string{
+	5:  "site24x7_slack_integration",
+	10: "site24x7_opsgenie_integration",
+	14: "site24x7_connectwise_integration",
+	21: "site24x7_telegram_integration",
+}
+
+// integrationProbes read integrations with the resources whose integration
+// type is not known. An integration is managed by a resource if it can be
+// read with its endpoint.
+var integrationProbes = map[string]func(c Client, id string) error{
+	"site24x7_webhook_integration": func(c Client, id string) error {
+		_, err := c.WebhookIntegration().Get(id)
+		return err
+	},
+	"site24x7_pagerduty_integration": func(c Client, id string) error {
+		_, err := c.PagerDutyIntegration().Get(id)
+		return err
+	},
+	"site24x7_servicenow_integration": func(c Client, id string) error {
+		_, err := c.ServiceNowIntegration().Get(id)
+		return err
+	},
+}
+
+// IntegrationResourceType returns the resource type managing the given third
+// party integration, or an empty string if no resource manages it.
+// Integrations of unknown type are read with each resource of unknown type in
+// turn, where only a 404 response rules a resource out.
+func IntegrationResourceType(c Client, integration *api.ThirdPartyIntegrations) (string, error) {
+	if resourceType, ok := integrationResourceTypes[integration.Type]; ok {
+		return resourceType, nil
+	}
+
+	resourceTypes := make([]string, 0, len(integrationProbes))
+	for resourceType := range integrationProbes {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		err := integrationProbes[resourceType](c, integration.ServiceID)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		return resourceType, nil
+	}
+
+	return "", nil
+}
@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 )
 
@@ -90,18 +92,18 @@ var itAutomationDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7ITAutomation() *schema.Resource {
 	return &schema.Resource{
-		Read:   itAutomationDataSourceRead,
-		Schema: itAutomationDataSourceSchema,
+		ReadContext: itAutomationDataSourceRead,
+		Schema:      itAutomationDataSourceSchema,
 	}
 }
 
 // itAutomationDataSourceRead fetches all itAutomation from Site24x7
-func itAutomationDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func itAutomationDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	itAutomationList, err := client.URLActions().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nameRegex := d.Get("name_regex")
@@ -141,11 +143,11 @@ func itAutomationDataSourceRead(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 	} else {
-		return errors.New("Please enter a value for the attribute name_regex!")
+		return diag.FromErr(errors.New("Please enter a value for the attribute name_regex!"))
 	}
 
 	if itAutomation == nil {
-		return errors.New("Unable to find IT action matching the name : \"" + d.Get("name_regex").(string))
+		return diag.FromErr(errors.New("Unable to find IT action matching the name : \"" + d.Get("name_regex").(string)))
 	}
 
 	updateITAutomationDataSourceResourceData(d, itAutomation, matchingITAutomationIDs, matchingITAutomationIDsAndNames)
@@ -1,7 +1,12 @@
 package site24x7
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 )
@@ -28,7 +33,7 @@ var LocationProfileSchema = map[string]*schema.Schema{
 	"primary_location": {
 		Type:        schema.TypeString,
 		Required:    true,
-		Description: "Primary location for monitoring.",
+		Description: "Primary location for monitoring. Either the ID of the location or its display name or city, e.g. \"Frankfurt - DE\", \"Frankfurt\" or \"FRA\".",
 	},
 	"secondary_locations": {
 		Type:     schema.TypeSet,
@@ -36,7 +41,7 @@ var LocationProfileSchema = map[string]*schema.Schema{
 		Elem: &schema.Schema{
 			Type: schema.TypeString,
 		},
-		Description: "List of secondary locations for monitoring",
+		Description: "List of secondary locations for monitoring. Either the IDs of the locations or their display names or cities.",
 	},
 	"restrict_alternate_location_polling": {
 		Type:        schema.TypeBool,
@@ -56,26 +61,51 @@ var LocationProfileSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7LocationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: locationProfileCreate,
-		Read:   locationProfileRead,
-		Update: locationProfileUpdate,
-		Delete: locationProfileDelete,
-		Exists: locationProfileExists,
+		CreateContext: locationProfileCreate,
+		ReadContext:   locationProfileRead,
+		UpdateContext: locationProfileUpdate,
+		DeleteContext: locationProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: ImportByName("location profile", findLocationProfiles),
 		},
-		Schema: LocationProfileSchema,
+		CustomizeDiff: locationProfileCustomizeDiff,
+		Schema:        LocationProfileSchema,
+	}
+}
+
+// locationProfileCustomizeDiff validates the locations against the location
+// template, so that unknown locations and duplicates are reported at plan
+// time instead of by the API.
+func locationProfileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("primary_location") && !d.HasChange("secondary_locations") {
+		return nil
 	}
+	if !d.NewValueKnown("primary_location") || !d.NewValueKnown("secondary_locations") {
+		return nil
+	}
+
+	template, err := WithContext(ctx, meta.(Client)).LocationTemplate().Get()
+	if err != nil {
+		return fmt.Errorf("unable to validate locations: %w", err)
+	}
+
+	_, _, err = resolveLocations(template.Locations, d.Get("primary_location").(string), setToStrings(d.Get("secondary_locations").(*schema.Set)))
+
+	return err
 }
 
-func locationProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func locationProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	locationProfile := resourceDataToLocationProfile(d)
 
+	if err := resolveLocationProfileLocations(client, locationProfile); err != nil {
+		return diag.FromErr(err)
+	}
+
 	locationProfile, err := client.LocationProfiles().Create(locationProfile)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(locationProfile.ProfileID)
@@ -83,12 +113,20 @@ func locationProfileCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func locationProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func locationProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	locationProfile, err := client.LocationProfiles().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
+	}
+
+	if err := keepLocationNames(client, d, locationProfile); err != nil {
+		return diag.FromErr(err)
 	}
 
 	updateLocationProfileResourceData(d, locationProfile)
@@ -96,14 +134,18 @@ func locationProfileRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func locationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func locationProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	locationProfile := resourceDataToLocationProfile(d)
 
+	if err := resolveLocationProfileLocations(client, locationProfile); err != nil {
+		return diag.FromErr(err)
+	}
+
 	locationProfile, err := client.LocationProfiles().Update(locationProfile)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(locationProfile.ProfileID)
@@ -111,30 +153,15 @@ func locationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func locationProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func locationProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	err := client.LocationProfiles().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func locationProfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(Client)
-
-	_, err := client.LocationProfiles().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToLocationProfile(d *schema.ResourceData) *api.LocationProfile {
@@ -156,9 +183,151 @@ func resourceDataToLocationProfile(d *schema.ResourceData) *api.LocationProfile
 
 // Called during read - populates the ResourceData with the locationProfile in API response
 func updateLocationProfileResourceData(d *schema.ResourceData, locationProfile *api.LocationProfile) {
-	d.Set("display_name", locationProfile.ProfileName)
+	d.Set("profile_name", locationProfile.ProfileName)
 	d.Set("primary_location", locationProfile.PrimaryLocation)
 	d.Set("secondary_locations", locationProfile.SecondaryLocations)
 	d.Set("restrict_alternate_location_polling", locationProfile.RestrictAlternateLocationPolling)
 	d.Set("outer_regions_location_consent", locationProfile.LocationConsentForOuterRegions)
 }
+
+// resolveLocationProfileLocations replaces the location names of profile with
+// the IDs of the locations. The location template is only fetched if the
+// profile refers to locations by name.
+func resolveLocationProfileLocations(client Client, profile *api.LocationProfile) error {
+	if !usesLocationNames(profile.PrimaryLocation, profile.SecondaryLocations) {
+		return nil
+	}
+
+	template, err := client.LocationTemplate().Get()
+	if err != nil {
+		return err
+	}
+
+	profile.PrimaryLocation, profile.SecondaryLocations, err = resolveLocations(template.Locations, profile.PrimaryLocation, profile.SecondaryLocations)
+
+	return err
+}
+
+// keepLocationNames replaces the location IDs of profile with the names used
+// in the configuration, as long as they still refer to the same locations.
+// This avoids perpetual diffs for profiles whose locations are configured by
+// name.
+func keepLocationNames(client Client, d *schema.ResourceData, profile *api.LocationProfile) error {
+	primary := d.Get("primary_location").(string)
+	secondaries := setToStrings(d.Get("secondary_locations").(*schema.Set))
+	if !usesLocationNames(primary, secondaries) {
+		return nil
+	}
+
+	template, err := client.LocationTemplate().Get()
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]string)
+	for _, name := range append([]string{primary}, secondaries...) {
+		if id, err := resolveLocation(template.Locations, name); err == nil {
+			names[id] = name
+		}
+	}
+
+	if name, ok := names[profile.PrimaryLocation]; ok {
+		profile.PrimaryLocation = name
+	}
+	for i, id := range profile.SecondaryLocations {
+		if name, ok := names[id]; ok {
+			profile.SecondaryLocations[i] = name
+		}
+	}
+
+	return nil
+}
+
+// resolveLocations returns the IDs of the primary and secondary locations. It
+// fails if a location is unknown, a secondary location is listed twice or the
+// primary location is also listed as a secondary location.
+func resolveLocations(locations []*api.Location, primary string, secondaries []string) (string, []string, error) {
+	primaryID, err := resolveLocation(locations, primary)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid primary_location: %w", err)
+	}
+
+	names := map[string]string{}
+	secondaryIDs := make([]string, 0, len(secondaries))
+	for _, name := range secondaries {
+		id, err := resolveLocation(locations, name)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid secondary_locations: %w", err)
+		}
+		if id == primaryID {
+			return "", nil, fmt.Errorf("invalid secondary_locations: %q is the primary location", name)
+		}
+		if other, ok := names[id]; ok {
+			return "", nil, fmt.Errorf("invalid secondary_locations: %q and %q are the same location", other, name)
+		}
+
+		names[id] = name
+		secondaryIDs = append(secondaryIDs, id)
+	}
+
+	return primaryID, secondaryIDs, nil
+}
+
+// resolveLocation returns the ID of a location given its ID, display name,
+// city name or city short name, e.g. "58", "Frankfurt - DE", "Frankfurt" or
+// "FRA". Names are matched case insensitively.
+func resolveLocation(locations []*api.Location, name string) (string, error) {
+	for _, location := range locations {
+		if location.LocationID == name {
+			return location.LocationID, nil
+		}
+	}
+	for _, location := range locations {
+		if strings.EqualFold(location.DisplayName, name) {
+			return location.LocationID, nil
+		}
+	}
+
+	var matches []*api.Location
+	for _, location := range locations {
+		if strings.EqualFold(location.CityName, name) || strings.EqualFold(location.CityShort, name) {
+			matches = append(matches, location)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("unknown location %q, see the site24x7_locations data source for valid locations", name)
+	case 1:
+		return matches[0].LocationID, nil
+	default:
+		displayNames := make([]string, len(matches))
+		for i, location := range matches {
+			displayNames[i] = location.DisplayName
+		}
+		return "", fmt.Errorf("location %q is ambiguous, use one of %s instead", name, strings.Join(displayNames, ", "))
+	}
+}
+
+// usesLocationNames reports whether any of the locations is given by name
+// rather than by its numeric ID.
+func usesLocationNames(primary string, secondaries []string) bool {
+	for _, value := range append([]string{primary}, secondaries...) {
+		for _, r := range value {
+			if r < '0' || r > '9' {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func setToStrings(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+
+	return values
+}
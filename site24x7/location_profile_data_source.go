@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 )
 
@@ -57,18 +59,18 @@ var locationProfileDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7LocationProfile() *schema.Resource {
 	return &schema.Resource{
-		Read:   locationProfileDataSourceRead,
-		Schema: locationProfileDataSourceSchema,
+		ReadContext: locationProfileDataSourceRead,
+		Schema:      locationProfileDataSourceSchema,
 	}
 }
 
 // locationProfileDataSourceRead fetches all locationProfile from Site24x7
-func locationProfileDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func locationProfileDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	locationProfileList, err := client.LocationProfiles().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nameRegex := d.Get("name_regex")
@@ -96,11 +98,11 @@ func locationProfileDataSourceRead(d *schema.ResourceData, meta interface{}) err
 			}
 		}
 	} else {
-		return errors.New("Please enter a value for the attribute name_regex!")
+		return diag.FromErr(errors.New("Please enter a value for the attribute name_regex!"))
 	}
 
 	if locationProfile == nil {
-		return errors.New("Unable to find location profile matching the name : \"" + d.Get("name_regex").(string))
+		return diag.FromErr(errors.New("Unable to find location profile matching the name : \"" + d.Get("name_regex").(string)))
 	}
 
 	updateLocationProfileDataSourceResourceData(d, locationProfile, matchingLocationProfileIDsAndNames)
@@ -1,9 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -24,13 +27,13 @@ func TestLocationProfileCreate(t *testing.T) {
 
 	c.FakeLocationProfiles.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, locationProfileCreate(d, c))
+	require.Nil(t, locationProfileCreate(context.Background(), d, c))
 
 	c.FakeLocationProfiles.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := locationProfileCreate(d, c)
+	err := locationProfileCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestLocationProfileUpdate(t *testing.T) {
@@ -48,13 +51,13 @@ func TestLocationProfileUpdate(t *testing.T) {
 
 	c.FakeLocationProfiles.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, locationProfileUpdate(d, c))
+	require.Nil(t, locationProfileUpdate(context.Background(), d, c))
 
 	c.FakeLocationProfiles.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := locationProfileUpdate(d, c)
+	err := locationProfileUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestLocationProfileRead(t *testing.T) {
@@ -63,15 +66,16 @@ func TestLocationProfileRead(t *testing.T) {
 
 	c := fake.NewClient()
 
-	c.FakeLocationProfiles.On("Get", "123").Return(&api.LocationProfile{}, nil).Once()
+	c.FakeLocationProfiles.On("Get", "123").Return(&api.LocationProfile{ProfileName: "renamed"}, nil).Once()
 
-	require.NoError(t, locationProfileRead(d, c))
+	require.Nil(t, locationProfileRead(context.Background(), d, c))
+	assert.Equal(t, "renamed", d.Get("profile_name"))
 
 	c.FakeLocationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := locationProfileRead(d, c)
+	err := locationProfileRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestLocationProfileDelete(t *testing.T) {
@@ -82,39 +86,114 @@ func TestLocationProfileDelete(t *testing.T) {
 
 	c.FakeLocationProfiles.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, locationProfileDelete(d, c))
+	require.Nil(t, locationProfileDelete(context.Background(), d, c))
 
 	c.FakeLocationProfiles.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, locationProfileDelete(d, c))
+	require.Nil(t, locationProfileDelete(context.Background(), d, c))
 }
 
-func TestLocationProfileExists(t *testing.T) {
+func TestLocationProfileReadNotFound(t *testing.T) {
 	d := locationProfileTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeLocationProfiles.On("Get", "123").Return(&api.LocationProfile{}, nil).Once()
+	c.FakeLocationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err := locationProfileExists(d, c)
+	require.Nil(t, locationProfileRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
+}
 
-	require.NoError(t, err)
-	assert.True(t, exists)
+var testLocations = []*api.Location{
+	{LocationID: "20", DisplayName: "Chicago - US", CityName: "Chicago", CityShort: "CHI"},
+	{LocationID: "48", DisplayName: "London - UK", CityName: "London", CityShort: "LON"},
+	{LocationID: "49", DisplayName: "London - CA", CityName: "London", CityShort: "LDN"},
+	{LocationID: "58", DisplayName: "Frankfurt - DE", CityName: "Frankfurt", CityShort: "FRA"},
+}
 
-	c.FakeLocationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
+func TestLocationProfileCreateWithLocationNames(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, LocationProfileSchema, map[string]interface{}{
+		"profile_name":        "prof",
+		"primary_location":    "Frankfurt - DE",
+		"secondary_locations": []interface{}{"chi"},
+	})
+
+	c := fake.NewClient()
+	c.FakeLocationTemplate.On("Get").Return(&api.LocationTemplate{Locations: testLocations}, nil)
+
+	a := &api.LocationProfile{
+		ProfileName:        "prof",
+		PrimaryLocation:    "58",
+		SecondaryLocations: []string{"20"},
+	}
+
+	c.FakeLocationProfiles.On("Create", a).Return(&api.LocationProfile{ProfileID: "123"}, nil).Once()
 
-	exists, err = locationProfileExists(d, c)
+	require.Nil(t, locationProfileCreate(context.Background(), d, c))
+	assert.Equal(t, "123", d.Id())
 
+	// Reading the profile keeps the names of the configuration.
+	c.FakeLocationProfiles.On("Get", "123").Return(a, nil).Once()
+
+	require.Nil(t, locationProfileRead(context.Background(), d, c))
+	assert.Equal(t, "Frankfurt - DE", d.Get("primary_location"))
+	assert.Equal(t, []interface{}{"chi"}, d.Get("secondary_locations").(*schema.Set).List())
+
+	// Locations changed outside of Terraform show up as IDs.
+	c.FakeLocationProfiles.On("Get", "123").Return(&api.LocationProfile{
+		ProfileID:          "123",
+		PrimaryLocation:    "48",
+		SecondaryLocations: []string{"20"},
+	}, nil).Once()
+
+	require.Nil(t, locationProfileRead(context.Background(), d, c))
+	assert.Equal(t, "48", d.Get("primary_location"))
+	assert.Equal(t, []interface{}{"chi"}, d.Get("secondary_locations").(*schema.Set).List())
+}
+
+func TestResolveLocations(t *testing.T) {
+	primary, secondaries, err := resolveLocations(testLocations, "58", []string{"LON", "london - ca", "Chicago"})
 	require.NoError(t, err)
-	assert.False(t, exists)
+	assert.Equal(t, "58", primary)
+	assert.Equal(t, []string{"48", "49", "20"}, secondaries)
+
+	for _, tc := range []struct {
+		primary     string
+		secondaries []string
+		err         string
+	}{
+		{"99", nil, `invalid primary_location: unknown location "99", see the site24x7_locations data source for valid locations`},
+		{"58", []string{"Berlin"}, `invalid secondary_locations: unknown location "Berlin", see the site24x7_locations data source for valid locations`},
+		{"58", []string{"London"}, `invalid secondary_locations: location "London" is ambiguous, use one of London - UK, London - CA instead`},
+		{"58", []string{"FRA"}, `invalid secondary_locations: "FRA" is the primary location`},
+		{"58", []string{"20", "CHI"}, `invalid secondary_locations: "20" and "CHI" are the same location`},
+	} {
+		_, _, err := resolveLocations(testLocations, tc.primary, tc.secondaries)
+		assert.EqualError(t, err, tc.err)
+	}
+}
 
-	c.FakeLocationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+func TestLocationProfileCustomizeDiff(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeLocationTemplate.On("Get").Return(&api.LocationTemplate{Locations: testLocations}, nil)
+
+	r := ResourceSite24x7LocationProfile()
+
+	diff := func(primary string, secondaries ...interface{}) error {
+		_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"profile_name":        "prof",
+			"primary_location":    primary,
+			"secondary_locations": secondaries,
+		}), c)
+		return err
+	}
 
-	exists, err = locationProfileExists(d, c)
+	require.NoError(t, diff("FRA", "20", "London - UK"))
+	assert.EqualError(t, diff("FRA", "58"), `invalid secondary_locations: "58" is the primary location`)
+	assert.EqualError(t, diff("Paris", "20"), `invalid primary_location: unknown location "Paris", see the site24x7_locations data source for valid locations`)
 
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	c.FakeLocationProfiles.AssertNotCalled(t, "Create")
 }
 
 func locationProfileTestResourceData(t *testing.T) *schema.ResourceData {
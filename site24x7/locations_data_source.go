@@ -0,0 +1,154 @@
+package site24x7
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+)
+
+var locationsDataSourceSchema = map[string]*schema.Schema{
+	"continent": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Continent of the locations, e.g. Europe. Matching is case insensitive.",
+	},
+	"country_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Country of the locations, e.g. Germany. Matching is case insensitive.",
+	},
+	"city_short": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Short name of the city of the location, e.g. FRA. Matching is case insensitive.",
+	},
+	"use_ipv6": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "If true, only locations supporting IPv6 are returned.",
+	},
+	"ids": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "IDs of the matching locations. They can be used as primary_location and secondary_locations of location profiles.",
+	},
+	"names": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Display names of the matching locations.",
+	},
+	"locations": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"location_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "ID of the location.",
+				},
+				"display_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Display name of the location.",
+				},
+				"city_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "City of the location.",
+				},
+				"city_short": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Short name of the city of the location.",
+				},
+				"country_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Country of the location.",
+				},
+				"continent": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Continent of the location.",
+				},
+				"use_ipv6": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the location supports IPv6.",
+				},
+			},
+		},
+		Description: "Matching locations.",
+	},
+}
+
+func DataSourceSite24x7Locations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: locationsDataSourceRead,
+		Schema:      locationsDataSourceSchema,
+	}
+}
+
+// locationsDataSourceRead fetches the monitoring locations from the location
+// template and filters them.
+func locationsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
+
+	template, err := client.LocationTemplate().Get()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	continent := d.Get("continent").(string)
+	countryName := d.Get("country_name").(string)
+	cityShort := d.Get("city_short").(string)
+	useIPv6 := d.Get("use_ipv6").(bool)
+
+	ids := []string{}
+	names := []string{}
+	var locations []interface{}
+	for _, location := range template.Locations {
+		if !matchesFilter(location.Continent, continent) ||
+			!matchesFilter(location.CountryName, countryName) ||
+			!matchesFilter(location.CityShort, cityShort) ||
+			useIPv6 && !location.UseIPV6 {
+			continue
+		}
+
+		ids = append(ids, location.LocationID)
+		names = append(names, location.DisplayName)
+		locations = append(locations, flattenLocation(location))
+	}
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join([]string{continent, countryName, cityShort, fmt.Sprint(useIPv6)}, "/"))))
+	d.Set("ids", ids)
+	d.Set("names", names)
+	d.Set("locations", locations)
+
+	return nil
+}
+
+// matchesFilter reports whether value equals filter, ignoring case. Empty
+// filters match all values.
+func matchesFilter(value, filter string) bool {
+	return filter == "" || strings.EqualFold(value, filter)
+}
+
+func flattenLocation(location *api.Location) map[string]interface{} {
+	return map[string]interface{}{
+		"location_id":  location.LocationID,
+		"display_name": location.DisplayName,
+		"city_name":    location.CityName,
+		"city_short":   location.CityShort,
+		"country_name": location.CountryName,
+		"continent":    location.Continent,
+		"use_ipv6":     location.UseIPV6,
+	}
+}
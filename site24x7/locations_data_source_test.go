@@ -0,0 +1,68 @@
+package site24x7
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
+	"github.com/site24x7/terraform-provider-site24x7/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testLocationTemplate = &api.LocationTemplate{
+	Locations: []*api.Location{
+		{LocationID: "20", DisplayName: "Chicago - US", CityName: "Chicago", CityShort: "CHI", CountryName: "United States", Continent: "North America", UseIPV6: true},
+		{LocationID: "48", DisplayName: "London - UK", CityName: "London", CityShort: "LON", CountryName: "United Kingdom", Continent: "Europe", UseIPV6: true},
+		{LocationID: "58", DisplayName: "Frankfurt - DE", CityName: "Frankfurt", CityShort: "FRA", CountryName: "Germany", Continent: "Europe"},
+	},
+}
+
+func TestLocationsDataSourceRead(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeLocationTemplate.On("Get").Return(testLocationTemplate, nil)
+
+	d := locationsTestResourceData(t, map[string]interface{}{})
+	require.Nil(t, locationsDataSourceRead(context.Background(), d, c))
+	assert.Equal(t, []interface{}{"20", "48", "58"}, d.Get("ids"))
+
+	d = locationsTestResourceData(t, map[string]interface{}{
+		"continent": "europe",
+	})
+	require.Nil(t, locationsDataSourceRead(context.Background(), d, c))
+	assert.Equal(t, []interface{}{"48", "58"}, d.Get("ids"))
+	assert.Equal(t, []interface{}{"London - UK", "Frankfurt - DE"}, d.Get("names"))
+	assert.Equal(t, "Germany", d.Get("locations.1.country_name"))
+
+	d = locationsTestResourceData(t, map[string]interface{}{
+		"continent": "Europe",
+		"use_ipv6":  true,
+	})
+	require.Nil(t, locationsDataSourceRead(context.Background(), d, c))
+	assert.Equal(t, []interface{}{"48"}, d.Get("ids"))
+	assert.Equal(t, true, d.Get("locations.0.use_ipv6"))
+
+	d = locationsTestResourceData(t, map[string]interface{}{
+		"country_name": "Germany",
+		"city_short":   "LON",
+	})
+	require.Nil(t, locationsDataSourceRead(context.Background(), d, c))
+	assert.Equal(t, []interface{}{}, d.Get("ids"))
+}
+
+func TestLocationsDataSourceReadError(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeLocationTemplate.On("Get").Return(nil, apierrors.NewStatusError(500, "error"))
+
+	d := locationsTestResourceData(t, map[string]interface{}{})
+	err := locationsDataSourceRead(context.Background(), d, c)
+
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
+}
+
+func locationsTestResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, DataSourceSite24x7Locations().Schema, raw)
+}
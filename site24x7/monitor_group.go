@@ -1,7 +1,10 @@
 package site24x7
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 )
@@ -137,26 +140,25 @@ var MonitorGroupSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7MonitorGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: monitorGroupCreate,
-		Read:   monitorGroupRead,
-		Update: monitorGroupUpdate,
-		Delete: monitorGroupDelete,
-		Exists: monitorGroupExists,
+		CreateContext: monitorGroupCreate,
+		ReadContext:   monitorGroupRead,
+		UpdateContext: monitorGroupUpdate,
+		DeleteContext: monitorGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: ImportByName("monitor group", findMonitorGroups),
 		},
 		Schema: MonitorGroupSchema,
 	}
 }
 
-func monitorGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func monitorGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	monitorGroup := resourceDataToMonitorGroupCreate(d, client)
 
 	monitorGroup, err := client.MonitorGroups().Create(monitorGroup)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(monitorGroup.GroupID)
@@ -167,12 +169,16 @@ func monitorGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func monitorGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func monitorGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	monitorGroup, err := client.MonitorGroups().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	// Check if the monitorGroup is nil or has no useful data
 	if monitorGroup == nil || monitorGroup.GroupID == "" {
@@ -184,19 +190,19 @@ func monitorGroupRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func monitorGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func monitorGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	monitorGroup, err := client.MonitorGroups().Get(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	monitorGroup = resourceDataToMonitorGroupUpdate(d, monitorGroup, client)
 
 	monitorGroup, err = client.MonitorGroups().Update(monitorGroup)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(monitorGroup.GroupID)
@@ -207,28 +213,13 @@ func monitorGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func monitorGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func monitorGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 	err := client.MonitorGroups().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
-	return err
-}
-
-func monitorGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(Client)
-
-	_, err := client.MonitorGroups().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToMonitorGroupCreate(d *schema.ResourceData, client Client) *api.MonitorGroup {
@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 )
 
@@ -60,18 +62,18 @@ var monitorGroupDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7MonitorGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:   monitorGroupDataSourceRead,
-		Schema: monitorGroupDataSourceSchema,
+		ReadContext: monitorGroupDataSourceRead,
+		Schema:      monitorGroupDataSourceSchema,
 	}
 }
 
 // monitorGroupDataSourceRead fetches all monitorGroup from Site24x7
-func monitorGroupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func monitorGroupDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	monitorGroupList, err := client.MonitorGroups().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nameRegex := d.Get("name_regex")
@@ -96,11 +98,11 @@ func monitorGroupDataSourceRead(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 	} else {
-		return errors.New("Please enter a value for the attribute name_regex!")
+		return diag.FromErr(errors.New("Please enter a value for the attribute name_regex!"))
 	}
 
 	if monitorGroup == nil {
-		return errors.New("Unable to find monitor group matching the name : \"" + d.Get("name_regex").(string))
+		return diag.FromErr(errors.New("Unable to find monitor group matching the name : \"" + d.Get("name_regex").(string)))
 	}
 
 	updateMonitorGroupDataSourceResourceData(d, monitorGroup)
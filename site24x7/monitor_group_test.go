@@ -1,9 +1,11 @@
 package site24x7
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -21,18 +23,18 @@ func TestMonitorGroupCreate(t *testing.T) {
 		Description:            "baz",
 		DependencyResourceIDs:  []string{"234", "567"},
 		DependencyResourceType: 2,
-		HealthThresholdCount:1,
+		HealthThresholdCount:   1,
 	}
 
 	c.FakeMonitorGroups.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, monitorGroupCreate(d, c))
+	require.Nil(t, monitorGroupCreate(context.Background(), d, c))
 
 	c.FakeMonitorGroups.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := monitorGroupCreate(d, c)
+	err := monitorGroupCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 // func TestMonitorGroupUpdate(t *testing.T) {
@@ -49,11 +51,11 @@ func TestMonitorGroupCreate(t *testing.T) {
 
 // 	c.FakeMonitorGroups.On("Update", a).Return(a, nil).Once()
 
-// 	require.NoError(t, monitorGroupUpdate(d, c))
+// 	require.Nil(t, monitorGroupUpdate(context.Background(), d, c))
 
 // 	c.FakeMonitorGroups.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-// 	err := monitorGroupUpdate(d, c)
+// 	err := monitorGroupUpdate(context.Background(), d, c)
 
 // 	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
 // }
@@ -66,13 +68,13 @@ func TestMonitorGroupRead(t *testing.T) {
 
 	c.FakeMonitorGroups.On("Get", "123").Return(&api.MonitorGroup{}, nil).Once()
 
-	require.NoError(t, monitorGroupRead(d, c))
+	require.Nil(t, monitorGroupRead(context.Background(), d, c))
 
 	c.FakeMonitorGroups.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := monitorGroupRead(d, c)
+	err := monitorGroupRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestMonitorGroupDelete(t *testing.T) {
@@ -83,39 +85,23 @@ func TestMonitorGroupDelete(t *testing.T) {
 
 	c.FakeMonitorGroups.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, monitorGroupDelete(d, c))
+	require.Nil(t, monitorGroupDelete(context.Background(), d, c))
 
 	c.FakeMonitorGroups.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, monitorGroupDelete(d, c))
+	require.Nil(t, monitorGroupDelete(context.Background(), d, c))
 }
 
-func TestMonitorGroupExists(t *testing.T) {
+func TestMonitorGroupReadNotFound(t *testing.T) {
 	d := monitorGroupTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeMonitorGroups.On("Get", "123").Return(&api.MonitorGroup{}, nil).Once()
-
-	exists, err := monitorGroupExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeMonitorGroups.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = monitorGroupExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeMonitorGroups.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = monitorGroupExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, monitorGroupRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func monitorGroupTestResourceData(t *testing.T) *schema.ResourceData {
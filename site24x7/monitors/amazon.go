@@ -1,7 +1,10 @@
 package monitors
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -94,27 +97,26 @@ var AmazonMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7AmazonMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: amazonMonitorCreate,
-		Read:   amazonMonitorRead,
-		Update: amazonMonitorUpdate,
-		Delete: amazonMonitorDelete,
-		Exists: amazonMonitorExists,
+		CreateContext: amazonMonitorCreate,
+		ReadContext:   amazonMonitorRead,
+		UpdateContext: amazonMonitorUpdate,
+		DeleteContext: amazonMonitorDelete,
 
 		Schema: AmazonMonitorSchema,
 	}
 }
 
-func amazonMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func amazonMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	monitor, err := resourceDataToAmazonMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	amazonMonitor, err := client.AmazonMonitors().Create(monitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(amazonMonitor.MonitorID)
@@ -122,12 +124,16 @@ func amazonMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func amazonMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func amazonMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	AmazonMonitor, err := client.AmazonMonitors().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateAmazonMonitorResourceData(d, AmazonMonitor)
@@ -135,17 +141,17 @@ func amazonMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func amazonMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func amazonMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	amazonMonitor, err := resourceDataToAmazonMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	amazonMonitor, err = client.AmazonMonitors().Update(amazonMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(amazonMonitor.MonitorID)
@@ -153,30 +159,15 @@ func amazonMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func amazonMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func amazonMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.AmazonMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func amazonMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.AmazonMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToAmazonMonitor(d *schema.ResourceData, client site24x7.Client) (*api.AmazonMonitor, error) {
@@ -1,10 +1,12 @@
 package monitors
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -118,71 +120,65 @@ var AzureMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7AzureMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: azureMonitorCreate,
-		Read:   azureMonitorRead,
-		Update: azureMonitorUpdate,
-		Delete: azureMonitorDelete,
-		Exists: azureMonitorExists,
-		Schema: AzureMonitorSchema,
+		CreateContext: azureMonitorCreate,
+		ReadContext:   azureMonitorRead,
+		UpdateContext: azureMonitorUpdate,
+		DeleteContext: azureMonitorDelete,
+		Schema:        AzureMonitorSchema,
 	}
 }
 
-func azureMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func azureMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 	monitor, err := resourceDataToAzureMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] Azure Monitor payload: %+v", monitor)
 
 	azureMonitor, err := client.AzureMonitors().Create(monitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 	d.SetId(azureMonitor.MonitorID)
 	return nil
 }
 
-func azureMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func azureMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 	azureMonitor, err := client.AzureMonitors().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	updateAzureMonitorResourceData(d, azureMonitor)
 	return nil
 }
 
-func azureMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func azureMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 	monitor, err := resourceDataToAzureMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	azureMonitor, err := client.AzureMonitors().Update(monitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 	d.SetId(azureMonitor.MonitorID)
 	return nil
 }
 
-func azureMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func azureMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 	err := client.AzureMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
-	return err
-}
-
-func azureMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-	_, err := client.AzureMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-	return err == nil, err
+	return diag.FromErr(err)
 }
 
 func expandAzureTagCondition(input []interface{}) *api.AzureTagCondition {
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -32,11 +34,11 @@ func TestAzureMonitorCreate(t *testing.T) {
 
 	c.FakeAzureMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, azureMonitorCreate(d, c))
+	require.Nil(t, azureMonitorCreate(context.Background(), d, c))
 
 	c.FakeAzureMonitors.On("Create", a).Return(nil, apierrors.NewStatusError(500, "error")).Once()
-	err := azureMonitorCreate(d, c)
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	err := azureMonitorCreate(context.Background(), d, c)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestAzureMonitorUpdate(t *testing.T) {
@@ -61,11 +63,11 @@ func TestAzureMonitorUpdate(t *testing.T) {
 	}
 
 	c.FakeAzureMonitors.On("Update", a).Return(a, nil).Once()
-	require.NoError(t, azureMonitorUpdate(d, c))
+	require.Nil(t, azureMonitorUpdate(context.Background(), d, c))
 
 	c.FakeAzureMonitors.On("Update", a).Return(nil, apierrors.NewStatusError(500, "error")).Once()
-	err := azureMonitorUpdate(d, c)
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	err := azureMonitorUpdate(context.Background(), d, c)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestAzureMonitorRead(t *testing.T) {
@@ -74,11 +76,11 @@ func TestAzureMonitorRead(t *testing.T) {
 
 	c := fake.NewClient()
 	c.FakeAzureMonitors.On("Get", "monitor-123").Return(&api.AzureMonitor{}, nil).Once()
-	require.NoError(t, azureMonitorRead(d, c))
+	require.Nil(t, azureMonitorRead(context.Background(), d, c))
 
 	c.FakeAzureMonitors.On("Get", "monitor-123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-	err := azureMonitorRead(d, c)
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	err := azureMonitorRead(context.Background(), d, c)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestAzureMonitorDelete(t *testing.T) {
@@ -87,32 +89,21 @@ func TestAzureMonitorDelete(t *testing.T) {
 
 	c := fake.NewClient()
 	c.FakeAzureMonitors.On("Delete", "monitor-123").Return(nil).Once()
-	require.NoError(t, azureMonitorDelete(d, c))
+	require.Nil(t, azureMonitorDelete(context.Background(), d, c))
 
 	c.FakeAzureMonitors.On("Delete", "monitor-123").Return(apierrors.NewStatusError(404, "not found")).Once()
-	require.NoError(t, azureMonitorDelete(d, c))
+	require.Nil(t, azureMonitorDelete(context.Background(), d, c))
 }
 
-func TestAzureMonitorExists(t *testing.T) {
+func TestAzureMonitorReadNotFound(t *testing.T) {
 	d := azureTestResourceData(t)
 	d.SetId("monitor-123")
 
 	c := fake.NewClient()
-	c.FakeAzureMonitors.On("Get", "monitor-123").Return(&api.AzureMonitor{}, nil).Once()
-
-	exists, err := azureMonitorExists(d, c)
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeAzureMonitors.On("Get", "monitor-123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
-	exists, err = azureMonitorExists(d, c)
-	require.NoError(t, err)
-	assert.False(t, exists)
 
-	c.FakeAzureMonitors.On("Get", "monitor-123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-	exists, err = azureMonitorExists(d, c)
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, azureMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func azureTestResourceData(t *testing.T) *schema.ResourceData {
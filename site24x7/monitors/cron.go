@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"sort"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -106,30 +108,29 @@ var CronMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7CronMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: cronMonitorCreate,
-		Read:   cronMonitorRead,
-		Update: cronMonitorUpdate,
-		Delete: cronMonitorDelete,
-		Exists: cronMonitorExists,
+		CreateContext: cronMonitorCreate,
+		ReadContext:   cronMonitorRead,
+		UpdateContext: cronMonitorUpdate,
+		DeleteContext: cronMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.CRON),
 		},
 
 		Schema: CronMonitorSchema,
 	}
 }
 
-func cronMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func cronMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	cronMonitor, err := resourceDataToCronMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cronMonitor, err = client.CronMonitors().Create(cronMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(cronMonitor.MonitorID)
@@ -137,12 +138,16 @@ func cronMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func cronMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func cronMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	cronMonitor, err := client.CronMonitors().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateCronMonitorResourceData(d, cronMonitor)
@@ -150,17 +155,17 @@ func cronMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func cronMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func cronMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	cronMonitor, err := resourceDataToCronMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	cronMonitor, err = client.CronMonitors().Update(cronMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(cronMonitor.MonitorID)
@@ -169,30 +174,15 @@ func cronMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func cronMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func cronMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.CronMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func cronMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.CronMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToCronMonitor(d *schema.ResourceData, client site24x7.Client) (*api.CronMonitor, error) {
@@ -259,7 +249,7 @@ func resourceDataToCronMonitor(d *schema.ResourceData, client site24x7.Client) (
 	if tagsErr != nil {
 		return nil, tagsErr
 	}
-	
+
 	// Threshold profile
 	if cronMonitor.ThresholdProfileID == "" {
 		profile, err := site24x7.DefaultThresholdProfile(client, api.CRON)
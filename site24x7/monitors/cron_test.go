@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -79,13 +81,13 @@ func TestCronMonitorCreate(t *testing.T) {
 
 	c.FakeCronMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, cronMonitorCreate(d, c))
+	require.Nil(t, cronMonitorCreate(context.Background(), d, c))
 
 	c.FakeCronMonitors.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := cronMonitorCreate(d, c)
+	err := cronMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestCronMonitorUpdate(t *testing.T) {
@@ -158,13 +160,13 @@ func TestCronMonitorUpdate(t *testing.T) {
 
 	c.FakeCronMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, cronMonitorUpdate(d, c))
+	require.Nil(t, cronMonitorUpdate(context.Background(), d, c))
 
 	c.FakeCronMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := cronMonitorUpdate(d, c)
+	err := cronMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestCronMonitorRead(t *testing.T) {
@@ -175,13 +177,13 @@ func TestCronMonitorRead(t *testing.T) {
 
 	c.FakeCronMonitors.On("Get", "123").Return(&api.CronMonitor{}, nil).Once()
 
-	require.NoError(t, cronMonitorRead(d, c))
+	require.Nil(t, cronMonitorRead(context.Background(), d, c))
 
 	c.FakeCronMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := cronMonitorRead(d, c)
+	err := cronMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestCronMonitorDelete(t *testing.T) {
@@ -192,39 +194,23 @@ func TestCronMonitorDelete(t *testing.T) {
 
 	c.FakeCronMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, cronMonitorDelete(d, c))
+	require.Nil(t, cronMonitorDelete(context.Background(), d, c))
 
 	c.FakeCronMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, cronMonitorDelete(d, c))
+	require.Nil(t, cronMonitorDelete(context.Background(), d, c))
 }
 
-func TestCronMonitorExists(t *testing.T) {
+func TestCronMonitorReadNotFound(t *testing.T) {
 	d := cronTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeCronMonitors.On("Get", "123").Return(&api.CronMonitor{}, nil).Once()
-
-	exists, err := cronMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeCronMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = cronMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeCronMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = cronMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, cronMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func cronTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,13 +1,15 @@
 package monitors
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	// "github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	// "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -281,30 +283,29 @@ var dnsServerMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7DNSServerMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: dnsServerMonitorCreate,
-		Read:   dnsServerMonitorRead,
-		Update: dnsServerMonitorUpdate,
-		Delete: dnsServerMonitorDelete,
-		Exists: dnsServerMonitorExists,
+		CreateContext: dnsServerMonitorCreate,
+		ReadContext:   dnsServerMonitorRead,
+		UpdateContext: dnsServerMonitorUpdate,
+		DeleteContext: dnsServerMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.DNS),
 		},
 
 		Schema: dnsServerMonitorSchema,
 	}
 }
 
-func dnsServerMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func dnsServerMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	dnsServerMonitor, err := resourceDataToDNSServerMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dnsServerMonitor, err = client.DNSServerMonitors().Create(dnsServerMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(dnsServerMonitor.MonitorID)
@@ -313,12 +314,16 @@ func dnsServerMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func dnsServerMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func dnsServerMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	dnsServerMonitor, err := client.DNSServerMonitors().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateDNSServerMonitorResourceData(d, dnsServerMonitor)
@@ -326,17 +331,17 @@ func dnsServerMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func dnsServerMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func dnsServerMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	dnsServerMonitor, err := resourceDataToDNSServerMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	dnsServerMonitor, err = client.DNSServerMonitors().Update(dnsServerMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(dnsServerMonitor.MonitorID)
@@ -345,30 +350,15 @@ func dnsServerMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func dnsServerMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func dnsServerMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.DNSServerMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func dnsServerMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.DNSServerMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToDNSServerMonitor(d *schema.ResourceData, client site24x7.Client) (*api.DNSServerMonitor, error) {
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -108,13 +110,13 @@ func TestDNSServerMonitorCreate(t *testing.T) {
 
 	c.FakeDNSServerMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, dnsServerMonitorCreate(d, c))
+	require.Nil(t, dnsServerMonitorCreate(context.Background(), d, c))
 
 	c.FakeDNSServerMonitors.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := dnsServerMonitorCreate(d, c)
+	err := dnsServerMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestDNSServerMonitorUpdate(t *testing.T) {
@@ -216,13 +218,13 @@ func TestDNSServerMonitorUpdate(t *testing.T) {
 
 	c.FakeDNSServerMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, dnsServerMonitorUpdate(d, c))
+	require.Nil(t, dnsServerMonitorUpdate(context.Background(), d, c))
 
 	c.FakeDNSServerMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := dnsServerMonitorUpdate(d, c)
+	err := dnsServerMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestDNSServerMonitorRead(t *testing.T) {
@@ -233,13 +235,13 @@ func TestDNSServerMonitorRead(t *testing.T) {
 
 	c.FakeDNSServerMonitors.On("Get", "123").Return(&api.DNSServerMonitor{}, nil).Once()
 
-	require.NoError(t, dnsServerMonitorRead(d, c))
+	require.Nil(t, dnsServerMonitorRead(context.Background(), d, c))
 
 	c.FakeDNSServerMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := dnsServerMonitorRead(d, c)
+	err := dnsServerMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestDNSServerMonitorDelete(t *testing.T) {
@@ -250,39 +252,23 @@ func TestDNSServerMonitorDelete(t *testing.T) {
 
 	c.FakeDNSServerMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, dnsServerMonitorDelete(d, c))
+	require.Nil(t, dnsServerMonitorDelete(context.Background(), d, c))
 
 	c.FakeDNSServerMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, dnsServerMonitorDelete(d, c))
+	require.Nil(t, dnsServerMonitorDelete(context.Background(), d, c))
 }
 
-func TestDNSServerMonitorExists(t *testing.T) {
+func TestDNSServerMonitorReadNotFound(t *testing.T) {
 	d := dnsServerMonitorTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeDNSServerMonitors.On("Get", "123").Return(&api.DNSServerMonitor{}, nil).Once()
-
-	exists, err := dnsServerMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeDNSServerMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = dnsServerMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeDNSServerMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = dnsServerMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, dnsServerMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func dnsServerMonitorTestResourceData(t *testing.T) *schema.ResourceData {
@@ -319,7 +305,7 @@ func dnsServerMonitorTestResourceData(t *testing.T) *schema.ResourceData {
 		},
 		"search_config": map[string]interface{}{
 			"addr": "1.2.3.4",
-			"ttl": 60,
+			"ttl":  60,
 			"ttlo": 60,
 		},
 	})
@@ -1,12 +1,13 @@
 package monitors
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -108,39 +109,15 @@ var DomainExpiryMonitorSchema = map[string]*schema.Schema{
 		Description: "Ignores the registry expiry date and prefer registrar expiry date when notifying for domain expiry",
 	},
 	"matching_keyword": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{0, 2}), // Trouble or Down
-				},
-				"value": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Check for the keyword in the website response.",
 	},
 	"unmatching_keyword": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{0, 2}), // Trouble or Down
-				},
-				"value": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Check for non existence of keyword in the website response.",
 	},
 	"match_case": {
@@ -149,21 +126,9 @@ var DomainExpiryMonitorSchema = map[string]*schema.Schema{
 		Description: "Perform case sensitive keyword search or not.",
 	},
 	"match_regex": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{0, 2}), // Trouble or Down
-				},
-				"value": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Match the regular expression in the website response.",
 	},
 	"monitor_groups": {
@@ -209,30 +174,29 @@ var DomainExpiryMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7DomainExpiryMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: domainExpiryMonitorCreate,
-		Read:   domainExpiryMonitorRead,
-		Update: domainExpiryMonitorUpdate,
-		Delete: domainExpiryMonitorDelete,
-		Exists: domainExpiryMonitorExists,
+		CreateContext: domainExpiryMonitorCreate,
+		ReadContext:   domainExpiryMonitorRead,
+		UpdateContext: domainExpiryMonitorUpdate,
+		DeleteContext: domainExpiryMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.DOMAINEXPIRY),
 		},
 
 		Schema: DomainExpiryMonitorSchema,
 	}
 }
 
-func domainExpiryMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func domainExpiryMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	domainExpiryMonitor, err := resourceDataToDomainExpiryMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	domainExpiryMonitor, err = client.DomainExpiryMonitors().Create(domainExpiryMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(domainExpiryMonitor.MonitorID)
@@ -241,13 +205,17 @@ func domainExpiryMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func domainExpiryMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func domainExpiryMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	domainExpiryMonitor, err := client.DomainExpiryMonitors().Get(d.Id())
 
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateDomainExpiryMonitorResourceData(d, domainExpiryMonitor)
@@ -255,17 +223,17 @@ func domainExpiryMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func domainExpiryMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func domainExpiryMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 	domainExpiryMonitor, err := resourceDataToDomainExpiryMonitor(d, client)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	domainExpiryMonitor, err = client.DomainExpiryMonitors().Update(domainExpiryMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(domainExpiryMonitor.MonitorID)
@@ -273,30 +241,15 @@ func domainExpiryMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func domainExpiryMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func domainExpiryMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.DomainExpiryMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func domainExpiryMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.DomainExpiryMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToDomainExpiryMonitor(d *schema.ResourceData, client site24x7.Client) (*api.DomainExpiryMonitor, error) {
@@ -421,9 +374,9 @@ func updateDomainExpiryMonitorResourceData(d *schema.ResourceData, monitor *api.
 	if monitor.MatchingKeyword != nil {
 		matchingKeywordMap := make(map[string]interface{})
 		matchingKeywordMap["severity"] = int(monitor.MatchingKeyword["severity"].(float64))
-		if(monitor.MatchingKeyword["value"]!=nil){
+		if monitor.MatchingKeyword["value"] != nil {
 			matchingKeywordMap["value"] = monitor.MatchingKeyword["value"].(string)
-		}else{
+		} else {
 			matchingKeywordMap["value"] = monitor.MatchingKeyword["value"]
 		}
 		d.Set("matching_keyword", matchingKeywordMap)
@@ -431,22 +384,22 @@ func updateDomainExpiryMonitorResourceData(d *schema.ResourceData, monitor *api.
 	if monitor.UnmatchingKeyword != nil {
 		unmatchingKeywordMap := make(map[string]interface{})
 		unmatchingKeywordMap["severity"] = int(monitor.UnmatchingKeyword["severity"].(float64))
-		if(monitor.UnmatchingKeyword["value"]!=nil){
+		if monitor.UnmatchingKeyword["value"] != nil {
 			unmatchingKeywordMap["value"] = monitor.UnmatchingKeyword["value"].(string)
-		}else{
+		} else {
 			unmatchingKeywordMap["value"] = monitor.UnmatchingKeyword["value"]
 		}
-		
+
 		d.Set("unmatching_keyword", unmatchingKeywordMap)
 	}
-	
+
 	if monitor.MatchRegex != nil {
-		
+
 		matchRegexMap := make(map[string]interface{})
 		matchRegexMap["severity"] = int(monitor.MatchRegex["severity"].(float64))
-		if(monitor.MatchRegex["value"]!=nil){
+		if monitor.MatchRegex["value"] != nil {
 			matchRegexMap["value"] = monitor.MatchRegex["value"].(string)
-		}else{
+		} else {
 			matchRegexMap["value"] = monitor.MatchRegex["value"]
 		}
 
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -91,13 +93,13 @@ func TestDomainExpiryMonitorCreate(t *testing.T) {
 
 	c.FakeDomainExpiryMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, domainExpiryMonitorCreate(d, c))
+	require.Nil(t, domainExpiryMonitorCreate(context.Background(), d, c))
 
 	c.FakeDomainExpiryMonitors.On("Create	", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := domainExpiryMonitorCreate(d, c)
+	err := domainExpiryMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestDomainExpiryMonitorUpdate(t *testing.T) {
@@ -177,13 +179,13 @@ func TestDomainExpiryMonitorUpdate(t *testing.T) {
 
 	c.FakeDomainExpiryMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, domainExpiryMonitorUpdate(d, c))
+	require.Nil(t, domainExpiryMonitorUpdate(context.Background(), d, c))
 
 	c.FakeDomainExpiryMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := domainExpiryMonitorUpdate(d, c)
+	err := domainExpiryMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestDomainExpiryMonitorRead(t *testing.T) {
@@ -194,13 +196,13 @@ func TestDomainExpiryMonitorRead(t *testing.T) {
 
 	c.FakeDomainExpiryMonitors.On("Get", "897654345678").Return(&api.DomainExpiryMonitor{}, nil).Once()
 
-	require.NoError(t, domainExpiryMonitorRead(d, c))
+	require.Nil(t, domainExpiryMonitorRead(context.Background(), d, c))
 
 	c.FakeDomainExpiryMonitors.On("Get", "897654345678").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := domainExpiryMonitorRead(d, c)
+	err := domainExpiryMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestDomainExpiryMonitorDelete(t *testing.T) {
@@ -211,39 +213,23 @@ func TestDomainExpiryMonitorDelete(t *testing.T) {
 
 	c.FakeDomainExpiryMonitors.On("Delete", "897654345678").Return(nil).Once()
 
-	require.NoError(t, domainExpiryMonitorDelete(d, c))
+	require.Nil(t, domainExpiryMonitorDelete(context.Background(), d, c))
 
 	c.FakeDomainExpiryMonitors.On("Delete", "897654345678").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, domainExpiryMonitorDelete(d, c))
+	require.Nil(t, domainExpiryMonitorDelete(context.Background(), d, c))
 }
 
-func TestDomainExpiryMonitorExists(t *testing.T) {
+func TestDomainExpiryMonitorReadNotFound(t *testing.T) {
 	d := domainExpiryMonitorTestResourceData(t)
 	d.SetId("897654345678")
 
 	c := fake.NewClient()
 
-	c.FakeDomainExpiryMonitors.On("Get", "897654345678").Return(&api.DomainExpiryMonitor{}, nil).Once()
-
-	exists, err := domainExpiryMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeDomainExpiryMonitors.On("Get", "897654345678").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = domainExpiryMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeDomainExpiryMonitors.On("Get", "897654345678").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = domainExpiryMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, domainExpiryMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func domainExpiryMonitorTestResourceData(t *testing.T) *schema.ResourceData {
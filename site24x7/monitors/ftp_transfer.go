@@ -1,10 +1,12 @@
 package monitors
 
 import (
+	"context"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -183,43 +185,46 @@ var FTPTransferMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7FTPTransferMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: ftpTransferMonitorCreate,
-		Read:   ftpTransferMonitorRead,
-		Update: ftpTransferMonitorUpdate,
-		Delete: ftpTransferMonitorDelete,
-		Exists: ftpTransferMonitorExists,
+		CreateContext: ftpTransferMonitorCreate,
+		ReadContext:   ftpTransferMonitorRead,
+		UpdateContext: ftpTransferMonitorUpdate,
+		DeleteContext: ftpTransferMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.FTP),
 		},
 
 		Schema: FTPTransferMonitorSchema,
 	}
 }
 
-func ftpTransferMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func ftpTransferMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	ftpTransferMonitor, err := resourceDataToFTPTransferMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ftpTransferMonitor, err = client.FTPTransferMonitors().Create(ftpTransferMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(ftpTransferMonitor.MonitorID)
 	return nil
 }
 
-func ftpTransferMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func ftpTransferMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	ftpTransferMonitor, err := client.FTPTransferMonitors().Get(d.Id())
 
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateFTPTransferMonitorResourceData(d, ftpTransferMonitor)
@@ -227,18 +232,18 @@ func ftpTransferMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func ftpTransferMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func ftpTransferMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	ftpTransferMonitor, err := resourceDataToFTPTransferMonitor(d, client)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ftpTransferMonitor, err = client.FTPTransferMonitors().Update(ftpTransferMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(ftpTransferMonitor.MonitorID)
@@ -246,30 +251,15 @@ func ftpTransferMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func ftpTransferMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func ftpTransferMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.FTPTransferMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func ftpTransferMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.FTPTransferMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToFTPTransferMonitor(d *schema.ResourceData, client site24x7.Client) (*api.FTPTransferMonitor, error) {
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -101,13 +103,13 @@ func TestFTPTransferMonitorCreate(t *testing.T) {
 
 	c.FakeFTPTransferMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, sslMonitorCreate(d, c))
+	require.Nil(t, sslMonitorCreate(context.Background(), d, c))
 
 	c.FakeFTPTransferMonitors.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := ftpTransferMonitorCreate(d, c)
+	err := ftpTransferMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestFTPTransferMonitorUpdate(t *testing.T) {
@@ -201,13 +203,13 @@ func TestFTPTransferMonitorUpdate(t *testing.T) {
 
 	c.FakeFTPTransferMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, sslMonitorUpdate(d, c))
+	require.Nil(t, sslMonitorUpdate(context.Background(), d, c))
 
 	c.FakeFTPTransferMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := sslMonitorUpdate(d, c)
+	err := sslMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestFTPTransferMonitorRead(t *testing.T) {
@@ -218,13 +220,13 @@ func TestFTPTransferMonitorRead(t *testing.T) {
 
 	c.FakeFTPTransferMonitors.On("Get", "123").Return(&api.FTPTransferMonitor{}, nil).Once()
 
-	require.NoError(t, ftpTransferMonitorRead(d, c))
+	require.Nil(t, ftpTransferMonitorRead(context.Background(), d, c))
 
 	c.FakeFTPTransferMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := ftpTransferMonitorRead(d, c)
+	err := ftpTransferMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestFTPTransferMonitorDelete(t *testing.T) {
@@ -235,39 +237,23 @@ func TestFTPTransferMonitorDelete(t *testing.T) {
 
 	c.FakeFTPTransferMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, ftpTransferMonitorDelete(d, c))
+	require.Nil(t, ftpTransferMonitorDelete(context.Background(), d, c))
 
 	c.FakeFTPTransferMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, ftpTransferMonitorDelete(d, c))
+	require.Nil(t, ftpTransferMonitorDelete(context.Background(), d, c))
 }
 
-func TestFTPTransferMonitorExists(t *testing.T) {
+func TestFTPTransferMonitorReadNotFound(t *testing.T) {
 	d := ftpTransferTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeFTPTransferMonitors.On("Get", "123").Return(&api.FTPTransferMonitor{}, nil).Once()
-
-	exists, err := ftpTransferMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeFTPTransferMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = ftpTransferMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeFTPTransferMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = ftpTransferMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, ftpTransferMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func ftpTransferTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,7 +1,10 @@
 package monitors
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -111,27 +114,26 @@ var GCPMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7GCPMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: gcpMonitorCreate,
-		Read:   gcpMonitorRead,
-		Update: gcpMonitorUpdate,
-		Delete: gcpMonitorDelete,
-		Exists: gcpMonitorExists,
+		CreateContext: gcpMonitorCreate,
+		ReadContext:   gcpMonitorRead,
+		UpdateContext: gcpMonitorUpdate,
+		DeleteContext: gcpMonitorDelete,
 
 		Schema: GCPMonitorSchema,
 	}
 }
 
-func gcpMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func gcpMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	monitor, err := resourceDataToGCPMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	gcpMonitor, err := client.GCPMonitors().Create(monitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(gcpMonitor.MonitorID)
@@ -139,12 +141,16 @@ func gcpMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func gcpMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func gcpMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	gcpMonitor, err := client.GCPMonitors().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateGCPMonitorResourceData(d, gcpMonitor)
@@ -152,12 +158,12 @@ func gcpMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func gcpMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func gcpMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	gcpMonitor, err := resourceDataToGCPMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if d.HasChange("gcp_discover_services") {
 		var gcpServicesToDiscover []int
@@ -172,7 +178,7 @@ func gcpMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	gcpMonitor, err = client.GCPMonitors().Update(gcpMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(gcpMonitor.MonitorID)
@@ -180,30 +186,15 @@ func gcpMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func gcpMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func gcpMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.GCPMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func gcpMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.GCPMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToGCPMonitor(d *schema.ResourceData, client site24x7.Client) (*api.GCPMonitor, error) {
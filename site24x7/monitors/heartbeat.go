@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"sort"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -96,30 +98,29 @@ var HeartbeatMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7HeartbeatMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: heartbeatMonitorCreate,
-		Read:   heartbeatMonitorRead,
-		Update: heartbeatMonitorUpdate,
-		Delete: heartbeatMonitorDelete,
-		Exists: heartbeatMonitorExists,
+		CreateContext: heartbeatMonitorCreate,
+		ReadContext:   heartbeatMonitorRead,
+		UpdateContext: heartbeatMonitorUpdate,
+		DeleteContext: heartbeatMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.HEARTBEAT),
 		},
 
 		Schema: HeartbeatMonitorSchema,
 	}
 }
 
-func heartbeatMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func heartbeatMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	heartbeatMonitor, err := resourceDataToHeartbeatMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	heartbeatMonitor, err = client.HeartbeatMonitors().Create(heartbeatMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(heartbeatMonitor.MonitorID)
@@ -127,12 +128,16 @@ func heartbeatMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func heartbeatMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func heartbeatMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	heartbeatMonitor, err := client.HeartbeatMonitors().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateHeartbeatMonitorResourceData(d, heartbeatMonitor)
@@ -140,17 +145,17 @@ func heartbeatMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func heartbeatMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func heartbeatMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	heartbeatMonitor, err := resourceDataToHeartbeatMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	heartbeatMonitor, err = client.HeartbeatMonitors().Update(heartbeatMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(heartbeatMonitor.MonitorID)
@@ -159,30 +164,15 @@ func heartbeatMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func heartbeatMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func heartbeatMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.HeartbeatMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func heartbeatMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.HeartbeatMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToHeartbeatMonitor(d *schema.ResourceData, client site24x7.Client) (*api.HeartbeatMonitor, error) {
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -23,9 +25,9 @@ func TestHeartbeatMonitorCreate(t *testing.T) {
 		ThresholdProfileID:    "012",
 		NotificationProfileID: "789",
 		MonitorGroups:         []string{"234", "567"},
-		UserGroupIDs:          []string{"456","123"},
+		UserGroupIDs:          []string{"456", "123"},
 		TagIDs:                []string{"123"},
-		ThirdPartyServiceIDs:  []string{"456","123"},
+		ThirdPartyServiceIDs:  []string{"456", "123"},
 		OnCallScheduleID:      "1244",
 	}
 
@@ -77,13 +79,13 @@ func TestHeartbeatMonitorCreate(t *testing.T) {
 
 	c.FakeHeartbeatMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, heartbeatMonitorCreate(d, c))
+	require.Nil(t, heartbeatMonitorCreate(context.Background(), d, c))
 
 	c.FakeHeartbeatMonitors.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := heartbeatMonitorCreate(d, c)
+	err := heartbeatMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestHeartbeatMonitorUpdate(t *testing.T) {
@@ -100,9 +102,9 @@ func TestHeartbeatMonitorUpdate(t *testing.T) {
 		ThresholdProfileID:    "012",
 		NotificationProfileID: "789",
 		MonitorGroups:         []string{"234", "567"},
-		UserGroupIDs:          []string{"456","123"},
+		UserGroupIDs:          []string{"456", "123"},
 		TagIDs:                []string{"123"},
-		ThirdPartyServiceIDs:  []string{"456","123"},
+		ThirdPartyServiceIDs:  []string{"456", "123"},
 		OnCallScheduleID:      "1244",
 	}
 
@@ -154,13 +156,13 @@ func TestHeartbeatMonitorUpdate(t *testing.T) {
 
 	c.FakeHeartbeatMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, heartbeatMonitorUpdate(d, c))
+	require.Nil(t, heartbeatMonitorUpdate(context.Background(), d, c))
 
 	c.FakeHeartbeatMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := heartbeatMonitorUpdate(d, c)
+	err := heartbeatMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestHeartbeatMonitorRead(t *testing.T) {
@@ -171,13 +173,13 @@ func TestHeartbeatMonitorRead(t *testing.T) {
 
 	c.FakeHeartbeatMonitors.On("Get", "123").Return(&api.HeartbeatMonitor{}, nil).Once()
 
-	require.NoError(t, heartbeatMonitorRead(d, c))
+	require.Nil(t, heartbeatMonitorRead(context.Background(), d, c))
 
 	c.FakeHeartbeatMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := heartbeatMonitorRead(d, c)
+	err := heartbeatMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestHeartbeatMonitorDelete(t *testing.T) {
@@ -188,39 +190,23 @@ func TestHeartbeatMonitorDelete(t *testing.T) {
 
 	c.FakeHeartbeatMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, heartbeatMonitorDelete(d, c))
+	require.Nil(t, heartbeatMonitorDelete(context.Background(), d, c))
 
 	c.FakeHeartbeatMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, heartbeatMonitorDelete(d, c))
+	require.Nil(t, heartbeatMonitorDelete(context.Background(), d, c))
 }
 
-func TestHeartbeatMonitorExists(t *testing.T) {
+func TestHeartbeatMonitorReadNotFound(t *testing.T) {
 	d := heartbeatTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeHeartbeatMonitors.On("Get", "123").Return(&api.HeartbeatMonitor{}, nil).Once()
-
-	exists, err := heartbeatMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeHeartbeatMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = heartbeatMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeHeartbeatMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = heartbeatMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, heartbeatMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func heartbeatTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,10 +1,12 @@
 package monitors
 
 import (
+	"context"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -156,29 +158,28 @@ var ISPMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7ISPMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: ispMonitorCreate,
-		Read:   ispMonitorRead,
-		Update: ispMonitorUpdate,
-		Delete: ispMonitorDelete,
-		Exists: ispMonitorExists,
+		CreateContext: ispMonitorCreate,
+		ReadContext:   ispMonitorRead,
+		UpdateContext: ispMonitorUpdate,
+		DeleteContext: ispMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.ISP),
 		},
 
 		Schema: ISPMonitorSchema,
 	}
 }
 
-func ispMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func ispMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	ispMonitor, err := resourceDataToISPMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	ispMonitor, err = client.ISPMonitors().Create(ispMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(ispMonitor.MonitorID)
@@ -187,13 +188,17 @@ func ispMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func ispMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func ispMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	ispMonitor, err := client.ISPMonitors().Get(d.Id())
 
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateISPMonitorResourceData(d, ispMonitor)
@@ -201,18 +206,18 @@ func ispMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func ispMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func ispMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	ispMonitor, err := resourceDataToISPMonitor(d, client)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	ispMonitor, err = client.ISPMonitors().Update(ispMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(ispMonitor.MonitorID)
@@ -221,30 +226,15 @@ func ispMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func ispMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func ispMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ISPMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func ispMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.ISPMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToISPMonitor(d *schema.ResourceData, client site24x7.Client) (*api.ISPMonitor, error) {
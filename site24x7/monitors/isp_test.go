@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -94,13 +96,13 @@ func TestISPMonitorCreate(t *testing.T) {
 
 	c.FakeISPMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, sslMonitorCreate(d, c))
+	require.Nil(t, sslMonitorCreate(context.Background(), d, c))
 
 	c.FakeISPMonitors.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := ispMonitorCreate(d, c)
+	err := ispMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestISPMonitorUpdate(t *testing.T) {
@@ -187,13 +189,13 @@ func TestISPMonitorUpdate(t *testing.T) {
 
 	c.FakeISPMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, sslMonitorUpdate(d, c))
+	require.Nil(t, sslMonitorUpdate(context.Background(), d, c))
 
 	c.FakeISPMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := sslMonitorUpdate(d, c)
+	err := sslMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestISPMonitorRead(t *testing.T) {
@@ -204,13 +206,13 @@ func TestISPMonitorRead(t *testing.T) {
 
 	c.FakeISPMonitors.On("Get", "123").Return(&api.ISPMonitor{}, nil).Once()
 
-	require.NoError(t, ispMonitorRead(d, c))
+	require.Nil(t, ispMonitorRead(context.Background(), d, c))
 
 	c.FakeISPMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := ispMonitorRead(d, c)
+	err := ispMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestISPMonitorDelete(t *testing.T) {
@@ -221,39 +223,23 @@ func TestISPMonitorDelete(t *testing.T) {
 
 	c.FakeISPMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, ispMonitorDelete(d, c))
+	require.Nil(t, ispMonitorDelete(context.Background(), d, c))
 
 	c.FakeISPMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, ispMonitorDelete(d, c))
+	require.Nil(t, ispMonitorDelete(context.Background(), d, c))
 }
 
-func TestISPMonitorExists(t *testing.T) {
+func TestISPMonitorReadNotFound(t *testing.T) {
 	d := ispTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeISPMonitors.On("Get", "123").Return(&api.ISPMonitor{}, nil).Once()
-
-	exists, err := ispMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeISPMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = ispMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeISPMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = ispMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, ispMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func ispTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,10 +1,12 @@
 package monitors
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
 )
@@ -80,18 +82,18 @@ var monitorDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7Monitor() *schema.Resource {
 	return &schema.Resource{
-		Read:   monitorDataSourceRead,
-		Schema: monitorDataSourceSchema,
+		ReadContext: monitorDataSourceRead,
+		Schema:      monitorDataSourceSchema,
 	}
 }
 
 // monitorDataSourceRead fetches all server monitors from Site24x7
-func monitorDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func monitorDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	allMonitorList, err := client.WebsiteMonitors().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	monitorType := d.Get("monitor_type").(string)
 	var genericMonitor *api.GenericMonitor
@@ -117,7 +119,7 @@ func monitorDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if genericMonitor == nil {
-		return errors.New("Unable to find monitor matching the name : \"" + d.Get("name_regex").(string) + "\" and monitor type : \"" + monitorType + "\"")
+		return diag.FromErr(errors.New("Unable to find monitor matching the name : \"" + d.Get("name_regex").(string) + "\" and monitor type : \"" + monitorType + "\""))
 	}
 
 	updateResourceData(d, genericMonitor)
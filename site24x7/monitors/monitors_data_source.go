@@ -1,12 +1,13 @@
 package monitors
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
 )
 
@@ -35,18 +36,18 @@ var monitorsDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7Monitors() *schema.Resource {
 	return &schema.Resource{
-		Read:   monitorsDataSourceRead,
-		Schema: monitorsDataSourceSchema,
+		ReadContext: monitorsDataSourceRead,
+		Schema:      monitorsDataSourceSchema,
 	}
 }
 
 // monitorDataSourceRead fetches all server monitors from Site24x7
-func monitorsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func monitorsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	allMonitorList, err := client.WebsiteMonitors().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	var monitorIDs []string
 	var monitorIDsAndNames []string
@@ -80,7 +81,7 @@ func monitorsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(monitorIDs) == 0 {
-		return errors.New("Unable to find monitors matching the name : \"" + d.Get("name_regex").(string) + "\" and monitor type : \"" + monitorType + "\"")
+		return diag.FromErr(errors.New("Unable to find monitors matching the name : \"" + d.Get("name_regex").(string) + "\" and monitor type : \"" + monitorType + "\""))
 	}
 
 	updateMonitorIDsResourceData(d, monitorIDs, monitorIDsAndNames)
@@ -89,7 +90,7 @@ func monitorsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func updateMonitorIDsResourceData(d *schema.ResourceData, monitorIDs []string, monitorIDsAndNames []string) {
-	d.SetId(fmt.Sprintf("%d", hashcode.String(d.Get("name_regex").(string)+d.Get("monitor_type").(string))))
+	d.SetId(fmt.Sprintf("%d", schema.HashString(d.Get("name_regex").(string)+d.Get("monitor_type").(string))))
 	d.Set("ids", monitorIDs)
 	d.Set("ids_and_names", monitorIDsAndNames)
 }
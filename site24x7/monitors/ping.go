@@ -1,10 +1,12 @@
 package monitors
 
 import (
+	"context"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -144,29 +146,28 @@ var PINGMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7PINGMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: pingMonitorCreate,
-		Read:   pingMonitorRead,
-		Update: pingMonitorUpdate,
-		Delete: pingMonitorDelete,
-		Exists: pingMonitorExists,
+		CreateContext: pingMonitorCreate,
+		ReadContext:   pingMonitorRead,
+		UpdateContext: pingMonitorUpdate,
+		DeleteContext: pingMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.PING),
 		},
 
 		Schema: PINGMonitorSchema,
 	}
 }
 
-func pingMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func pingMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	pingMonitor, err := resourceDataToPINGMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	pingMonitor, err = client.PINGMonitors().Create(pingMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(pingMonitor.MonitorID)
@@ -174,13 +175,17 @@ func pingMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func pingMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func pingMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	pingMonitor, err := client.PINGMonitors().Get(d.Id())
 
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updatePINGMonitorResourceData(d, pingMonitor)
@@ -188,18 +193,18 @@ func pingMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func pingMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func pingMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(site24x7.Client)
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	pingMonitor, err := resourceDataToPINGMonitor(d, client)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	pingMonitor, err = client.PINGMonitors().Update(pingMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(pingMonitor.MonitorID)
@@ -207,30 +212,15 @@ func pingMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func pingMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func pingMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.PINGMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func pingMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.PINGMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToPINGMonitor(d *schema.ResourceData, client site24x7.Client) (*api.PINGMonitor, error) {
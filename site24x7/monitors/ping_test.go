@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -91,13 +93,13 @@ func TestPINGMonitorCreate(t *testing.T) {
 
 	c.FakePINGMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, pingMonitorCreate(d, c))
+	require.Nil(t, pingMonitorCreate(context.Background(), d, c))
 
 	c.FakePINGMonitors.On("Create	", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := pingMonitorCreate(d, c)
+	err := pingMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestPINGMonitorUpdate(t *testing.T) {
@@ -199,13 +201,13 @@ func TestPINGMonitorUpdate(t *testing.T) {
 
 	c.FakePINGMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, pingMonitorUpdate(d, c))
+	require.Nil(t, pingMonitorUpdate(context.Background(), d, c))
 
 	c.FakePINGMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := pingMonitorUpdate(d, c)
+	err := pingMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestPINGMonitorRead(t *testing.T) {
@@ -216,13 +218,13 @@ func TestPINGMonitorRead(t *testing.T) {
 
 	c.FakePINGMonitors.On("Get", "123").Return(&api.PINGMonitor{}, nil).Once()
 
-	require.NoError(t, pingMonitorRead(d, c))
+	require.Nil(t, pingMonitorRead(context.Background(), d, c))
 
 	c.FakePINGMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := pingMonitorRead(d, c)
+	err := pingMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestPINGMonitorDelete(t *testing.T) {
@@ -233,39 +235,23 @@ func TestPINGMonitorDelete(t *testing.T) {
 
 	c.FakePINGMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, pingMonitorDelete(d, c))
+	require.Nil(t, pingMonitorDelete(context.Background(), d, c))
 
 	c.FakePINGMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, pingMonitorDelete(d, c))
+	require.Nil(t, pingMonitorDelete(context.Background(), d, c))
 }
 
-func TestPINGMonitorExists(t *testing.T) {
+func TestPINGMonitorReadNotFound(t *testing.T) {
 	d := pingMonitorTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakePINGMonitors.On("Get", "123").Return(&api.PINGMonitor{}, nil).Once()
-
-	exists, err := pingMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakePINGMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = pingMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakePINGMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = pingMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, pingMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func pingMonitorTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,10 +1,12 @@
 package monitors
 
 import (
+	"context"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -194,28 +196,27 @@ var PortMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7PortMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: portMonitorCreate,
-		Read:   portMonitorRead,
-		Update: portMonitorUpdate,
-		Delete: portMonitorDelete,
-		Exists: portMonitorExists,
+		CreateContext: portMonitorCreate,
+		ReadContext:   portMonitorRead,
+		UpdateContext: portMonitorUpdate,
+		DeleteContext: portMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.PORT),
 		},
 
 		Schema: PortMonitorSchema,
 	}
 }
 
-func portMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func portMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 	portMonitor, err := resourceDataToPortMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	portMonitor, err = client.PortMonitors().Create(portMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(portMonitor.MonitorID)
@@ -223,13 +224,17 @@ func portMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func portMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func portMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	portMonitor, err := client.PortMonitors().Get(d.Id())
 
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updatePortMonitorResourceData(d, portMonitor)
@@ -237,18 +242,18 @@ func portMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func portMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func portMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	portMonitor, err := resourceDataToPortMonitor(d, client)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	portMonitor, err = client.PortMonitors().Update(portMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(portMonitor.MonitorID)
@@ -256,30 +261,15 @@ func portMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func portMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func portMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.PortMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func portMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.PortMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToPortMonitor(d *schema.ResourceData, client site24x7.Client) (*api.PortMonitor, error) {
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -94,13 +96,13 @@ func TestPortMonitorCreate(t *testing.T) {
 
 	c.FakePortMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, portMonitorCreate(d, c))
+	require.Nil(t, portMonitorCreate(context.Background(), d, c))
 
 	c.FakePortMonitors.On("Create	", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := portMonitorCreate(d, c)
+	err := portMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestPortMonitorUpdate(t *testing.T) {
@@ -202,13 +204,13 @@ func TestPortMonitorUpdate(t *testing.T) {
 
 	c.FakePortMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, portMonitorUpdate(d, c))
+	require.Nil(t, portMonitorUpdate(context.Background(), d, c))
 
 	c.FakePortMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := portMonitorUpdate(d, c)
+	err := portMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestPortMonitorRead(t *testing.T) {
@@ -219,13 +221,13 @@ func TestPortMonitorRead(t *testing.T) {
 
 	c.FakePortMonitors.On("Get", "123").Return(&api.PortMonitor{}, nil).Once()
 
-	require.NoError(t, portMonitorRead(d, c))
+	require.Nil(t, portMonitorRead(context.Background(), d, c))
 
 	c.FakePortMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := portMonitorRead(d, c)
+	err := portMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestPortMonitorDelete(t *testing.T) {
@@ -236,39 +238,23 @@ func TestPortMonitorDelete(t *testing.T) {
 
 	c.FakePortMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, portMonitorDelete(d, c))
+	require.Nil(t, portMonitorDelete(context.Background(), d, c))
 
 	c.FakePortMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, portMonitorDelete(d, c))
+	require.Nil(t, portMonitorDelete(context.Background(), d, c))
 }
 
-func TestPortMonitorExists(t *testing.T) {
+func TestPortMonitorReadNotFound(t *testing.T) {
 	d := portMonitorTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakePortMonitors.On("Get", "123").Return(&api.PortMonitor{}, nil).Once()
-
-	exists, err := portMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakePortMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = portMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakePortMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = portMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, portMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func portMonitorTestResourceData(t *testing.T) *schema.ResourceData {
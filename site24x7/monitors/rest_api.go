@@ -1,12 +1,14 @@
 package monitors
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -137,39 +139,15 @@ var RestApiMonitorSchema = map[string]*schema.Schema{
 		Description: "JSON Schema check allows you to annotate and validate all JSON endpoints for your web service.",
 	},
 	"matching_keyword": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{0, 2}), // Trouble or Down
-				},
-				"value": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Check for the keyword in the website response.",
 	},
 	"unmatching_keyword": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{0, 2}), // Trouble or Down
-				},
-				"value": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Check for non existence of keyword in the website response.",
 	},
 	"match_case": {
@@ -178,21 +156,9 @@ var RestApiMonitorSchema = map[string]*schema.Schema{
 		Description: "Perform case sensitive keyword search or not.",
 	},
 	"match_regex": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{0, 2}), // Trouble or Down
-				},
-				"value": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Match the regular expression in the website response.",
 	},
 	"response_headers": {
@@ -230,20 +196,9 @@ var RestApiMonitorSchema = map[string]*schema.Schema{
 		Description: "A Map of request header name and value.",
 	},
 	"graphql": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"query": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-				"variables": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Check for the keyword in the website response.",
 	},
 	"user_agent": {
@@ -421,30 +376,29 @@ var RestApiMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7RestApiMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: restApiMonitorCreate,
-		Read:   restApiMonitorRead,
-		Update: restApiMonitorUpdate,
-		Delete: restApiMonitorDelete,
-		Exists: restApiMonitorExists,
+		CreateContext: restApiMonitorCreate,
+		ReadContext:   restApiMonitorRead,
+		UpdateContext: restApiMonitorUpdate,
+		DeleteContext: restApiMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.RESTAPI),
 		},
 
 		Schema: RestApiMonitorSchema,
 	}
 }
 
-func restApiMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func restApiMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	restApiMonitor, err := resourceDataToRestApiMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	restApiMonitor, err = client.RestApiMonitors().Create(restApiMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(restApiMonitor.MonitorID)
@@ -453,13 +407,17 @@ func restApiMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func restApiMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func restApiMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	restApiMonitor, err := client.RestApiMonitors().Get(d.Id())
 
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateRestApiMonitorResourceData(d, restApiMonitor)
@@ -467,18 +425,18 @@ func restApiMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func restApiMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func restApiMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	restApiMonitor, err := resourceDataToRestApiMonitor(d, client)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	restApiMonitor, err = client.RestApiMonitors().Update(restApiMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(restApiMonitor.MonitorID)
@@ -487,30 +445,15 @@ func restApiMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func restApiMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func restApiMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.RestApiMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func restApiMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.RestApiMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToRestApiMonitor(d *schema.ResourceData, client site24x7.Client) (*api.RestApiMonitor, error) {
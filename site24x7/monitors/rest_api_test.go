@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -143,13 +145,13 @@ func TestRestApiMonitorCreate(t *testing.T) {
 
 	c.FakeRestApiMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, restApiMonitorCreate(d, c))
+	require.Nil(t, restApiMonitorCreate(context.Background(), d, c))
 
 	c.FakeRestApiMonitors.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := restApiMonitorCreate(d, c)
+	err := restApiMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestRestApiMonitorUpdate(t *testing.T) {
@@ -284,13 +286,13 @@ func TestRestApiMonitorUpdate(t *testing.T) {
 
 	c.FakeRestApiMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, restApiMonitorUpdate(d, c))
+	require.Nil(t, restApiMonitorUpdate(context.Background(), d, c))
 
 	c.FakeRestApiMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := restApiMonitorUpdate(d, c)
+	err := restApiMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestRestApiMonitorRead(t *testing.T) {
@@ -301,13 +303,13 @@ func TestRestApiMonitorRead(t *testing.T) {
 
 	c.FakeRestApiMonitors.On("Get", "123").Return(&api.RestApiMonitor{}, nil).Once()
 
-	require.NoError(t, restApiMonitorRead(d, c))
+	require.Nil(t, restApiMonitorRead(context.Background(), d, c))
 
 	c.FakeRestApiMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := restApiMonitorRead(d, c)
+	err := restApiMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestRestApiMonitorDelete(t *testing.T) {
@@ -318,39 +320,23 @@ func TestRestApiMonitorDelete(t *testing.T) {
 
 	c.FakeRestApiMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, restApiMonitorDelete(d, c))
+	require.Nil(t, restApiMonitorDelete(context.Background(), d, c))
 
 	c.FakeRestApiMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, restApiMonitorDelete(d, c))
+	require.Nil(t, restApiMonitorDelete(context.Background(), d, c))
 }
 
-func TestRestApiMonitorExists(t *testing.T) {
+func TestRestApiMonitorReadNotFound(t *testing.T) {
 	d := restApiMonitorTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeRestApiMonitors.On("Get", "123").Return(&api.RestApiMonitor{}, nil).Once()
-
-	exists, err := restApiMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeRestApiMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = restApiMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeRestApiMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = restApiMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, restApiMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func restApiMonitorTestResourceData(t *testing.T) *schema.ResourceData {
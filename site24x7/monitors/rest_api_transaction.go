@@ -1,12 +1,14 @@
 package monitors
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -295,39 +297,15 @@ var RestApiTransactionMonitorSchema = map[string]*schema.Schema{
 								Description: "JSON Schema check allows you to annotate and validate all JSON endpoints for your web service.",
 							},
 							"matching_keyword": {
-								Type:     schema.TypeMap,
-								Optional: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"severity": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntInSlice([]int{0, 2}), // Trouble or Down
-										},
-										"value": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
-									},
-								},
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
 								Description: "Check for the keyword in the website response.",
 							},
 							"unmatching_keyword": {
-								Type:     schema.TypeMap,
-								Optional: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"severity": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntInSlice([]int{0, 2}), // Trouble or Down
-										},
-										"value": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
-									},
-								},
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
 								Description: "Check for non existence of keyword in the website response.",
 							},
 							"match_case": {
@@ -336,21 +314,9 @@ var RestApiTransactionMonitorSchema = map[string]*schema.Schema{
 								Description: "Perform case sensitive keyword search or not.",
 							},
 							"match_regex": {
-								Type:     schema.TypeMap,
-								Optional: true,
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"severity": {
-											Type:         schema.TypeInt,
-											Required:     true,
-											ValidateFunc: validation.IntInSlice([]int{0, 2}), // Trouble or Down
-										},
-										"value": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
-									},
-								},
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
 								Description: "Match the regular expression in the website response.",
 							},
 							"response_headers": {
@@ -502,31 +468,30 @@ var RestApiTransactionMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7RestApiTransactionMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: restApiTransactionMonitorCreate,
-		Read:   restApiTransactionMonitorRead,
-		Update: restApiTransactionMonitorUpdate,
-		Delete: restApiTransactionMonitorDelete,
-		Exists: restApiTransactionMonitorExists,
+		CreateContext: restApiTransactionMonitorCreate,
+		ReadContext:   restApiTransactionMonitorRead,
+		UpdateContext: restApiTransactionMonitorUpdate,
+		DeleteContext: restApiTransactionMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.RESTAPISEQ),
 		},
 
 		Schema: RestApiTransactionMonitorSchema,
 	}
 }
 
-func restApiTransactionMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func restApiTransactionMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(site24x7.Client)
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	restApiTransactionMonitor, err := resourceDataToRestApiTransactionMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	restApiTransactionMonitor, err = client.RestApiTransactionMonitors().Create(restApiTransactionMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(restApiTransactionMonitor.MonitorID)
@@ -535,19 +500,23 @@ func restApiTransactionMonitorCreate(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func restApiTransactionMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func restApiTransactionMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(site24x7.Client)
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	restApiTransactionMonitors, err := client.RestApiTransactionMonitors().Get(d.Id())
 
 	restApiTransactionMonitorsSteps, steperr := client.RestApiTransactionMonitors().GetSteps(d.Id())
 
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	if steperr != nil {
-		return steperr
+		return diag.FromErr(steperr)
 	}
 
 	updateRestApiTransactionMonitorResourceData(d, restApiTransactionMonitors, restApiTransactionMonitorsSteps)
@@ -555,18 +524,18 @@ func restApiTransactionMonitorRead(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func restApiTransactionMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func restApiTransactionMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	restApiTransactionMonitors, err := resourceDataToRestApiTransactionMonitor(d, client)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	restApiTransactionMonitors, err = client.RestApiTransactionMonitors().Update(restApiTransactionMonitors)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(restApiTransactionMonitors.MonitorID)
@@ -575,30 +544,15 @@ func restApiTransactionMonitorUpdate(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func restApiTransactionMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func restApiTransactionMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.RestApiTransactionMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func restApiTransactionMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.RestApiTransactionMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToRestApiTransactionMonitor(d *schema.ResourceData, client site24x7.Client) (*api.RestApiTransactionMonitor, error) {
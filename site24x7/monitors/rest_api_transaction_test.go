@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -172,13 +174,13 @@ func TestRestApiTransactionMonitorCreate(t *testing.T) {
 
 	c.FakeRestApiTransactionMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, restApiTransactionMonitorCreate(d, c))
+	require.Nil(t, restApiTransactionMonitorCreate(context.Background(), d, c))
 
 	c.FakeRestApiTransactionMonitors.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := restApiTransactionMonitorCreate(d, c)
+	err := restApiTransactionMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestRestApiTransactionMonitorUpdate(t *testing.T) {
@@ -367,13 +369,13 @@ func TestRestApiTransactionMonitorUpdate(t *testing.T) {
 
 	c.FakeRestApiTransactionMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, restApiTransactionMonitorUpdate(d, c))
+	require.Nil(t, restApiTransactionMonitorUpdate(context.Background(), d, c))
 
 	c.FakeRestApiTransactionMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := restApiTransactionMonitorUpdate(d, c)
+	err := restApiTransactionMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestRestApiTransactionMonitorRead(t *testing.T) {
@@ -384,13 +386,13 @@ func TestRestApiTransactionMonitorRead(t *testing.T) {
 
 	c.FakeRestApiTransactionMonitors.On("Get", "123").Return(&api.RestApiMonitor{}, nil).Once()
 
-	require.NoError(t, restApiTransactionMonitorRead(d, c))
+	require.Nil(t, restApiTransactionMonitorRead(context.Background(), d, c))
 
 	c.FakeRestApiTransactionMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := restApiTransactionMonitorRead(d, c)
+	err := restApiTransactionMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestRestApiTransactionMonitorDelete(t *testing.T) {
@@ -401,39 +403,23 @@ func TestRestApiTransactionMonitorDelete(t *testing.T) {
 
 	c.FakeRestApiTransactionMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, restApiTransactionMonitorDelete(d, c))
+	require.Nil(t, restApiTransactionMonitorDelete(context.Background(), d, c))
 
 	c.FakeRestApiTransactionMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, restApiTransactionMonitorDelete(d, c))
+	require.Nil(t, restApiTransactionMonitorDelete(context.Background(), d, c))
 }
 
-func TestRestApiTransactionMonitorExists(t *testing.T) {
+func TestRestApiTransactionMonitorReadNotFound(t *testing.T) {
 	d := restApiTransactionMonitorTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeRestApiTransactionMonitors.On("Get", "123").Return(&api.RestApiMonitor{}, nil).Once()
-
-	exists, err := restApiTransactionMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeRestApiTransactionMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = restApiTransactionMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeRestApiTransactionMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = restApiTransactionMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, restApiTransactionMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func restApiTransactionMonitorTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"sort"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -105,24 +107,27 @@ var ServerMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7ServerMonitor() *schema.Resource {
 	return &schema.Resource{
-		Read:   serverMonitorRead,
-		Update: serverMonitorUpdate,
-		Delete: serverMonitorDelete,
-		Exists: serverMonitorExists,
+		ReadContext:   serverMonitorRead,
+		UpdateContext: serverMonitorUpdate,
+		DeleteContext: serverMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.SERVER),
 		},
 
 		Schema: ServerMonitorSchema,
 	}
 }
 
-func serverMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func serverMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	serverMonitor, err := client.ServerMonitors().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateServerMonitorResourceData(d, serverMonitor)
@@ -130,18 +135,18 @@ func serverMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func serverMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func serverMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 	serverMon, err := client.ServerMonitors().Get(d.Id())
 
 	serverMonitor, err := resourceDataToServerMonitor(d, client, serverMon)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serverMonitor, err = client.ServerMonitors().Update(serverMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(serverMonitor.MonitorID)
@@ -150,30 +155,15 @@ func serverMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func serverMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func serverMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.ServerMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func serverMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.ServerMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToServerMonitor(d *schema.ResourceData, client site24x7.Client, serverMon *api.ServerMonitor) (*api.ServerMonitor, error) {
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -105,11 +107,11 @@ import (
 
 // 	c.FakeServerMonitors.On("Update", a).Return(a, nil).Once()
 
-// 	require.NoError(t, serverMonitorUpdate(d, c))
+// 	require.Nil(t, serverMonitorUpdate(context.Background(), d, c))
 
 // 	c.FakeServerMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-// 	err := serverMonitorUpdate(d, c)
+// 	err := serverMonitorUpdate(context.Background(), d, c)
 
 // 	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
 // }
@@ -122,13 +124,13 @@ func TestServerMonitorRead(t *testing.T) {
 
 	c.FakeServerMonitors.On("Get", "123").Return(&api.ServerMonitor{}, nil).Once()
 
-	require.NoError(t, serverMonitorRead(d, c))
+	require.Nil(t, serverMonitorRead(context.Background(), d, c))
 
 	c.FakeServerMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := serverMonitorRead(d, c)
+	err := serverMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestServerMonitorDelete(t *testing.T) {
@@ -139,39 +141,23 @@ func TestServerMonitorDelete(t *testing.T) {
 
 	c.FakeServerMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, serverMonitorDelete(d, c))
+	require.Nil(t, serverMonitorDelete(context.Background(), d, c))
 
 	c.FakeServerMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, serverMonitorDelete(d, c))
+	require.Nil(t, serverMonitorDelete(context.Background(), d, c))
 }
 
-func TestServerMonitorExists(t *testing.T) {
+func TestServerMonitorReadNotFound(t *testing.T) {
 	d := serverTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeServerMonitors.On("Get", "123").Return(&api.ServerMonitor{}, nil).Once()
-
-	exists, err := serverMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeServerMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = serverMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeServerMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = serverMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, serverMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func serverTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,13 +1,15 @@
 package monitors
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -229,29 +231,28 @@ var SOAPMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7SOAPMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: soapMonitorCreate,
-		Read:   soapMonitorRead,
-		Update: soapMonitorUpdate,
-		Delete: soapMonitorDelete,
-		Exists: soapMonitorExists,
+		CreateContext: soapMonitorCreate,
+		ReadContext:   soapMonitorRead,
+		UpdateContext: soapMonitorUpdate,
+		DeleteContext: soapMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.SOAP),
 		},
 
 		Schema: SOAPMonitorSchema,
 	}
 }
 
-func soapMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func soapMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 	log.Println("***********************************Inside soap monitor creation*******************************************")
 	soapMonitor, err := resourceDataToSOAPMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	soapMonitor, err = client.SOAPMonitors().Create(soapMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(soapMonitor.MonitorID)
@@ -259,13 +260,17 @@ func soapMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func soapMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func soapMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	soapMonitor, err := client.SOAPMonitors().Get(d.Id())
 
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateSOAPMonitorResourceData(d, soapMonitor)
@@ -273,18 +278,18 @@ func soapMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func soapMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func soapMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
-	client := meta.(site24x7.Client)
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	soapMonitor, err := resourceDataToSOAPMonitor(d, client)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	soapMonitor, err = client.SOAPMonitors().Update(soapMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(soapMonitor.MonitorID)
@@ -292,30 +297,15 @@ func soapMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func soapMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func soapMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.PINGMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func soapMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.SOAPMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToSOAPMonitor(d *schema.ResourceData, client site24x7.Client) (*api.SOAPMonitor, error) {
@@ -327,7 +317,7 @@ func resourceDataToSOAPMonitor(d *schema.ResourceData, client site24x7.Client) (
 		}
 	}
 	sort.Strings(monitorGroups)
-	
+
 	var userGroupIDs []string
 	for _, id := range d.Get("user_group_ids").([]interface{}) {
 		if id != nil {
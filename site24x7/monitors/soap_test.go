@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -109,13 +111,13 @@ func TestSOAPMonitorCreate(t *testing.T) {
 
 	c.FakeSOAPMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, soapMonitorCreate(d, c))
+	require.Nil(t, soapMonitorCreate(context.Background(), d, c))
 
 	c.FakeSOAPMonitors.On("Create	", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := soapMonitorCreate(d, c)
+	err := soapMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSOAPMonitorUpdate(t *testing.T) {
@@ -217,13 +219,13 @@ func TestSOAPMonitorUpdate(t *testing.T) {
 
 	c.FakeSOAPMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, soapMonitorUpdate(d, c))
+	require.Nil(t, soapMonitorUpdate(context.Background(), d, c))
 
 	c.FakeSOAPMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := soapMonitorUpdate(d, c)
+	err := soapMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSOAPMonitorRead(t *testing.T) {
@@ -234,13 +236,13 @@ func TestSOAPMonitorRead(t *testing.T) {
 
 	c.FakeSOAPMonitors.On("Get", "123").Return(&api.SOAPMonitor{}, nil).Once()
 
-	require.NoError(t, soapMonitorRead(d, c))
+	require.Nil(t, soapMonitorRead(context.Background(), d, c))
 
 	c.FakeSOAPMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := soapMonitorRead(d, c)
+	err := soapMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSOAPMonitorDelete(t *testing.T) {
@@ -251,39 +253,23 @@ func TestSOAPMonitorDelete(t *testing.T) {
 
 	c.FakeSOAPMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, soapMonitorDelete(d, c))
+	require.Nil(t, soapMonitorDelete(context.Background(), d, c))
 
 	c.FakeSOAPMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, soapMonitorDelete(d, c))
+	require.Nil(t, soapMonitorDelete(context.Background(), d, c))
 }
 
-func TestSOAPMonitorExists(t *testing.T) {
+func TestSOAPMonitorReadNotFound(t *testing.T) {
 	d := soapMonitorTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeSOAPMonitors.On("Get", "123").Return(&api.SOAPMonitor{}, nil).Once()
-
-	exists, err := soapMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeSOAPMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = soapMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeSOAPMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = soapMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, soapMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func soapMonitorTestResourceData(t *testing.T) *schema.ResourceData {
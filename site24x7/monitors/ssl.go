@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"sort"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -147,30 +149,29 @@ var SSLMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7SSLMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: sslMonitorCreate,
-		Read:   sslMonitorRead,
-		Update: sslMonitorUpdate,
-		Delete: sslMonitorDelete,
-		Exists: sslMonitorExists,
+		CreateContext: sslMonitorCreate,
+		ReadContext:   sslMonitorRead,
+		UpdateContext: sslMonitorUpdate,
+		DeleteContext: sslMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.SSL_CERT),
 		},
 
 		Schema: SSLMonitorSchema,
 	}
 }
 
-func sslMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func sslMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	sslMonitor, err := resourceDataToSSLMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sslMonitor, err = client.SSLMonitors().Create(sslMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(sslMonitor.MonitorID)
@@ -178,12 +179,16 @@ func sslMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func sslMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func sslMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	sslMonitor, err := client.SSLMonitors().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateSSLMonitorResourceData(d, sslMonitor)
@@ -191,17 +196,17 @@ func sslMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func sslMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func sslMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	sslMonitor, err := resourceDataToSSLMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	sslMonitor, err = client.SSLMonitors().Update(sslMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(sslMonitor.MonitorID)
@@ -210,30 +215,15 @@ func sslMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func sslMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func sslMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.SSLMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func sslMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.SSLMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToSSLMonitor(d *schema.ResourceData, client site24x7.Client) (*api.SSLMonitor, error) {
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -94,13 +96,13 @@ func TestSSLMonitorCreate(t *testing.T) {
 
 	c.FakeSSLMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, sslMonitorCreate(d, c))
+	require.Nil(t, sslMonitorCreate(context.Background(), d, c))
 
 	c.FakeSSLMonitors.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := sslMonitorCreate(d, c)
+	err := sslMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSSLMonitorUpdate(t *testing.T) {
@@ -190,13 +192,13 @@ func TestSSLMonitorUpdate(t *testing.T) {
 
 	c.FakeSSLMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, sslMonitorUpdate(d, c))
+	require.Nil(t, sslMonitorUpdate(context.Background(), d, c))
 
 	c.FakeSSLMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := sslMonitorUpdate(d, c)
+	err := sslMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSSLMonitorRead(t *testing.T) {
@@ -207,13 +209,13 @@ func TestSSLMonitorRead(t *testing.T) {
 
 	c.FakeSSLMonitors.On("Get", "123").Return(&api.SSLMonitor{}, nil).Once()
 
-	require.NoError(t, sslMonitorRead(d, c))
+	require.Nil(t, sslMonitorRead(context.Background(), d, c))
 
 	c.FakeSSLMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := sslMonitorRead(d, c)
+	err := sslMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSSLMonitorDelete(t *testing.T) {
@@ -224,39 +226,23 @@ func TestSSLMonitorDelete(t *testing.T) {
 
 	c.FakeSSLMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, sslMonitorDelete(d, c))
+	require.Nil(t, sslMonitorDelete(context.Background(), d, c))
 
 	c.FakeSSLMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, sslMonitorDelete(d, c))
+	require.Nil(t, sslMonitorDelete(context.Background(), d, c))
 }
 
-func TestSSLMonitorExists(t *testing.T) {
+func TestSSLMonitorReadNotFound(t *testing.T) {
 	d := sslTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeSSLMonitors.On("Get", "123").Return(&api.SSLMonitor{}, nil).Once()
-
-	exists, err := sslMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeSSLMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = sslMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeSSLMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = sslMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, sslMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func sslTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,11 +1,13 @@
 package monitors
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -324,30 +326,29 @@ var webPageSpeedMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7WebPageSpeedMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: webPageSpeedMonitorCreate,
-		Read:   webPageSpeedMonitorRead,
-		Update: webPageSpeedMonitorUpdate,
-		Delete: webPageSpeedMonitorDelete,
-		Exists: webPageSpeedMonitorExists,
+		CreateContext: webPageSpeedMonitorCreate,
+		ReadContext:   webPageSpeedMonitorRead,
+		UpdateContext: webPageSpeedMonitorUpdate,
+		DeleteContext: webPageSpeedMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.HOMEPAGE),
 		},
 
 		Schema: webPageSpeedMonitorSchema,
 	}
 }
 
-func webPageSpeedMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webPageSpeedMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	webPageSpeedMonitor, err := resourceDataToWebPageSpeedMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	webPageSpeedMonitor, err = client.WebPageSpeedMonitors().Create(webPageSpeedMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(webPageSpeedMonitor.MonitorID)
@@ -356,12 +357,16 @@ func webPageSpeedMonitorCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func webPageSpeedMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webPageSpeedMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	webPageSpeedMonitor, err := client.WebPageSpeedMonitors().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateWebPageSpeedMonitorResourceData(d, webPageSpeedMonitor)
@@ -369,17 +374,17 @@ func webPageSpeedMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func webPageSpeedMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webPageSpeedMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	webPageSpeedMonitor, err := resourceDataToWebPageSpeedMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	webPageSpeedMonitor, err = client.WebPageSpeedMonitors().Update(webPageSpeedMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(webPageSpeedMonitor.MonitorID)
@@ -388,30 +393,15 @@ func webPageSpeedMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func webPageSpeedMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webPageSpeedMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.WebPageSpeedMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func webPageSpeedMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.WebPageSpeedMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToWebPageSpeedMonitor(d *schema.ResourceData, client site24x7.Client) (*api.WebPageSpeedMonitor, error) {
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -21,7 +23,7 @@ func TestWebPageSpeedMonitorCreate(t *testing.T) {
 		Type:                  "HOMEPAGE",
 		Website:               "www.test.tld",
 		BrowserType:           1,
-		BrowserVersion:		   83,
+		BrowserVersion:        83,
 		WebsiteType:           1,
 		DeviceType:            "1",
 		WPAResolution:         "1024,768",
@@ -121,13 +123,13 @@ func TestWebPageSpeedMonitorCreate(t *testing.T) {
 
 	c.FakeWebPageSpeedMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, webPageSpeedMonitorCreate(d, c))
+	require.Nil(t, webPageSpeedMonitorCreate(context.Background(), d, c))
 
 	c.FakeWebPageSpeedMonitors.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := webPageSpeedMonitorCreate(d, c)
+	err := webPageSpeedMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebPageSpeedMonitorUpdate(t *testing.T) {
@@ -141,7 +143,7 @@ func TestWebPageSpeedMonitorUpdate(t *testing.T) {
 		DisplayName:           "foo",
 		Type:                  "HOMEPAGE",
 		BrowserType:           1,
-		BrowserVersion:		   83,
+		BrowserVersion:        83,
 		WebsiteType:           1,
 		DeviceType:            "1",
 		WPAResolution:         "1024,768",
@@ -242,13 +244,13 @@ func TestWebPageSpeedMonitorUpdate(t *testing.T) {
 
 	c.FakeWebPageSpeedMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, webPageSpeedMonitorUpdate(d, c))
+	require.Nil(t, webPageSpeedMonitorUpdate(context.Background(), d, c))
 
 	c.FakeWebPageSpeedMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := webPageSpeedMonitorUpdate(d, c)
+	err := webPageSpeedMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebPageSpeedMonitorRead(t *testing.T) {
@@ -259,13 +261,13 @@ func TestWebPageSpeedMonitorRead(t *testing.T) {
 
 	c.FakeWebPageSpeedMonitors.On("Get", "123").Return(&api.WebPageSpeedMonitor{}, nil).Once()
 
-	require.NoError(t, webPageSpeedMonitorRead(d, c))
+	require.Nil(t, webPageSpeedMonitorRead(context.Background(), d, c))
 
 	c.FakeWebPageSpeedMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := webPageSpeedMonitorRead(d, c)
+	err := webPageSpeedMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebPageSpeedMonitorDelete(t *testing.T) {
@@ -276,39 +278,23 @@ func TestWebPageSpeedMonitorDelete(t *testing.T) {
 
 	c.FakeWebPageSpeedMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, webPageSpeedMonitorDelete(d, c))
+	require.Nil(t, webPageSpeedMonitorDelete(context.Background(), d, c))
 
 	c.FakeWebPageSpeedMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, webPageSpeedMonitorDelete(d, c))
+	require.Nil(t, webPageSpeedMonitorDelete(context.Background(), d, c))
 }
 
-func TestWebPageSpeedMonitorExists(t *testing.T) {
+func TestWebPageSpeedMonitorReadNotFound(t *testing.T) {
 	d := webPageSpeedMonitorTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeWebPageSpeedMonitors.On("Get", "123").Return(&api.WebPageSpeedMonitor{}, nil).Once()
-
-	exists, err := webPageSpeedMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeWebPageSpeedMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = webPageSpeedMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeWebPageSpeedMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = webPageSpeedMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, webPageSpeedMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func webPageSpeedMonitorTestResourceData(t *testing.T) *schema.ResourceData {
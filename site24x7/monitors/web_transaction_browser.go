@@ -1,6 +1,7 @@
 package monitors
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -8,7 +9,8 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -138,41 +140,15 @@ var WebTransactionBrowserMonitorSchema = map[string]*schema.Schema{
 	// 	Description: "Parallel polling option",
 	// },
 	"proxy_details": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"webProxyUrl": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-				"webProxyUname": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-				"webProxyPass": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Check for the proxy in the website response.",
 	},
 	"auth_details": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"username": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-				"password": {
-					Type:     schema.TypeString,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Check for the auth details in the website response.",
 	},
 	"cookies": {
@@ -253,31 +229,30 @@ var WebTransactionBrowserMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7WebTransactionBrowserMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: webTransactionBrowserMonitorCreate,
-		Read:   webTransactionBrowserMonitorRead,
-		Update: webTransactionBrowserMonitorUpdate,
-		Delete: webTransactionBrowserMonitorDelete,
-		Exists: webTransactionBrowserMonitorExists,
+		CreateContext: webTransactionBrowserMonitorCreate,
+		ReadContext:   webTransactionBrowserMonitorRead,
+		UpdateContext: webTransactionBrowserMonitorUpdate,
+		DeleteContext: webTransactionBrowserMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.REALBROWSER),
 		},
 
 		Schema: WebTransactionBrowserMonitorSchema,
 	}
 }
 
-func webTransactionBrowserMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webTransactionBrowserMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	webTransactionBrowserMonitor, err := resourceDataToWebTransactionBrowserMonitorCreate(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	webTransactionBrowserMonitor, err = client.WebTransactionBrowserMonitors().Create(webTransactionBrowserMonitor)
 	log.Println("GetTokenURL : ", webTransactionBrowserMonitor.AsyncDCEnabled)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(webTransactionBrowserMonitor.MonitorID)
@@ -286,13 +261,17 @@ func webTransactionBrowserMonitorCreate(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func webTransactionBrowserMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webTransactionBrowserMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	webTransactionBrowserMonitor, err := client.WebTransactionBrowserMonitors().Get(d.Id())
 
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateWebTransactionBrowserMonitorResourceData(d, webTransactionBrowserMonitor)
@@ -300,13 +279,13 @@ func webTransactionBrowserMonitorRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func webTransactionBrowserMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webTransactionBrowserMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	webTransactionBrowserMonitor, err := resourceDataToWebTransactionBrowserMonitorCreate(d, client)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	webTransactionBrowserMonitor.SeleniumScript = ""
@@ -314,7 +293,7 @@ func webTransactionBrowserMonitorUpdate(d *schema.ResourceData, meta interface{}
 
 	webTransactionBrowserMonitor, err = client.WebTransactionBrowserMonitors().Update(webTransactionBrowserMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(webTransactionBrowserMonitor.MonitorID)
@@ -323,30 +302,15 @@ func webTransactionBrowserMonitorUpdate(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func webTransactionBrowserMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func webTransactionBrowserMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.WebTransactionBrowserMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func webTransactionBrowserMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.WebTransactionBrowserMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToWebTransactionBrowserMonitorCreate(d *schema.ResourceData, client site24x7.Client) (*api.WebTransactionBrowserMonitor, error) {
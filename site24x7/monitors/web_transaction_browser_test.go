@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -96,13 +98,13 @@ func TestWebTransactionBrowserMonitorCreate(t *testing.T) {
 
 	c.FakeWebTransactionBrowserMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, webTransactionBrowserMonitorCreate(d, c))
+	require.Nil(t, webTransactionBrowserMonitorCreate(context.Background(), d, c))
 
 	c.FakeWebTransactionBrowserMonitors.On("Create	", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := webTransactionBrowserMonitorCreate(d, c)
+	err := webTransactionBrowserMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebTransactionBrowserMonitorUpdate(t *testing.T) {
@@ -191,13 +193,13 @@ func TestWebTransactionBrowserMonitorUpdate(t *testing.T) {
 
 	c.FakeWebTransactionBrowserMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, webTransactionBrowserMonitorUpdate(d, c))
+	require.Nil(t, webTransactionBrowserMonitorUpdate(context.Background(), d, c))
 
 	c.FakeWebTransactionBrowserMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := webTransactionBrowserMonitorUpdate(d, c)
+	err := webTransactionBrowserMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebTransactionBrowserMonitorRead(t *testing.T) {
@@ -208,13 +210,13 @@ func TestWebTransactionBrowserMonitorRead(t *testing.T) {
 
 	c.FakeWebTransactionBrowserMonitors.On("Get", "123").Return(&api.DomainExpiryMonitor{}, nil).Once()
 
-	require.NoError(t, webTransactionBrowserMonitorRead(d, c))
+	require.Nil(t, webTransactionBrowserMonitorRead(context.Background(), d, c))
 
 	c.FakeWebTransactionBrowserMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := webTransactionBrowserMonitorRead(d, c)
+	err := webTransactionBrowserMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebTransactionBrowserMonitorDelete(t *testing.T) {
@@ -225,39 +227,23 @@ func TestWebTransactionBrowserMonitorDelete(t *testing.T) {
 
 	c.FakeWebTransactionBrowserMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, webTransactionBrowserMonitorDelete(d, c))
+	require.Nil(t, webTransactionBrowserMonitorDelete(context.Background(), d, c))
 
 	c.FakeWebTransactionBrowserMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, webTransactionBrowserMonitorDelete(d, c))
+	require.Nil(t, webTransactionBrowserMonitorDelete(context.Background(), d, c))
 }
 
-func TestWebTransactionBrowserMonitorExists(t *testing.T) {
+func TestWebTransactionBrowserMonitorReadNotFound(t *testing.T) {
 	d := webTransactionBrowserMonitorTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeWebTransactionBrowserMonitors.On("Get", "123").Return(&api.WebTransactionBrowserMonitor{}, nil).Once()
-
-	exists, err := webTransactionBrowserMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeWebTransactionBrowserMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = webTransactionBrowserMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeWebTransactionBrowserMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = webTransactionBrowserMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, webTransactionBrowserMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func webTransactionBrowserMonitorTestResourceData(t *testing.T) *schema.ResourceData {
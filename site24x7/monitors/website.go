@@ -1,12 +1,14 @@
 package monitors
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -383,52 +385,55 @@ var websiteMonitorSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7WebsiteMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: websiteMonitorCreate,
-		Read:   websiteMonitorRead,
-		Update: websiteMonitorUpdate,
-		Delete: websiteMonitorDelete,
-		Exists: websiteMonitorExists,
+		CreateContext: websiteMonitorCreate,
+		ReadContext:   websiteMonitorRead,
+		UpdateContext: websiteMonitorUpdate,
+		DeleteContext: websiteMonitorDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: site24x7.ImportMonitorByName(api.URL),
 		},
 
 		Schema: websiteMonitorSchema,
 	}
 }
 
-func websiteMonitorCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func websiteMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	websiteMonitor, err := resourceDataToWebsiteMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	websiteMonitor, err = client.WebsiteMonitors().Create(websiteMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 	d.SetId(websiteMonitor.MonitorID)
 
 	desiredState := d.Get("state").(int)
 	if desiredState == 5 {
 		if err := client.WebsiteMonitors().Suspend(websiteMonitor.MonitorID); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if desiredState == 0 {
 		if err := client.WebsiteMonitors().Activate(websiteMonitor.MonitorID); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func websiteMonitorRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func websiteMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	websiteMonitor, err := client.WebsiteMonitors().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateWebsiteMonitorResourceData(d, websiteMonitor)
@@ -436,56 +441,41 @@ func websiteMonitorRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func websiteMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func websiteMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 	websiteMonitor, err := resourceDataToWebsiteMonitor(d, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	websiteMonitor, err = client.WebsiteMonitors().Update(websiteMonitor)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 	d.SetId(websiteMonitor.MonitorID)
 
 	desiredState := d.Get("state").(int)
 	if desiredState == 5 {
 		if err := client.WebsiteMonitors().Suspend(websiteMonitor.MonitorID); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	} else if desiredState == 0 {
 		if err := client.WebsiteMonitors().Activate(websiteMonitor.MonitorID); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func websiteMonitorDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func websiteMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	err := client.WebsiteMonitors().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func websiteMonitorExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.WebsiteMonitors().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToWebsiteMonitor(d *schema.ResourceData, client site24x7.Client) (*api.WebsiteMonitor, error) {
@@ -1,9 +1,11 @@
 package monitors
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -120,13 +122,13 @@ func TestWebsiteMonitorCreate(t *testing.T) {
 
 	c.FakeWebsiteMonitors.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, websiteMonitorCreate(d, c))
+	require.Nil(t, websiteMonitorCreate(context.Background(), d, c))
 
 	c.FakeWebsiteMonitors.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := websiteMonitorCreate(d, c)
+	err := websiteMonitorCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebsiteMonitorUpdate(t *testing.T) {
@@ -240,13 +242,13 @@ func TestWebsiteMonitorUpdate(t *testing.T) {
 
 	c.FakeWebsiteMonitors.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, websiteMonitorUpdate(d, c))
+	require.Nil(t, websiteMonitorUpdate(context.Background(), d, c))
 
 	c.FakeWebsiteMonitors.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := websiteMonitorUpdate(d, c)
+	err := websiteMonitorUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebsiteMonitorRead(t *testing.T) {
@@ -257,13 +259,13 @@ func TestWebsiteMonitorRead(t *testing.T) {
 
 	c.FakeWebsiteMonitors.On("Get", "123").Return(&api.WebsiteMonitor{}, nil).Once()
 
-	require.NoError(t, websiteMonitorRead(d, c))
+	require.Nil(t, websiteMonitorRead(context.Background(), d, c))
 
 	c.FakeWebsiteMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := websiteMonitorRead(d, c)
+	err := websiteMonitorRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestWebsiteMonitorDelete(t *testing.T) {
@@ -274,39 +276,23 @@ func TestWebsiteMonitorDelete(t *testing.T) {
 
 	c.FakeWebsiteMonitors.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, websiteMonitorDelete(d, c))
+	require.Nil(t, websiteMonitorDelete(context.Background(), d, c))
 
 	c.FakeWebsiteMonitors.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, websiteMonitorDelete(d, c))
+	require.Nil(t, websiteMonitorDelete(context.Background(), d, c))
 }
 
-func TestWebsiteMonitorExists(t *testing.T) {
+func TestWebsiteMonitorReadNotFound(t *testing.T) {
 	d := websiteMonitorTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeWebsiteMonitors.On("Get", "123").Return(&api.WebsiteMonitor{}, nil).Once()
-
-	exists, err := websiteMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeWebsiteMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = websiteMonitorExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeWebsiteMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = websiteMonitorExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, websiteMonitorRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func websiteMonitorTestResourceData(t *testing.T) *schema.ResourceData {
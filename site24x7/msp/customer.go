@@ -1,7 +1,10 @@
 package msp
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
@@ -94,25 +97,24 @@ var CustomerSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7Customer() *schema.Resource {
 	return &schema.Resource{
-		Create: customerCreate,
-		Read:   customerRead,
-		Update: customerUpdate,
-		Delete: customerDelete,
-		Exists: customerExists,
+		CreateContext: customerCreate,
+		ReadContext:   customerRead,
+		UpdateContext: customerUpdate,
+		DeleteContext: customerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: CustomerSchema,
 	}
 }
 
-func customerCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func customerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	customer := resourceDataToCustomer(d)
 	customer, err := client.Customers().Create(customer)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(customer.UserID)
@@ -120,12 +122,16 @@ func customerCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func customerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func customerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	customer, err := client.Customers().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateCustomerResourceData(d, customer)
@@ -133,14 +139,14 @@ func customerRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func customerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func customerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	customer := resourceDataToCustomer(d)
 	// log.Printf("[DEBUG] Customer before update: %+v", customer)
 	customer, err := client.Customers().Update(customer)
 	if err != nil {
-		return err
+		return site24x7.APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(customer.UserID)
@@ -148,26 +154,11 @@ func customerUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func customerDelete(d *schema.ResourceData, meta interface{}) error {
+func customerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	return nil
 }
 
-func customerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(site24x7.Client)
-
-	_, err := client.Customers().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
-}
-
 // Mapping Terraform ResourceData to API Customer struct
 func resourceDataToCustomer(d *schema.ResourceData) *api.Customer {
 	var customerGroups []string
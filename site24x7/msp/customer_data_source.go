@@ -1,10 +1,12 @@
 package msp
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	"github.com/site24x7/terraform-provider-site24x7/site24x7"
 )
@@ -51,18 +53,18 @@ var customerDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7Customer() *schema.Resource {
 	return &schema.Resource{
-		Read:   customerDataSourceRead,
-		Schema: customerDataSourceSchema,
+		ReadContext: customerDataSourceRead,
+		Schema:      customerDataSourceSchema,
 	}
 }
 
 // customerDataSourceRead fetches customers from Site24x7 based on name_regex
-func customerDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(site24x7.Client)
+func customerDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := site24x7.WithContext(ctx, meta.(site24x7.Client))
 
 	customerList, err := client.Customers().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nameRegex := d.Get("name_regex").(string)
@@ -80,11 +82,11 @@ func customerDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		return errors.New("Please enter a value for the attribute name_regex!")
+		return diag.FromErr(errors.New("Please enter a value for the attribute name_regex!"))
 	}
 
 	if customer == nil {
-		return errors.New("Unable to find customer matching the name: \"" + nameRegex + "\"")
+		return diag.FromErr(errors.New("Unable to find customer matching the name: \"" + nameRegex + "\""))
 	}
 
 	updateCustomerDataSourceResourceData(d, customer, matchingCustomerIDs, matchingCustomerIDsAndNames)
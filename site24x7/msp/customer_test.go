@@ -1,9 +1,11 @@
 package msp
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -36,13 +38,13 @@ func TestCustomerCreate(t *testing.T) {
 
 	c.FakeCustomer.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, customerCreate(d, c))
+	require.Nil(t, customerCreate(context.Background(), d, c))
 
 	c.FakeCustomer.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := customerCreate(d, c)
+	err := customerCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestCustomerUpdate(t *testing.T) {
@@ -72,13 +74,13 @@ func TestCustomerUpdate(t *testing.T) {
 
 	c.FakeCustomer.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, customerUpdate(d, c))
+	require.Nil(t, customerUpdate(context.Background(), d, c))
 
 	c.FakeCustomer.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := customerUpdate(d, c)
+	err := customerUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestCustomerRead(t *testing.T) {
@@ -89,41 +91,25 @@ func TestCustomerRead(t *testing.T) {
 
 	c.FakeCustomer.On("Get", "123").Return(&api.Customer{}, nil).Once()
 
-	require.NoError(t, customerRead(d, c))
+	require.Nil(t, customerRead(context.Background(), d, c))
 
 	c.FakeCustomer.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := customerRead(d, c)
+	err := customerRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
-func TestCustomerExists(t *testing.T) {
+func TestCustomerReadNotFound(t *testing.T) {
 	d := customerTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeCustomer.On("Get", "123").Return(&api.Customer{}, nil).Once()
-
-	exists, err := customerExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeCustomer.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = customerExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeCustomer.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = customerExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, customerRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func customerTestResourceData(t *testing.T) *schema.ResourceData {
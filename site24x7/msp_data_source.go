@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 )
 
@@ -45,18 +47,18 @@ var mspDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7MSP() *schema.Resource {
 	return &schema.Resource{
-		Read:   mspDataSourceRead,
-		Schema: mspDataSourceSchema,
+		ReadContext: mspDataSourceRead,
+		Schema:      mspDataSourceSchema,
 	}
 }
 
 // mspDataSourceRead fetches all msp from Site24x7
-func mspDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func mspDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	mspList, err := client.MSP().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nameRegex := d.Get("customer_name_regex")
@@ -85,11 +87,11 @@ func mspDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		return errors.New("Please enter a value for the attribute customer_name_regex!")
+		return diag.FromErr(errors.New("Please enter a value for the attribute customer_name_regex!"))
 	}
 
 	if mspCustomer == nil {
-		return errors.New("Unable to find MSP customer matching the name : \"" + d.Get("customer_name_regex").(string))
+		return diag.FromErr(errors.New("Unable to find MSP customer matching the name : \"" + d.Get("customer_name_regex").(string)))
 	}
 	updateMSPDataSourceResourceData(d, mspCustomer, matchingZAAIDs, matchingZAAIDsAndNames)
 
@@ -1,8 +1,11 @@
 package site24x7
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 )
@@ -185,26 +188,25 @@ var NotificationProfileSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7NotificationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: notificationProfileCreate,
-		Read:   notificationProfileRead,
-		Update: notificationProfileUpdate,
-		Delete: notificationProfileDelete,
-		Exists: notificationProfileExists,
+		CreateContext: notificationProfileCreate,
+		ReadContext:   notificationProfileRead,
+		UpdateContext: notificationProfileUpdate,
+		DeleteContext: notificationProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: ImportByName("notification profile", findNotificationProfiles),
 		},
 		Schema: NotificationProfileSchema,
 	}
 }
 
-func notificationProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func notificationProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	notificationProfile := resourceDataToNotificationProfile(d)
 
 	notificationProfile, err := client.NotificationProfiles().Create(notificationProfile)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(notificationProfile.ProfileID)
@@ -212,12 +214,16 @@ func notificationProfileCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func notificationProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func notificationProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	notificationProfile, err := client.NotificationProfiles().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateNotificationProfileResourceData(d, notificationProfile)
@@ -225,14 +231,14 @@ func notificationProfileRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func notificationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func notificationProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	notificationProfile := resourceDataToNotificationProfile(d)
 
 	notificationProfile, err := client.NotificationProfiles().Update(notificationProfile)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(notificationProfile.ProfileID)
@@ -240,30 +246,15 @@ func notificationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func notificationProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func notificationProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	err := client.NotificationProfiles().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func notificationProfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(Client)
-
-	_, err := client.NotificationProfiles().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToNotificationProfile(d *schema.ResourceData) *api.NotificationProfile {
@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 )
 
@@ -56,18 +58,18 @@ var notificationProfileDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7NotificationProfile() *schema.Resource {
 	return &schema.Resource{
-		Read:   notificationProfileDataSourceRead,
-		Schema: notificationProfileDataSourceSchema,
+		ReadContext: notificationProfileDataSourceRead,
+		Schema:      notificationProfileDataSourceSchema,
 	}
 }
 
 // notificationProfileDataSourceRead fetches all notificationProfile from Site24x7
-func notificationProfileDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func notificationProfileDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	notificationProfileList, err := client.NotificationProfiles().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nameRegex := d.Get("name_regex")
@@ -98,11 +100,11 @@ func notificationProfileDataSourceRead(d *schema.ResourceData, meta interface{})
 			}
 		}
 	} else {
-		return errors.New("Please enter a value for the attribute name_regex!")
+		return diag.FromErr(errors.New("Please enter a value for the attribute name_regex!"))
 	}
 
 	if notificationProfile == nil {
-		return errors.New("Unable to find notification profile matching the name : \"" + d.Get("name_regex").(string))
+		return diag.FromErr(errors.New("Unable to find notification profile matching the name : \"" + d.Get("name_regex").(string)))
 	}
 
 	updateNotificationProfileDataSourceResourceData(d, notificationProfile, matchingNotificationProfileIDs, matchingNotificationProfileIDsAndNames)
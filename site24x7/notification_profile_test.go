@@ -1,9 +1,11 @@
 package site24x7
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -33,13 +35,13 @@ func TestNotificationProfileCreate(t *testing.T) {
 
 	c.FakeNotificationProfiles.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, notificationProfileCreate(d, c))
+	require.Nil(t, notificationProfileCreate(context.Background(), d, c))
 
 	c.FakeNotificationProfiles.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := notificationProfileCreate(d, c)
+	err := notificationProfileCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestNotificationProfileUpdate(t *testing.T) {
@@ -58,13 +60,13 @@ func TestNotificationProfileUpdate(t *testing.T) {
 
 	c.FakeNotificationProfiles.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, notificationProfileUpdate(d, c))
+	require.Nil(t, notificationProfileUpdate(context.Background(), d, c))
 
 	c.FakeNotificationProfiles.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := notificationProfileUpdate(d, c)
+	err := notificationProfileUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestNotificationProfileRead(t *testing.T) {
@@ -75,13 +77,13 @@ func TestNotificationProfileRead(t *testing.T) {
 
 	c.FakeNotificationProfiles.On("Get", "123").Return(&api.NotificationProfile{}, nil).Once()
 
-	require.NoError(t, notificationProfileRead(d, c))
+	require.Nil(t, notificationProfileRead(context.Background(), d, c))
 
 	c.FakeNotificationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := notificationProfileRead(d, c)
+	err := notificationProfileRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestNotificationProfileDelete(t *testing.T) {
@@ -92,39 +94,23 @@ func TestNotificationProfileDelete(t *testing.T) {
 
 	c.FakeNotificationProfiles.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, notificationProfileDelete(d, c))
+	require.Nil(t, notificationProfileDelete(context.Background(), d, c))
 
 	c.FakeNotificationProfiles.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, notificationProfileDelete(d, c))
+	require.Nil(t, notificationProfileDelete(context.Background(), d, c))
 }
 
-func TestNotificationProfileExists(t *testing.T) {
+func TestNotificationProfileReadNotFound(t *testing.T) {
 	d := notificationProfileTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeNotificationProfiles.On("Get", "123").Return(&api.NotificationProfile{}, nil).Once()
-
-	exists, err := notificationProfileExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeNotificationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = notificationProfileExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeNotificationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = notificationProfileExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, notificationProfileRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func notificationProfileTestResourceData(t *testing.T) *schema.ResourceData {
@@ -0,0 +1,66 @@
+package site24x7
+
+import (
+	"encoding/json"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// monitorSnapshots serves Get calls for monitors of all types from a single
+// GET /monitors call. The list is fetched on the first Get and kept undecoded,
+// each monitor is decoded into the requested type when it is read. Each
+// monitor is served from the snapshot at most once, so repeated reads of the
+// same monitor, e.g. after it was updated, always hit the API. Monitors
+// missing from the snapshot are fetched individually. A nil *monitorSnapshots
+// disables prefetching.
+type monitorSnapshots struct {
+	mu       sync.Mutex
+	loaded   bool
+	monitors map[string]json.RawMessage
+}
+
+func newMonitorSnapshots() *monitorSnapshots {
+	return &monitorSnapshots{
+		monitors: make(map[string]json.RawMessage),
+	}
+}
+
+// take removes the monitor with monitorID from the snapshot and returns it.
+// list is called to fill the snapshot on first use.
+func (s *monitorSnapshots) take(monitorID string, list func() ([]json.RawMessage, error)) (json.RawMessage, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loaded {
+		s.loaded = true
+
+		monitors, err := list()
+		if err != nil {
+			log.Warnf("Unable to prefetch monitors, falling back to individual reads: %v", err)
+		}
+
+		for _, monitor := range monitors {
+			var id struct {
+				MonitorID string `json:"monitor_id"`
+			}
+			if err := json.Unmarshal(monitor, &id); err == nil && id.MonitorID != "" {
+				s.monitors[id.MonitorID] = monitor
+			}
+		}
+	}
+
+	monitor, ok := s.monitors[monitorID]
+	delete(s.monitors, monitorID)
+
+	return monitor, ok
+}
+
+// forget drops the monitor with monitorID from the snapshot. It must be called
+// after the monitor was modified.
+func (s *monitorSnapshots) forget(monitorID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.monitors, monitorID)
+}
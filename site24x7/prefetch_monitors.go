@@ -0,0 +1,162 @@
+package site24x7
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/site24x7/terraform-provider-site24x7/api/endpoints/monitors"
+	"github.com/site24x7/terraform-provider-site24x7/rest"
+)
+
+// monitorEndpoint is the method set shared by the endpoints of all monitor
+// types, M being the monitor type.
+type monitorEndpoint[M any] interface {
+	Get(monitorID string) (*M, error)
+	GetContext(ctx context.Context, monitorID string) (*M, error)
+	Create(monitor *M) (*M, error)
+	CreateContext(ctx context.Context, monitor *M) (*M, error)
+	Update(monitor *M) (*M, error)
+	UpdateContext(ctx context.Context, monitor *M) (*M, error)
+	Delete(monitorID string) error
+	DeleteContext(ctx context.Context, monitorID string) error
+	List() ([]*M, error)
+	ListContext(ctx context.Context) ([]*M, error)
+	Activate(monitorID string) error
+	ActivateContext(ctx context.Context, monitorID string) error
+	Suspend(monitorID string) error
+	SuspendContext(ctx context.Context, monitorID string) error
+}
+
+// prefetchMonitors adds prefetching to the monitor endpoint m. Get calls are
+// served from the snapshots s, which are filled with a single GET /monitors
+// call made with client. Monitors are dropped from the snapshots when they
+// are modified. idOf returns the ID of a monitor. If prefetching is disabled,
+// i.e. s is nil, m is returned unwrapped.
+func prefetchMonitors[M any](s *monitorSnapshots, client rest.Client, m monitorEndpoint[M], idOf func(*M) string) monitorEndpoint[M] {
+	if s == nil {
+		return m
+	}
+
+	return &prefetchedMonitors[M]{
+		monitorEndpoint: m,
+		snapshots:       s,
+		client:          client,
+		idOf:            idOf,
+	}
+}
+
+type prefetchedMonitors[M any] struct {
+	monitorEndpoint[M]
+	snapshots *monitorSnapshots
+	client    rest.Client
+	idOf      func(*M) string
+}
+
+// listMonitors fetches all monitors, regardless of their type, without
+// decoding them.
+func listMonitors(client rest.Client) ([]json.RawMessage, error) {
+	var monitors []json.RawMessage
+	err := client.
+		Get().
+		Resource("monitors").
+		Do().
+		Parse(&monitors)
+
+	return monitors, err
+}
+
+// get returns the monitor with monitorID from the snapshots, decoded into M.
+// It falls back to calling get if the monitor is not part of the snapshots.
+func (c *prefetchedMonitors[M]) get(client rest.Client, monitorID string, get func(string) (*M, error)) (*M, error) {
+	raw, ok := c.snapshots.take(monitorID, func() ([]json.RawMessage, error) {
+		return listMonitors(client)
+	})
+	if ok {
+		monitor := new(M)
+		if err := json.Unmarshal(raw, monitor); err == nil {
+			return monitor, nil
+		}
+	}
+
+	return get(monitorID)
+}
+
+func (c *prefetchedMonitors[M]) Get(monitorID string) (*M, error) {
+	return c.get(c.client, monitorID, c.monitorEndpoint.Get)
+}
+
+func (c *prefetchedMonitors[M]) GetContext(ctx context.Context, monitorID string) (*M, error) {
+	get := func(monitorID string) (*M, error) {
+		return c.monitorEndpoint.GetContext(ctx, monitorID)
+	}
+
+	return c.get(c.client.WithContext(ctx), monitorID, get)
+}
+
+func (c *prefetchedMonitors[M]) Update(monitor *M) (*M, error) {
+	defer c.snapshots.forget(c.idOf(monitor))
+	return c.monitorEndpoint.Update(monitor)
+}
+
+func (c *prefetchedMonitors[M]) UpdateContext(ctx context.Context, monitor *M) (*M, error) {
+	defer c.snapshots.forget(c.idOf(monitor))
+	return c.monitorEndpoint.UpdateContext(ctx, monitor)
+}
+
+func (c *prefetchedMonitors[M]) Delete(monitorID string) error {
+	defer c.snapshots.forget(monitorID)
+	return c.monitorEndpoint.Delete(monitorID)
+}
+
+func (c *prefetchedMonitors[M]) DeleteContext(ctx context.Context, monitorID string) error {
+	defer c.snapshots.forget(monitorID)
+	return c.monitorEndpoint.DeleteContext(ctx, monitorID)
+}
+
+func (c *prefetchedMonitors[M]) Activate(monitorID string) error {
+	defer c.snapshots.forget(monitorID)
+	return c.monitorEndpoint.Activate(monitorID)
+}
+
+func (c *prefetchedMonitors[M]) ActivateContext(ctx context.Context, monitorID string) error {
+	defer c.snapshots.forget(monitorID)
+	return c.monitorEndpoint.ActivateContext(ctx, monitorID)
+}
+
+func (c *prefetchedMonitors[M]) Suspend(monitorID string) error {
+	defer c.snapshots.forget(monitorID)
+	return c.monitorEndpoint.Suspend(monitorID)
+}
+
+func (c *prefetchedMonitors[M]) SuspendContext(ctx context.Context, monitorID string) error {
+	defer c.snapshots.forget(monitorID)
+	return c.monitorEndpoint.SuspendContext(ctx, monitorID)
+}
+
+// prefetchRestApiTransactionMonitors is like prefetchMonitors for the REST
+// API transaction monitor endpoint, which additionally serves the steps of
+// the monitors.
+func prefetchRestApiTransactionMonitors(s *monitorSnapshots, client rest.Client, m monitors.RestApiTransactionMonitors) monitors.RestApiTransactionMonitors {
+	if s == nil {
+		return m
+	}
+
+	return &prefetchedRestApiTransactionMonitors{
+		monitorEndpoint: prefetchMonitors(s, client, m, func(monitor *api.RestApiTransactionMonitor) string { return monitor.MonitorID }),
+		steps:           m,
+	}
+}
+
+type prefetchedRestApiTransactionMonitors struct {
+	monitorEndpoint[api.RestApiTransactionMonitor]
+	steps monitors.RestApiTransactionMonitors
+}
+
+func (c *prefetchedRestApiTransactionMonitors) GetSteps(monitorID string) (*[]api.Steps, error) {
+	return c.steps.GetSteps(monitorID)
+}
+
+func (c *prefetchedRestApiTransactionMonitors) GetStepsContext(ctx context.Context, monitorID string) (*[]api.Steps, error) {
+	return c.steps.GetStepsContext(ctx, monitorID)
+}
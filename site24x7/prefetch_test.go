@@ -0,0 +1,133 @@
+package site24x7
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type monitorsServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	listCalls int
+	getCalls  map[string]int
+}
+
+func newMonitorsServer(t *testing.T) *monitorsServer {
+	s := &monitorsServer{getCalls: make(map[string]int)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/monitors":
+			s.listCalls++
+			w.Write([]byte(`{"code":0,"message":"success","data":[
+				{"monitor_id":"1","display_name":"one","type":"URL"},
+				{"monitor_id":"2","display_name":"two","type":"URL"}
+			]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/monitors/1":
+			s.getCalls["1"]++
+			w.Write([]byte(`{"code":0,"message":"success","data":{"monitor_id":"1","display_name":"one (fresh)","type":"URL"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/monitors/3":
+			s.getCalls["3"]++
+			w.Write([]byte(`{"code":0,"message":"success","data":{"monitor_id":"3","display_name":"three","type":"URL"}}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/monitors/2":
+			w.Write([]byte(`{"code":0,"message":"success","data":{"monitor_id":"2","display_name":"two","type":"URL"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":1,"message":"not found"}`))
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func TestPrefetchMonitors(t *testing.T) {
+	server := newMonitorsServer(t)
+
+	c := NewClient(http.DefaultClient, Config{APIBaseURL: server.URL, PrefetchMonitors: true})
+
+	monitor, err := c.WebsiteMonitors().Get("1")
+	require.NoError(t, err)
+	assert.Equal(t, "one", monitor.DisplayName)
+
+	// Monitors missing from the snapshot are read individually.
+	monitor, err = WithContext(context.Background(), c).WebsiteMonitors().GetContext(context.Background(), "3")
+	require.NoError(t, err)
+	assert.Equal(t, "three", monitor.DisplayName)
+
+	// Monitors are served from the snapshot only once.
+	monitor, err = c.WebsiteMonitors().Get("1")
+	require.NoError(t, err)
+	assert.Equal(t, "one (fresh)", monitor.DisplayName)
+
+	// Modified monitors are dropped from the snapshot.
+	_, err = c.WebsiteMonitors().Update(&api.WebsiteMonitor{MonitorID: "2"})
+	require.NoError(t, err)
+
+	_, err = c.WebsiteMonitors().Get("2")
+	require.Error(t, err)
+
+	assert.Equal(t, 1, server.listCalls)
+	assert.Equal(t, map[string]int{"1": 1, "3": 1}, server.getCalls)
+}
+
+func TestPrefetchMonitorsSharesListAcrossTypes(t *testing.T) {
+	server := newMonitorsServer(t)
+
+	c := NewClient(http.DefaultClient, Config{APIBaseURL: server.URL, PrefetchMonitors: true})
+
+	website, err := c.WebsiteMonitors().Get("1")
+	require.NoError(t, err)
+	assert.Equal(t, "one", website.DisplayName)
+
+	ssl, err := c.SSLMonitors().Get("2")
+	require.NoError(t, err)
+	assert.Equal(t, "two", ssl.DisplayName)
+
+	assert.Equal(t, 1, server.listCalls)
+	assert.Empty(t, server.getCalls)
+}
+
+func TestPrefetchMonitorsDisabled(t *testing.T) {
+	server := newMonitorsServer(t)
+
+	c := NewClient(http.DefaultClient, Config{APIBaseURL: server.URL})
+
+	monitor, err := c.WebsiteMonitors().Get("1")
+	require.NoError(t, err)
+	assert.Equal(t, "one (fresh)", monitor.DisplayName)
+
+	assert.Equal(t, 0, server.listCalls)
+	assert.Equal(t, map[string]int{"1": 1}, server.getCalls)
+}
+
+func TestPrefetchMonitorsFallsBackIfListFails(t *testing.T) {
+	getCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/monitors" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"code":1,"message":"internal error"}`))
+			return
+		}
+		getCalls++
+		w.Write([]byte(`{"code":0,"message":"success","data":{"monitor_id":"1","display_name":"one"}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(http.DefaultClient, Config{APIBaseURL: server.URL, PrefetchMonitors: true})
+
+	monitor, err := c.SSLMonitors().Get("1")
+	require.NoError(t, err)
+	assert.Equal(t, "one", monitor.DisplayName)
+	assert.Equal(t, 1, getCalls)
+}
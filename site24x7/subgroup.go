@@ -1,7 +1,10 @@
 package site24x7
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 )
@@ -66,26 +69,25 @@ var SubgroupSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7Subgroup() *schema.Resource {
 	return &schema.Resource{
-		Create: subgroupCreate,
-		Read:   subgroupRead,
-		Update: subgroupUpdate,
-		Delete: subgroupDelete,
-		Exists: subgroupExists,
+		CreateContext: subgroupCreate,
+		ReadContext:   subgroupRead,
+		UpdateContext: subgroupUpdate,
+		DeleteContext: subgroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: SubgroupSchema,
 	}
 }
 
-func subgroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func subgroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	subgroup := resourceDataToSubgroup(d)
 
 	subgroup, err := client.Subgroups().Create(subgroup)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(subgroup.ID)
@@ -96,12 +98,16 @@ func subgroupCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func subgroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func subgroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	subgroup, err := client.Subgroups().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateSubgroupResourceData(d, subgroup)
@@ -109,14 +115,14 @@ func subgroupRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func subgroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func subgroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	subgrp := resourceDataToSubgroup(d)
 
 	subgroup, err := client.Subgroups().Update(subgrp)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(subgroup.ID)
@@ -127,30 +133,15 @@ func subgroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func subgroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func subgroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	err := client.Subgroups().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func subgroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(Client)
-
-	_, err := client.Subgroups().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToSubgroup(d *schema.ResourceData) *api.Subgroup {
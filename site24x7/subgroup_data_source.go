@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 )
 
@@ -14,6 +16,56 @@ var subgroupDataSourceSchema = map[string]*schema.Schema{
 		Required: true,
 		// ValidateFunc: validation.StringIsValidRegExp,
 	},
+	"parents": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"group_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "ID of the parent group.",
+				},
+				"display_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Display name of the parent group.",
+				},
+			},
+		},
+		Description: "Parent groups of the subgroup, from the direct parent up to the top monitor group.",
+	},
+	"child_subgroups": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"group_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "ID of the child subgroup.",
+				},
+				"display_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Display name of the child subgroup.",
+				},
+				"monitors": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of monitors associated to the child subgroup.",
+				},
+			},
+		},
+		Description: "Subgroups whose parent is the subgroup.",
+	},
+	"all_monitors": {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "List of monitors associated to the subgroup or to any of its nested subgroups.",
+	},
 	"display_name": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -30,18 +82,18 @@ var subgroupDataSourceSchema = map[string]*schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
 		Computed:    true,
-		Description: "Unique ID of the parent group under which subgroup has to be configured. It can be a subgroup or Monitor group. (In case of level 1 subgroup, top_group_id is monitor group id. In other cases it will be subgroup id. You can get the subgroup Ids configured for top_group_id by using business view API).",
+		Description: "Unique ID of the parent group of the subgroup. It can be a subgroup or Monitor group. If set, only subgroups with this parent are matched.",
 	},
 	"top_group_id": {
 		Type:        schema.TypeString,
 		Optional:    true,
 		Computed:    true,
-		Description: "Unique ID of the top monitor group for which business view has been configured.",
+		Description: "Unique ID of the top monitor group for which business view has been configured. If set, only subgroups of this business view are matched.",
 	},
 	"group_type": {
 		Type:        schema.TypeInt,
 		Optional:    true,
-		Default:     1,
+		Computed:    true,
 		Description: "Denotes the type of monitors that can be associated. ‘1’ implies that all type of monitors can be associated with this subgroup. Default value is 1. '2' - Web, '3' - Port/Ping, '4' - Server, '5' - Database, '6' - Synthetic Transaction, '7' - Web API, '8' - APM Insight,'9' - Network Devices, '10' - RUM, '11' - AppLogs Monitor",
 	},
 	"health_threshold_count": {
@@ -63,60 +115,159 @@ var subgroupDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7Subgroup() *schema.Resource {
 	return &schema.Resource{
-		Read:   subgroupDataSourceRead,
-		Schema: subgroupDataSourceSchema,
+		ReadContext: subgroupDataSourceRead,
+		Schema:      subgroupDataSourceSchema,
 	}
 }
 
-// subgroupDataSourceRead fetches all subgroup from Site24x7
-func subgroupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+// subgroupDataSourceRead fetches all subgroups from Site24x7 and looks up the
+// first one matching the name within the given top and parent group. The
+// hierarchy around it is derived from the parent group IDs of the subgroups.
+func subgroupDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	subgroupList, err := client.Subgroups().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	nameRegex := d.Get("name_regex")
+	nameRegex := d.Get("name_regex").(string)
+	topGroupID := d.Get("top_group_id").(string)
+	parentGroupID := d.Get("parent_group_id").(string)
+
+	if nameRegex == "" {
+		return diag.FromErr(errors.New("Please enter a value for the attribute name_regex!"))
+	}
+
+	// (?i) - Case insensitive match
+	nameRegexPattern := regexp.MustCompile("(?i)" + nameRegex)
 
 	var subgroup *api.Subgroup
-	if nameRegex != "" {
-		// (?i) - Case insensitive match
-		nameRegexPattern := regexp.MustCompile("(?i)" + nameRegex.(string))
-		for _, groupInfo := range subgroupList {
-			if len(groupInfo.DisplayName) > 0 {
-				if nameRegexPattern.MatchString(groupInfo.DisplayName) {
-					subgroup = new(api.Subgroup)
-					subgroup.ID = groupInfo.ID
-					subgroup.DisplayName = groupInfo.DisplayName
-					subgroup.Description = groupInfo.Description
-					subgroup.HealthThresholdCount = groupInfo.HealthThresholdCount
-					subgroup.Monitors = groupInfo.Monitors
-					subgroup.ParentGroupID = groupInfo.ParentGroupID
-					subgroup.TopGroupID = groupInfo.TopGroupID
-					subgroup.Type = groupInfo.Type
-					break
-				}
-			}
+	for _, groupInfo := range subgroupList {
+		if topGroupID != "" && groupInfo.TopGroupID != topGroupID {
+			continue
+		}
+		if parentGroupID != "" && groupInfo.ParentGroupID != parentGroupID {
+			continue
+		}
+		if len(groupInfo.DisplayName) > 0 && nameRegexPattern.MatchString(groupInfo.DisplayName) {
+			subgroup = groupInfo
+			break
 		}
-	} else {
-		return errors.New("Please enter a value for the attribute name_regex!")
 	}
 
 	if subgroup == nil {
-		return errors.New("Unable to find subgroup matching the name : \"" + d.Get("name_regex").(string))
+		message := "Unable to find subgroup matching the name : \"" + nameRegex + "\""
+		if topGroupID != "" {
+			message += " in the business view of the monitor group \"" + topGroupID + "\""
+		}
+		return diag.FromErr(errors.New(message))
+	}
+
+	parents, err := subgroupParents(client, subgroup, subgroupList)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	updateSubgroupDataSourceResourceData(d, subgroup)
+	d.Set("parents", parents)
+	d.Set("child_subgroups", subgroupChildren(subgroup, subgroupList))
+	d.Set("all_monitors", subgroupMonitors(subgroup, subgroupList))
 
 	return nil
 }
 
+// subgroupParents returns the parent groups of subgroup, from the direct
+// parent up to the top monitor group.
+func subgroupParents(client Client, subgroup *api.Subgroup, subgroups []*api.Subgroup) ([]interface{}, error) {
+	byID := make(map[string]*api.Subgroup, len(subgroups))
+	for _, s := range subgroups {
+		byID[s.ID] = s
+	}
+
+	var parents []interface{}
+	visited := map[string]bool{subgroup.ID: true}
+	for id := subgroup.ParentGroupID; id != "" && !visited[id]; {
+		visited[id] = true
+
+		parent, ok := byID[id]
+		if !ok {
+			// The parent of a level 1 subgroup is the top monitor group.
+			name, err := monitorGroupName(client, id)
+			if err != nil {
+				return nil, err
+			}
+			parents = append(parents, map[string]interface{}{"group_id": id, "display_name": name})
+			break
+		}
+
+		parents = append(parents, map[string]interface{}{"group_id": parent.ID, "display_name": parent.DisplayName})
+		id = parent.ParentGroupID
+	}
+
+	return parents, nil
+}
+
+func monitorGroupName(client Client, groupID string) (string, error) {
+	groups, err := client.MonitorGroups().List()
+	if err != nil {
+		return "", err
+	}
+
+	for _, group := range groups {
+		if group.GroupID == groupID {
+			return group.DisplayName, nil
+		}
+	}
+
+	return "", nil
+}
+
+// subgroupChildren returns the subgroups whose parent is subgroup.
+func subgroupChildren(subgroup *api.Subgroup, subgroups []*api.Subgroup) []interface{} {
+	var children []interface{}
+	for _, s := range subgroups {
+		if s.ParentGroupID == subgroup.ID {
+			children = append(children, map[string]interface{}{
+				"group_id":     s.ID,
+				"display_name": s.DisplayName,
+				"monitors":     s.Monitors,
+			})
+		}
+	}
+
+	return children
+}
+
+// subgroupMonitors returns the monitors of subgroup and of all of its nested
+// subgroups.
+func subgroupMonitors(subgroup *api.Subgroup, subgroups []*api.Subgroup) []string {
+	var monitors []string
+	visited := map[string]bool{}
+
+	var collect func(s *api.Subgroup)
+	collect = func(s *api.Subgroup) {
+		if visited[s.ID] {
+			return
+		}
+		visited[s.ID] = true
+
+		monitors = append(monitors, s.Monitors...)
+		for _, child := range subgroups {
+			if child.ParentGroupID == s.ID {
+				collect(child)
+			}
+		}
+	}
+	collect(subgroup)
+
+	return monitors
+}
+
 func updateSubgroupDataSourceResourceData(d *schema.ResourceData, subgroup *api.Subgroup) {
 	d.SetId(subgroup.ID)
 	d.Set("display_name", subgroup.DisplayName)
 	d.Set("description", subgroup.Description)
-	d.Set("monitors", subgroup.Monitors)
 	d.Set("parent_group_id", subgroup.ParentGroupID)
 	d.Set("top_group_id", subgroup.TopGroupID)
 	d.Set("group_type", subgroup.Type)
@@ -0,0 +1,86 @@
+package site24x7
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/site24x7/terraform-provider-site24x7/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSubgroups are the subgroups of two business views:
+//
+//	Shop (100)              Blog (200)
+//	└── Backend (1)         └── Backend (4)
+//	    ├── Databases (2)
+//	    │   └── Replicas (3)
+//	    └── Queues (5)
+var testSubgroups = []*api.Subgroup{
+	{ID: "1", DisplayName: "Backend", TopGroupID: "100", ParentGroupID: "100", Monitors: []string{"m1"}},
+	{ID: "2", DisplayName: "Databases", TopGroupID: "100", ParentGroupID: "1", Monitors: []string{"m2"}},
+	{ID: "3", DisplayName: "Replicas", TopGroupID: "100", ParentGroupID: "2", Monitors: []string{"m3", "m4"}},
+	{ID: "4", DisplayName: "Backend", TopGroupID: "200", ParentGroupID: "200", Monitors: []string{"m5"}},
+	{ID: "5", DisplayName: "Queues", TopGroupID: "100", ParentGroupID: "1"},
+}
+
+func TestSubgroupDataSourceRead(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeSubgroups.On("List").Return(testSubgroups, nil)
+	c.FakeMonitorGroups.On("List").Return([]*api.MonitorGroup{
+		{GroupID: "100", DisplayName: "Shop"},
+		{GroupID: "200", DisplayName: "Blog"},
+	}, nil)
+
+	d := subgroupDataSourceTestResourceData(t, map[string]interface{}{
+		"name_regex":   "^backend$",
+		"top_group_id": "200",
+	})
+	require.Nil(t, subgroupDataSourceRead(context.Background(), d, c))
+	assert.Equal(t, "4", d.Id())
+	assert.Equal(t, []interface{}{map[string]interface{}{"group_id": "200", "display_name": "Blog"}}, d.Get("parents"))
+
+	d = subgroupDataSourceTestResourceData(t, map[string]interface{}{
+		"name_regex":   "^backend$",
+		"top_group_id": "100",
+	})
+	require.Nil(t, subgroupDataSourceRead(context.Background(), d, c))
+	assert.Equal(t, "1", d.Id())
+	assert.Equal(t, "Databases", d.Get("child_subgroups.0.display_name"))
+	assert.Equal(t, []interface{}{"m2"}, d.Get("child_subgroups.0.monitors"))
+	assert.Equal(t, "Queues", d.Get("child_subgroups.1.display_name"))
+	assert.Len(t, d.Get("child_subgroups"), 2)
+	assert.ElementsMatch(t, []interface{}{"m1", "m2", "m3", "m4"}, d.Get("all_monitors").(*schema.Set).List())
+
+	d = subgroupDataSourceTestResourceData(t, map[string]interface{}{
+		"name_regex":      "replicas",
+		"parent_group_id": "2",
+	})
+	require.Nil(t, subgroupDataSourceRead(context.Background(), d, c))
+	assert.Equal(t, "3", d.Id())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"group_id": "2", "display_name": "Databases"},
+		map[string]interface{}{"group_id": "1", "display_name": "Backend"},
+		map[string]interface{}{"group_id": "100", "display_name": "Shop"},
+	}, d.Get("parents"))
+	assert.Empty(t, d.Get("child_subgroups"))
+}
+
+func TestSubgroupDataSourceReadNotFound(t *testing.T) {
+	c := fake.NewClient()
+	c.FakeSubgroups.On("List").Return(testSubgroups, nil)
+
+	d := subgroupDataSourceTestResourceData(t, map[string]interface{}{
+		"name_regex":   "replicas",
+		"top_group_id": "200",
+	})
+	diags := subgroupDataSourceRead(context.Background(), d, c)
+	require.True(t, diags.HasError())
+	assert.Equal(t, `Unable to find subgroup matching the name : "replicas" in the business view of the monitor group "200"`, diags[0].Summary)
+}
+
+func subgroupDataSourceTestResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, DataSourceSite24x7Subgroup().Schema, raw)
+}
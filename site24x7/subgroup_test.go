@@ -1,9 +1,11 @@
 package site24x7
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -31,13 +33,13 @@ func TestSubgroupCreate(t *testing.T) {
 
 	c.FakeSubgroups.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, subgroupCreate(d, c))
+	require.Nil(t, subgroupCreate(context.Background(), d, c))
 
 	c.FakeSubgroups.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := subgroupCreate(d, c)
+	err := subgroupCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSubgroupUpdate(t *testing.T) {
@@ -62,13 +64,13 @@ func TestSubgroupUpdate(t *testing.T) {
 
 	c.FakeSubgroups.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, subgroupUpdate(d, c))
+	require.Nil(t, subgroupUpdate(context.Background(), d, c))
 
 	c.FakeSubgroups.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := subgroupUpdate(d, c)
+	err := subgroupUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSubgroupRead(t *testing.T) {
@@ -79,13 +81,13 @@ func TestSubgroupRead(t *testing.T) {
 
 	c.FakeSubgroups.On("Get", "123").Return(&api.Subgroup{}, nil).Once()
 
-	require.NoError(t, subgroupRead(d, c))
+	require.Nil(t, subgroupRead(context.Background(), d, c))
 
 	c.FakeSubgroups.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := subgroupRead(d, c)
+	err := subgroupRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestSubgroupDelete(t *testing.T) {
@@ -96,39 +98,23 @@ func TestSubgroupDelete(t *testing.T) {
 
 	c.FakeSubgroups.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, subgroupDelete(d, c))
+	require.Nil(t, subgroupDelete(context.Background(), d, c))
 
 	c.FakeSubgroups.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, subgroupDelete(d, c))
+	require.Nil(t, subgroupDelete(context.Background(), d, c))
 }
 
-func TestSubgroupExists(t *testing.T) {
+func TestSubgroupReadNotFound(t *testing.T) {
 	d := subgroupTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeSubgroups.On("Get", "123").Return(&api.Subgroup{}, nil).Once()
-
-	exists, err := subgroupExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeSubgroups.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = subgroupExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeSubgroups.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = subgroupExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, subgroupRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func subgroupTestResourceData(t *testing.T) *schema.ResourceData {
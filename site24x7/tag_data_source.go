@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 )
 
@@ -59,18 +61,18 @@ var tagDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7Tag() *schema.Resource {
 	return &schema.Resource{
-		Read:   tagDataSourceRead,
-		Schema: tagDataSourceSchema,
+		ReadContext: tagDataSourceRead,
+		Schema:      tagDataSourceSchema,
 	}
 }
 
 // tagDataSourceRead fetches all tags from Site24x7
-func tagDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func tagDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	tagsList, err := client.Tags().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	nameRegex := d.Get("tag_name_regex")
 	valueRegex := d.Get("tag_value_regex")
@@ -122,11 +124,11 @@ func tagDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 
 		}
 	} else {
-		return errors.New("Please enter a value for the attribute tag_name_regex!")
+		return diag.FromErr(errors.New("Please enter a value for the attribute tag_name_regex!"))
 	}
 
 	if tag == nil {
-		return errors.New("Unable to find tag matching the name : \"" + d.Get("tag_name_regex").(string) + "\" and value : \"" + d.Get("tag_value_regex").(string) + "\"")
+		return diag.FromErr(errors.New("Unable to find tag matching the name : \"" + d.Get("tag_name_regex").(string) + "\" and value : \"" + d.Get("tag_value_regex").(string) + "\""))
 	}
 
 	updateTagDataSourceResourceData(d, tag, matchingTagIDs, matchingTagIDsAndNames)
@@ -1,7 +1,10 @@
 package site24x7
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 )
@@ -26,26 +29,25 @@ var TagSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7Tag() *schema.Resource {
 	return &schema.Resource{
-		Create: tagCreate,
-		Read:   tagRead,
-		Update: tagUpdate,
-		Delete: tagDelete,
-		Exists: tagExists,
+		CreateContext: tagCreate,
+		ReadContext:   tagRead,
+		UpdateContext: tagUpdate,
+		DeleteContext: tagDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: ImportByName("tag", findTags),
 		},
 		Schema: TagSchema,
 	}
 }
 
-func tagCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func tagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	tag, err := resourceDataToTagCreateOrUpdate(d)
 
 	tag, err = client.Tags().Create(tag)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(tag.TagID)
@@ -56,12 +58,16 @@ func tagCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func tagRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func tagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	tag, err := client.Tags().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateTagResourceData(d, tag)
@@ -69,14 +75,14 @@ func tagRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func tagUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func tagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	tag, err := resourceDataToTagCreateOrUpdate(d)
 
 	tag, err = client.Tags().Update(tag)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(tag.TagID)
@@ -87,30 +93,15 @@ func tagUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func tagDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func tagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	err := client.Tags().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func tagExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(Client)
-
-	_, err := client.Tags().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToTagCreateOrUpdate(d *schema.ResourceData) (*api.Tag, error) {
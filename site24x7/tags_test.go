@@ -1,9 +1,11 @@
 package site24x7
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -25,13 +27,13 @@ func TestTagCreate(t *testing.T) {
 
 	c.FakeTags.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, tagCreate(d, c))
+	require.Nil(t, tagCreate(context.Background(), d, c))
 
 	c.FakeTags.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := tagCreate(d, c)
+	err := tagCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestTagUpdate(t *testing.T) {
@@ -50,13 +52,13 @@ func TestTagUpdate(t *testing.T) {
 
 	c.FakeTags.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, tagUpdate(d, c))
+	require.Nil(t, tagUpdate(context.Background(), d, c))
 
 	c.FakeTags.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := tagUpdate(d, c)
+	err := tagUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestTagRead(t *testing.T) {
@@ -67,13 +69,13 @@ func TestTagRead(t *testing.T) {
 
 	c.FakeTags.On("Get", "123").Return(&api.Tag{}, nil).Once()
 
-	require.NoError(t, tagRead(d, c))
+	require.Nil(t, tagRead(context.Background(), d, c))
 
 	c.FakeTags.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := tagRead(d, c)
+	err := tagRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestTagDelete(t *testing.T) {
@@ -84,39 +86,23 @@ func TestTagDelete(t *testing.T) {
 
 	c.FakeTags.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, tagDelete(d, c))
+	require.Nil(t, tagDelete(context.Background(), d, c))
 
 	c.FakeTags.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, tagDelete(d, c))
+	require.Nil(t, tagDelete(context.Background(), d, c))
 }
 
-func TestTagExists(t *testing.T) {
+func TestTagReadNotFound(t *testing.T) {
 	d := tagTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeTags.On("Get", "123").Return(&api.Tag{}, nil).Once()
-
-	exists, err := tagExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeTags.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = tagExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeTags.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = tagExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, tagRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func tagTestResourceData(t *testing.T) *schema.ResourceData {
@@ -1,8 +1,11 @@
 package site24x7
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 )
@@ -106,21 +109,9 @@ var ThresholdProfileSchema = map[string]*schema.Schema{
 		Description: "Triggers alert when the website content is modified.",
 	},
 	"read_time_out": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{0, 2, 3}), //Down or Trouble or Critical
-				},
-				"value": {
-					Type:     schema.TypeBool,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers alert when not receiving the website entire HTTP response within 30 seconds.",
 	},
 	"website_content_changes": {
@@ -149,139 +140,27 @@ var ThresholdProfileSchema = map[string]*schema.Schema{
 		Description: "Triggers alert when the website content changes by configured percentage.",
 	},
 	"primary_response_time_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:     schema.TypeInt,
-					Required: true,
-					// ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-					// 	log.Println("=============================== Validating primary_response_time_trouble_threshold : ", val)
-					// 	v := val.(int)
-					// 	if v != 2 {
-					// 		errs = append(errs, fmt.Errorf("%q must be 2 for trouble threshold, got: %d", key, v))
-					// 	}
-					// 	return warns, errs
-					// },
-					ValidateFunc: validation.IntInSlice([]int{2}),
-				},
-				"comparison_operator": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5}),
-				},
-				"value": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-				"strategy": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4}),
-				},
-				"polls_check": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Response time trouble threshold for the primary monitoring location. Anomaly Enabled Attribute",
 	},
 	"primary_response_time_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{3}), // Critical
-				},
-				"comparison_operator": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5}),
-				},
-				"value": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-				"strategy": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4}),
-				},
-				"polls_check": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Response time critical threshold for the primary monitoring location. Anomaly Enabled Attribute",
 	},
 	"secondary_response_time_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{2}), // Trouble
-				},
-				"comparison_operator": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5}),
-				},
-				"value": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-				"strategy": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4}),
-				},
-				"polls_check": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Response time trouble threshold for the secondary monitoring location. Anomaly Enabled Attribute",
 	},
 	"secondary_response_time_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{3}), // Critical
-				},
-				"comparison_operator": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5}),
-				},
-				"value": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-				"strategy": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4}),
-				},
-				"polls_check": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Response time critical threshold for the secondary monitoring location. Anomaly Enabled Attribute",
 	},
 	// SSL_CERT monitor type attributes
@@ -291,71 +170,28 @@ var ThresholdProfileSchema = map[string]*schema.Schema{
 		Description: "Triggers alert when the ssl certificate is modified.",
 	},
 	"ssl_cert_days_until_expiry_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{2}), // Trouble
-				},
-				"value": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert before the SSL certificate expires within the configured number of days.",
 	},
 	"ssl_cert_days_until_expiry_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{2}), // Trouble
-				},
-				"value": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert before the SSL certificate expires within the configured number of days.",
 	},
 	// CRON monitor type attributes
 	"cron_no_run_alert": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity": {
-					Type:         schema.TypeInt,
-					Required:     true,
-					ValidateFunc: validation.IntInSlice([]int{2}), // Trouble
-				},
-				"value": {
-					Type:     schema.TypeBool,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers Alert, if job does not start on schedule",
 	},
 	"cron_duration_alert": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"trouble": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Generate Trouble Alert if not pinged for more than x seconds.",
 	},
 
@@ -379,703 +215,326 @@ var ThresholdProfileSchema = map[string]*schema.Schema{
 	// SERVER monitor type attributes
 	// Standard threshold attributes - trouble thresholds (severity 2)
 	"cpu_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if Server CPU Usage exceeds configured threshold.",
 	},
 	"cpu_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if Server CPU Usage exceeds configured threshold.",
 	},
 	"memory_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if Server Memory Usage exceeds configured threshold.",
 	},
 	"memory_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if Server Memory Usage exceeds configured threshold.",
 	},
 	"disk_usage_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if Server Disk utilization exceeds configured threshold.",
 	},
 	"disk_usage_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if Server Disk utilization exceeds configured threshold.",
 	},
 	"disk_partition_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if disk partition threshold exceeds configured threshold.",
 	},
 	"disk_partition_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if disk partition threshold exceeds configured threshold.",
 	},
 	"process_cpu_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if any process CPU usage exceeds configured threshold.",
 	},
 	"process_cpu_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if any process CPU usage exceeds configured threshold.",
 	},
 	"process_memory_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if any process memory usage exceeds configured threshold.",
 	},
 	"process_memory_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if any process memory usage exceeds configured threshold.",
 	},
 	"process_disk_usage_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if any process disk utilization exceeds configured threshold.",
 	},
 	"process_disk_usage_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if any process disk utilization exceeds configured threshold.",
 	},
 	"process_handle_count_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if any process handle count exceeds configured threshold.",
 	},
 	"process_handle_count_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if any process handle count exceeds configured threshold.",
 	},
 	"network_error_packet_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if network error packet exceeds configured threshold.",
 	},
 	"network_error_packet_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if network error packet exceeds configured threshold.",
 	},
 	"ip_address_change_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if IP address validation fails.",
 	},
 	"ip_address_change_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if IP address validation fails.",
 	},
 	"process_instance_count_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers trouble alert if any process instance count exceeds configured threshold.",
 	},
 	"process_instance_count_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers critical alert if any process instance count exceeds configured threshold.",
 	},
 	// Special attributes
 	"process_down_alert": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"value":    {Type: schema.TypeBool, Required: true},
-				"severity": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{0, 2, 3})},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers alert if process is down.",
 	},
 	"server_resource_down_alert": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"value":    {Type: schema.TypeBool, Required: true},
-				"severity": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{0, 2, 3})},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Notify if a resource check fails.",
 	},
 	"dc_alert": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"value":    {Type: schema.TypeBool, Required: true},
-				"severity": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{0, 2, 3})},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "DC alert configuration.",
 	},
 	"disk_status_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"value":    {Type: schema.TypeBool, Required: true},
-				"severity": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{0, 2, 3})},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Disk status threshold alert configuration.",
 	},
 	"service_status_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"value":       {Type: schema.TypeBool, Required: true},
-				"severity":    {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{0, 2, 3})},
-				"strategy":    {Type: schema.TypeInt, Optional: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check": {Type: schema.TypeInt, Optional: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Service status threshold alert configuration.",
 	},
 	"nw_status_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"value":    {Type: schema.TypeBool, Required: true},
-				"severity": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{0, 2, 3})},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Network status threshold alert configuration.",
 	},
 	// Windows specific
 	"running_process_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Windows Specific. Triggers trouble alert if running process count exceeds configured threshold.",
 	},
 	"running_process_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Windows Specific. Triggers critical alert if running process count exceeds configured threshold.",
 	},
 	"total_service_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Windows Specific. Triggers trouble alert if total service count exceeds configured threshold.",
 	},
 	"total_service_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Windows Specific. Triggers critical alert if total service count exceeds configured threshold.",
 	},
 	"process_queue_length_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Windows Specific. Triggers trouble alert if processor queue length exceeds configured threshold.",
 	},
 	"process_queue_length_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Windows Specific. Triggers critical alert if processor queue length exceeds configured threshold.",
 	},
 	// Linux specific
 	"system_load_1min_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers trouble alert if system load (1 min avg) exceeds configured threshold.",
 	},
 	"system_load_1min_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers critical alert if system load (1 min avg) exceeds configured threshold.",
 	},
 	"system_load_5min_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers trouble alert if system load (5 min avg) exceeds configured threshold.",
 	},
 	"system_load_5min_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers critical alert if system load (5 min avg) exceeds configured threshold.",
 	},
 	"system_load_15min_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers trouble alert if system load (15 min avg) exceeds configured threshold.",
 	},
 	"system_load_15min_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers critical alert if system load (15 min avg) exceeds configured threshold.",
 	},
 	"process_running_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers trouble alert if running process count exceeds configured threshold.",
 	},
 	"process_running_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers critical alert if running process count exceeds configured threshold.",
 	},
 	"total_process_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers trouble alert if total process count exceeds configured threshold.",
 	},
 	"total_process_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers critical alert if total process count exceeds configured threshold.",
 	},
 	"blocked_process_trouble_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{2})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers trouble alert if blocked process count exceeds configured threshold.",
 	},
 	"blocked_process_critical_threshold": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"severity":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{3})},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"value":               {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Linux Specific. Triggers critical alert if blocked process count exceeds configured threshold.",
 	},
 	// Special format attributes
 	"disk_used_size": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"trouble":             {Type: schema.TypeInt, Required: true},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"unit_id":             {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers alert if disk partition usage threshold exceeds configured threshold.",
 	},
 	"disk_free_size": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"trouble":             {Type: schema.TypeInt, Required: true},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"unit_id":             {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers alert if disk partition free space exceeds configured threshold.",
 	},
 	"server_uptime": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"trouble":             {Type: schema.TypeInt, Required: true},
-				"comparison_operator": {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5})},
-				"polls_check":         {Type: schema.TypeInt, Required: true},
-				"strategy":            {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4})},
-				"unit_id":             {Type: schema.TypeInt, Required: true},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Triggers alert if uptime of the server exceeds configured threshold.",
 	},
 }
 
 func ResourceSite24x7ThresholdProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: thresholdProfileCreate,
-		Read:   thresholdProfileRead,
-		Update: thresholdProfileUpdate,
-		Delete: thresholdProfileDelete,
-		Exists: thresholdProfileExists,
+		CreateContext: thresholdProfileCreate,
+		ReadContext:   thresholdProfileRead,
+		UpdateContext: thresholdProfileUpdate,
+		DeleteContext: thresholdProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: ImportByName("threshold profile", findThresholdProfiles),
 		},
 		Schema: ThresholdProfileSchema,
 	}
 }
 
-func thresholdProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func thresholdProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	thresholdProfile := resourceDataToThresholdProfile(d)
 
 	thresholdProfile, err := client.ThresholdProfiles().Create(thresholdProfile)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(thresholdProfile.ProfileID)
@@ -1083,12 +542,16 @@ func thresholdProfileCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func thresholdProfileRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func thresholdProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	thresholdProfile, err := client.ThresholdProfiles().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateThresholdProfileResourceData(d, thresholdProfile)
@@ -1096,13 +559,13 @@ func thresholdProfileRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func thresholdProfileUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func thresholdProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	thresholdProfile := resourceDataToThresholdProfile(d)
 	thresholdProfile, err := client.ThresholdProfiles().Update(thresholdProfile)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(thresholdProfile.ProfileID)
@@ -1110,30 +573,15 @@ func thresholdProfileUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func thresholdProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func thresholdProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	err := client.ThresholdProfiles().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func thresholdProfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(Client)
-
-	_, err := client.ThresholdProfiles().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToThresholdProfile(d *schema.ResourceData) *api.ThresholdProfile {
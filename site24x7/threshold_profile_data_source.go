@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 )
 
@@ -68,18 +70,18 @@ var thresholdProfileDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7ThresholdProfile() *schema.Resource {
 	return &schema.Resource{
-		Read:   thresholdProfileDataSourceRead,
-		Schema: thresholdProfileDataSourceSchema,
+		ReadContext: thresholdProfileDataSourceRead,
+		Schema:      thresholdProfileDataSourceSchema,
 	}
 }
 
 // thresholdProfileDataSourceRead fetches all thresholdProfile from Site24x7
-func thresholdProfileDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func thresholdProfileDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	thresholdProfileList, err := client.ThresholdProfiles().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nameRegex := d.Get("name_regex")
@@ -111,11 +113,11 @@ func thresholdProfileDataSourceRead(d *schema.ResourceData, meta interface{}) er
 			}
 		}
 	} else {
-		return errors.New("Please enter a value for the attribute name_regex!")
+		return diag.FromErr(errors.New("Please enter a value for the attribute name_regex!"))
 	}
 
 	if thresholdProfile == nil {
-		return errors.New("Unable to find threshold profile matching the name : \"" + d.Get("name_regex").(string))
+		return diag.FromErr(errors.New("Unable to find threshold profile matching the name : \"" + d.Get("name_regex").(string)))
 	}
 
 	updateThresholdProfileDataSourceResourceData(d, thresholdProfile, matchingThresholdProfileIDs, matchingThresholdProfileIDsAndNames)
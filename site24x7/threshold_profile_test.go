@@ -1,9 +1,11 @@
 package site24x7
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -27,13 +29,13 @@ func TestThresholdProfileCreate(t *testing.T) {
 
 	c.FakeThresholdProfiles.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, thresholdProfileCreate(d, c))
+	require.Nil(t, thresholdProfileCreate(context.Background(), d, c))
 
 	c.FakeThresholdProfiles.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := thresholdProfileCreate(d, c)
+	err := thresholdProfileCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestThresholdProfileUpdate(t *testing.T) {
@@ -55,13 +57,13 @@ func TestThresholdProfileUpdate(t *testing.T) {
 
 	c.FakeThresholdProfiles.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, thresholdProfileUpdate(d, c))
+	require.Nil(t, thresholdProfileUpdate(context.Background(), d, c))
 
 	c.FakeThresholdProfiles.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := thresholdProfileUpdate(d, c)
+	err := thresholdProfileUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestThresholdProfileRead(t *testing.T) {
@@ -72,13 +74,13 @@ func TestThresholdProfileRead(t *testing.T) {
 
 	c.FakeThresholdProfiles.On("Get", "123").Return(&api.ThresholdProfile{}, nil).Once()
 
-	require.NoError(t, thresholdProfileRead(d, c))
+	require.Nil(t, thresholdProfileRead(context.Background(), d, c))
 
 	c.FakeThresholdProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := thresholdProfileRead(d, c)
+	err := thresholdProfileRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestThresholdProfileDelete(t *testing.T) {
@@ -89,39 +91,23 @@ func TestThresholdProfileDelete(t *testing.T) {
 
 	c.FakeThresholdProfiles.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, thresholdProfileDelete(d, c))
+	require.Nil(t, thresholdProfileDelete(context.Background(), d, c))
 
 	c.FakeThresholdProfiles.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, thresholdProfileDelete(d, c))
+	require.Nil(t, thresholdProfileDelete(context.Background(), d, c))
 }
 
-func TestThresholdProfileExists(t *testing.T) {
+func TestThresholdProfileReadNotFound(t *testing.T) {
 	d := thresholdProfileTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeThresholdProfiles.On("Get", "123").Return(&api.ThresholdProfile{}, nil).Once()
-
-	exists, err := thresholdProfileExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeThresholdProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = thresholdProfileExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeThresholdProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = thresholdProfileExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, thresholdProfileRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func thresholdProfileTestResourceData(t *testing.T) *schema.ResourceData {
@@ -169,13 +155,13 @@ func TestServerThresholdProfileCreate(t *testing.T) {
 
 	c.FakeThresholdProfiles.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, thresholdProfileCreate(d, c))
+	require.Nil(t, thresholdProfileCreate(context.Background(), d, c))
 
 	c.FakeThresholdProfiles.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := thresholdProfileCreate(d, c)
+	err := thresholdProfileCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestServerThresholdProfileUpdate(t *testing.T) {
@@ -215,13 +201,13 @@ func TestServerThresholdProfileUpdate(t *testing.T) {
 
 	c.FakeThresholdProfiles.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, thresholdProfileUpdate(d, c))
+	require.Nil(t, thresholdProfileUpdate(context.Background(), d, c))
 
 	c.FakeThresholdProfiles.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := thresholdProfileUpdate(d, c)
+	err := thresholdProfileUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestServerThresholdProfileRead(t *testing.T) {
@@ -252,7 +238,7 @@ func TestServerThresholdProfileRead(t *testing.T) {
 
 	c.FakeThresholdProfiles.On("Get", "456").Return(serverProfile, nil).Once()
 
-	require.NoError(t, thresholdProfileRead(d, c))
+	require.Nil(t, thresholdProfileRead(context.Background(), d, c))
 
 	assert.Equal(t, "server_threshold_profile", d.Get("profile_name"))
 	assert.Equal(t, "SERVER", d.Get("type"))
@@ -0,0 +1,109 @@
+package site24x7
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// TransportConfig configures how the provider connects to the Zoho token
+// endpoint and the Site24x7 API, e.g. when running behind a TLS-inspecting
+// corporate proxy.
+type TransportConfig struct {
+	// HTTPProxy is the URL of the proxy all requests are sent through. If
+	// empty, the proxy is taken from the HTTPS_PROXY, HTTP_PROXY and
+	// NO_PROXY environment variables.
+	HTTPProxy string
+
+	// CABundleFile is the path of a PEM file with additional CA
+	// certificates that are trusted next to the system roots.
+	CABundleFile string
+
+	// ClientCert and ClientKey are the PEM encoded certificate and private
+	// key, or the paths of files containing them, that are presented to
+	// servers requesting a client certificate.
+	ClientCert string
+	ClientKey  string
+
+	// InsecureSkipVerify disables the verification of server certificates.
+	// Only meant for debugging.
+	InsecureSkipVerify bool
+}
+
+// NewTransport creates a new *http.Transport from config. It is based on
+// http.DefaultTransport, so timeouts and connection pooling stay the same.
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.HTTPProxy != "" {
+		proxyURL, err := url.Parse(config.HTTPProxy)
+		if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid http_proxy %q: expected a URL like http://proxy.example.com:3128", config.HTTPProxy)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CABundleFile != "" {
+		pem, err := ioutil.ReadFile(config.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_bundle_file: %v", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ca_bundle_file %s does not contain any PEM encoded certificates", config.CABundleFile)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCert != "" || config.ClientKey != "" {
+		if config.ClientCert == "" || config.ClientKey == "" {
+			return nil, fmt.Errorf("client_cert and client_key must be set together")
+		}
+
+		certPEM, err := pemOrFile(config.ClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client_cert: %v", err)
+		}
+
+		keyPEM, err := pemOrFile(config.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client_key: %v", err)
+		}
+
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client_cert or client_key: %v", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// pemOrFile returns value if it is PEM encoded data and the contents of the
+// file at path value otherwise.
+func pemOrFile(value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return ioutil.ReadFile(value)
+}
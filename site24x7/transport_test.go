@@ -0,0 +1,226 @@
+package site24x7
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/site24x7/terraform-provider-site24x7/backoff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTransportCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	// Without the CA bundle the self-signed server certificate is rejected.
+	transport, err := NewTransport(TransportConfig{})
+	require.NoError(t, err)
+
+	_, err = (&http.Client{Transport: transport}).Get(server.URL)
+	require.Error(t, err)
+
+	transport, err = NewTransport(TransportConfig{
+		CABundleFile: writeCertificate(t, server.Certificate()),
+	})
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestNewTransportInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	transport, err := NewTransport(TransportConfig{InsecureSkipVerify: true})
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestNewTransportClientCertificate(t *testing.T) {
+	certPEM, keyPEM, cert := newClientCertificate(t)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	var peerCN string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		peerCN = r.TLS.PeerCertificates[0].Subject.CommonName
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  pool,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	caBundleFile := writeCertificate(t, server.Certificate())
+
+	// The server rejects clients without a certificate.
+	transport, err := NewTransport(TransportConfig{CABundleFile: caBundleFile})
+	require.NoError(t, err)
+
+	_, err = (&http.Client{Transport: transport}).Get(server.URL)
+	require.Error(t, err)
+
+	// PEM content and file paths are both accepted.
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "client.key")
+	require.NoError(t, ioutil.WriteFile(keyFile, keyPEM, 0600))
+
+	transport, err = NewTransport(TransportConfig{
+		CABundleFile: caBundleFile,
+		ClientCert:   string(certPEM),
+		ClientKey:    keyFile,
+	})
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "terraform-provider-site24x7", peerCN)
+}
+
+func TestNewTransportProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	transport, err := NewTransport(TransportConfig{HTTPProxy: proxy.URL})
+	require.NoError(t, err)
+
+	resp, err := (&http.Client{Transport: transport}).Get("http://www.site24x7.example/api/monitors")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "http://www.site24x7.example/api/monitors", proxied)
+}
+
+func TestNewTransportErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   TransportConfig
+		expected string
+	}{
+		{
+			name:     "invalid proxy URL",
+			config:   TransportConfig{HTTPProxy: "proxy:3128"},
+			expected: `invalid http_proxy "proxy:3128"`,
+		},
+		{
+			name:     "missing CA bundle",
+			config:   TransportConfig{CABundleFile: "/nonexistent/ca.pem"},
+			expected: "unable to read ca_bundle_file",
+		},
+		{
+			name:     "client certificate without key",
+			config:   TransportConfig{ClientCert: "cert.pem"},
+			expected: "client_cert and client_key must be set together",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewTransport(test.config)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expected)
+		})
+	}
+}
+
+func TestConfigTransportIsUsedForTokenEndpointAndAPI(t *testing.T) {
+	var tokenRequests, apiRequests int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth/v2/token":
+			tokenRequests++
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"1000.abc","expires_in":3600}`))
+		case "/api/monitor_groups":
+			apiRequests++
+			assert.Contains(t, r.Header.Get("Authorization"), "1000.abc")
+			w.Write([]byte(`{"code":0,"message":"success","data":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	transport, err := NewTransport(TransportConfig{
+		CABundleFile: writeCertificate(t, server.Certificate()),
+	})
+	require.NoError(t, err)
+
+	client := New(Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RefreshToken: "refresh-token",
+		TokenURL:     server.URL + "/oauth/v2/token",
+		APIBaseURL:   server.URL + "/api",
+		Transport:    transport,
+		RetryConfig:  &backoff.RetryConfig{MaxRetries: 0},
+	})
+
+	_, err = client.MonitorGroups().List()
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, tokenRequests)
+	assert.Equal(t, 1, apiRequests)
+}
+
+func writeCertificate(t *testing.T, cert *x509.Certificate) string {
+	path := filepath.Join(t.TempDir(), "ca.pem")
+
+	buf := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	require.NoError(t, ioutil.WriteFile(path, buf, 0600))
+
+	return path
+}
+
+func newClientCertificate(t *testing.T) ([]byte, []byte, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-site24x7"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return certPEM, keyPEM, cert
+}
@@ -1,7 +1,10 @@
 package site24x7
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 )
@@ -93,26 +96,25 @@ var URLActionSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7URLAction() *schema.Resource {
 	return &schema.Resource{
-		Create: urlActionCreate,
-		Read:   urlActionRead,
-		Update: urlActionUpdate,
-		Delete: urlActionDelete,
-		Exists: urlActionExists,
+		CreateContext: urlActionCreate,
+		ReadContext:   urlActionRead,
+		UpdateContext: urlActionUpdate,
+		DeleteContext: urlActionDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: URLActionSchema,
 	}
 }
 
-func urlActionCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func urlActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	automation := resourceDataToUrlAction(d)
 
 	automation, err := client.URLActions().Create(automation)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(automation.ActionID)
@@ -120,12 +122,16 @@ func urlActionCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func urlActionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func urlActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	automation, err := client.URLActions().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateUrlActionResourceData(d, automation)
@@ -133,14 +139,14 @@ func urlActionRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func urlActionUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func urlActionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	automation := resourceDataToUrlAction(d)
 
 	automation, err := client.URLActions().Update(automation)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(automation.ActionID)
@@ -148,30 +154,15 @@ func urlActionUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func urlActionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func urlActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	err := client.URLActions().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func urlActionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(Client)
-
-	_, err := client.URLActions().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToUrlAction(d *schema.ResourceData) *api.URLAction {
@@ -1,9 +1,11 @@
 package site24x7
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -31,13 +33,13 @@ func TestActionCreate(t *testing.T) {
 
 	c.FakeURLActions.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, urlActionCreate(d, c))
+	require.Nil(t, urlActionCreate(context.Background(), d, c))
 
 	c.FakeURLActions.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := urlActionCreate(d, c)
+	err := urlActionCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestActionUpdate(t *testing.T) {
@@ -62,13 +64,13 @@ func TestActionUpdate(t *testing.T) {
 
 	c.FakeURLActions.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, urlActionUpdate(d, c))
+	require.Nil(t, urlActionUpdate(context.Background(), d, c))
 
 	c.FakeURLActions.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := urlActionUpdate(d, c)
+	err := urlActionUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestActionRead(t *testing.T) {
@@ -79,13 +81,13 @@ func TestActionRead(t *testing.T) {
 
 	c.FakeURLActions.On("Get", "123").Return(&api.URLAction{}, nil).Once()
 
-	require.NoError(t, urlActionRead(d, c))
+	require.Nil(t, urlActionRead(context.Background(), d, c))
 
 	c.FakeURLActions.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := urlActionRead(d, c)
+	err := urlActionRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestActionDelete(t *testing.T) {
@@ -96,39 +98,23 @@ func TestActionDelete(t *testing.T) {
 
 	c.FakeURLActions.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, urlActionDelete(d, c))
+	require.Nil(t, urlActionDelete(context.Background(), d, c))
 
 	c.FakeURLActions.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, urlActionDelete(d, c))
+	require.Nil(t, urlActionDelete(context.Background(), d, c))
 }
 
-func TestActionExists(t *testing.T) {
+func TestActionReadNotFound(t *testing.T) {
 	d := urlActionTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeURLActions.On("Get", "123").Return(&api.URLAction{}, nil).Once()
-
-	exists, err := urlActionExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeURLActions.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = urlActionExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeURLActions.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = urlActionExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, urlActionRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func urlActionTestResourceData(t *testing.T) *schema.ResourceData {
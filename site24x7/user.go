@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 )
@@ -131,35 +133,9 @@ var UserSchema = map[string]*schema.Schema{
 		Description: "List of groups to be associated for the user for receiving alerts.",
 	},
 	"mobile_settings": {
-		Type:     schema.TypeMap,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"country_code": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-				"mobile_number": {
-					Type:     schema.TypeInt,
-					Required: true,
-				},
-				"is_confirmed": {
-					Type:     schema.TypeBool,
-					Optional: true,
-					Default:  false,
-				},
-				"call_provider_id": {
-					Type:     schema.TypeInt,
-					Optional: true,
-					Default:  0,
-				},
-				"sms_provider_id": {
-					Type:     schema.TypeInt,
-					Optional: true,
-					Default:  2,
-				},
-			},
-		},
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
 		Description: "Phone number configurations to receive alerts.",
 	},
 	// Alert Settings
@@ -223,26 +199,25 @@ var UserSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7User() *schema.Resource {
 	return &schema.Resource{
-		Create: userCreate,
-		Read:   userRead,
-		Update: userUpdate,
-		Delete: userDelete,
-		Exists: userExists,
+		CreateContext: userCreate,
+		ReadContext:   userRead,
+		UpdateContext: userUpdate,
+		DeleteContext: userDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: UserSchema,
 	}
 }
 
-func userCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func userCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	user := resourceDataToUser(d)
 
 	user, err := client.Users().Create(user)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(user.ID)
@@ -250,12 +225,16 @@ func userCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func userRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func userRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	user, err := client.Users().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateUserResourceData(d, user)
@@ -263,14 +242,14 @@ func userRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func userUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func userUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	user := resourceDataToUser(d)
 
 	user, err := client.Users().Update(user)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(user.ID)
@@ -278,30 +257,15 @@ func userUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func userDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func userDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	err := client.Users().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func userExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(Client)
-
-	_, err := client.Users().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToUser(d *schema.ResourceData) *api.User {
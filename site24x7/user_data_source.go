@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 )
 
@@ -50,18 +52,18 @@ var userDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7User() *schema.Resource {
 	return &schema.Resource{
-		Read:   userDataSourceRead,
-		Schema: userDataSourceSchema,
+		ReadContext: userDataSourceRead,
+		Schema:      userDataSourceSchema,
 	}
 }
 
 // userDataSourceRead fetches users from Site24x7 based on name_regex
-func userDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func userDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	userList, err := client.Users().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nameRegex := d.Get("name_regex").(string)
@@ -79,11 +81,11 @@ func userDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		return errors.New("Please enter a value for the attribute name_regex!")
+		return diag.FromErr(errors.New("Please enter a value for the attribute name_regex!"))
 	}
 
 	if user == nil {
-		return errors.New("Unable to find user matching the name: \"" + nameRegex + "\"")
+		return diag.FromErr(errors.New("Unable to find user matching the name: \"" + nameRegex + "\""))
 	}
 
 	updateUserDataSourceResourceData(d, user, matchingUserIDs, matchingUserIDsAndNames)
@@ -1,8 +1,11 @@
 package site24x7
 
 import (
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 )
@@ -47,26 +50,25 @@ var UserGroupSchema = map[string]*schema.Schema{
 
 func ResourceSite24x7UserGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: userGroupCreate,
-		Read:   userGroupRead,
-		Update: userGroupUpdate,
-		Delete: userGroupDelete,
-		Exists: userGroupExists,
+		CreateContext: userGroupCreate,
+		ReadContext:   userGroupRead,
+		UpdateContext: userGroupUpdate,
+		DeleteContext: userGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: ImportByName("user group", findUserGroups),
 		},
 		Schema: UserGroupSchema,
 	}
 }
 
-func userGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func userGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	userGroup := resourceDataToUserGroup(d)
 
 	userGroup, err := client.UserGroups().Create(userGroup)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(userGroup.UserGroupID)
@@ -74,12 +76,16 @@ func userGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func userGroupRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func userGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	userGroup, err := client.UserGroups().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	updateUserGroupResourceData(d, userGroup)
@@ -87,14 +93,14 @@ func userGroupRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func userGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func userGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	userGroup := resourceDataToUserGroup(d)
 
 	userGroup, err := client.UserGroups().Update(userGroup)
 	if err != nil {
-		return err
+		return APIErrorDiagnostics(err, d)
 	}
 
 	d.SetId(userGroup.UserGroupID)
@@ -102,30 +108,15 @@ func userGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func userGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func userGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	err := client.UserGroups().Delete(d.Id())
 	if apierrors.IsNotFound(err) {
 		return nil
 	}
 
-	return err
-}
-
-func userGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(Client)
-
-	_, err := client.UserGroups().Get(d.Id())
-	if apierrors.IsNotFound(err) {
-		return false, nil
-	}
-
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return diag.FromErr(err)
 }
 
 func resourceDataToUserGroup(d *schema.ResourceData) *api.UserGroup {
@@ -1,10 +1,12 @@
 package site24x7
 
 import (
+	"context"
 	"errors"
 	"regexp"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 )
 
@@ -53,18 +55,18 @@ var userGroupDataSourceSchema = map[string]*schema.Schema{
 
 func DataSourceSite24x7UserGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:   userGroupDataSourceRead,
-		Schema: userGroupDataSourceSchema,
+		ReadContext: userGroupDataSourceRead,
+		Schema:      userGroupDataSourceSchema,
 	}
 }
 
 // userGroupDataSourceRead fetches all userGroup from Site24x7
-func userGroupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(Client)
+func userGroupDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := WithContext(ctx, meta.(Client))
 
 	userGroupList, err := client.UserGroups().List()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	nameRegex := d.Get("name_regex")
@@ -94,11 +96,11 @@ func userGroupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 	} else {
-		return errors.New("Please enter a value for the attribute name_regex!")
+		return diag.FromErr(errors.New("Please enter a value for the attribute name_regex!"))
 	}
 
 	if userGroup == nil {
-		return errors.New("Unable to find user group matching the name : \"" + d.Get("name_regex").(string))
+		return diag.FromErr(errors.New("Unable to find user group matching the name : \"" + d.Get("name_regex").(string)))
 	}
 
 	updateUserGroupDataSourceResourceData(d, userGroup, matchingUserGroupIDs, matchingUserGroupIDsAndNames)
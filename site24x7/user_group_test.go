@@ -1,9 +1,11 @@
 package site24x7
 
 import (
+	"context"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/site24x7/terraform-provider-site24x7/api"
 	apierrors "github.com/site24x7/terraform-provider-site24x7/api/errors"
 	"github.com/site24x7/terraform-provider-site24x7/fake"
@@ -25,13 +27,13 @@ func TestUserGroupCreate(t *testing.T) {
 
 	c.FakeUserGroups.On("Create", a).Return(a, nil).Once()
 
-	require.NoError(t, userGroupCreate(d, c))
+	require.Nil(t, userGroupCreate(context.Background(), d, c))
 
 	c.FakeUserGroups.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := userGroupCreate(d, c)
+	err := userGroupCreate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestUserGroupUpdate(t *testing.T) {
@@ -50,13 +52,13 @@ func TestUserGroupUpdate(t *testing.T) {
 
 	c.FakeUserGroups.On("Update", a).Return(a, nil).Once()
 
-	require.NoError(t, userGroupUpdate(d, c))
+	require.Nil(t, userGroupUpdate(context.Background(), d, c))
 
 	c.FakeUserGroups.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
 
-	err := userGroupUpdate(d, c)
+	err := userGroupUpdate(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestUserGroupRead(t *testing.T) {
@@ -67,13 +69,13 @@ func TestUserGroupRead(t *testing.T) {
 
 	c.FakeUserGroups.On("Get", "123").Return(&api.UserGroup{}, nil).Once()
 
-	require.NoError(t, userGroupRead(d, c))
+	require.Nil(t, userGroupRead(context.Background(), d, c))
 
 	c.FakeUserGroups.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
 
-	err := userGroupRead(d, c)
+	err := userGroupRead(context.Background(), d, c)
 
-	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.Equal(t, diag.FromErr(apierrors.NewStatusError(500, "error")), err)
 }
 
 func TestUserGroupDelete(t *testing.T) {
@@ -84,39 +86,23 @@ func TestUserGroupDelete(t *testing.T) {
 
 	c.FakeUserGroups.On("Delete", "123").Return(nil).Once()
 
-	require.NoError(t, userGroupDelete(d, c))
+	require.Nil(t, userGroupDelete(context.Background(), d, c))
 
 	c.FakeUserGroups.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
 
-	require.NoError(t, userGroupDelete(d, c))
+	require.Nil(t, userGroupDelete(context.Background(), d, c))
 }
 
-func TestUserGroupExists(t *testing.T) {
+func TestUserGroupReadNotFound(t *testing.T) {
 	d := userGroupTestResourceData(t)
 	d.SetId("123")
 
 	c := fake.NewClient()
 
-	c.FakeUserGroups.On("Get", "123").Return(&api.UserGroup{}, nil).Once()
-
-	exists, err := userGroupExists(d, c)
-
-	require.NoError(t, err)
-	assert.True(t, exists)
-
 	c.FakeUserGroups.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
 
-	exists, err = userGroupExists(d, c)
-
-	require.NoError(t, err)
-	assert.False(t, exists)
-
-	c.FakeUserGroups.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
-
-	exists, err = userGroupExists(d, c)
-
-	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
-	assert.False(t, exists)
+	require.Nil(t, userGroupRead(context.Background(), d, c))
+	assert.Equal(t, "", d.Id())
 }
 
 func userGroupTestResourceData(t *testing.T) *schema.ResourceData {
@@ -0,0 +1,168 @@
+package site24x7
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	log "github.com/sirupsen/logrus"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+)
+
+// waitForStatusPollInterval is the time between two status checks while
+// waiting for a monitor to reach its target status.
+var waitForStatusPollInterval = 10 * time.Second
+
+// defaultFailOnStatuses are the statuses that abort waiting if fail_on is not
+// configured.
+var defaultFailOnStatuses = []string{"down", "configuration_error"}
+
+var waitForStatusSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "up",
+				ValidateFunc: validation.StringInSlice(validStatusNames(), false),
+				Description:  "Status to wait for, e.g. up or trouble.",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      600,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Time in seconds to wait for the monitor to reach the status.",
+			},
+			"fail_on": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(validStatusNames(), false),
+				},
+				Description: "Statuses that fail the apply instead of waiting further. Defaults to down and configuration_error.",
+			},
+		},
+	},
+	Description: "Waits for the monitor to reach a status after it has been created or updated.",
+}
+
+// WithWaitForStatus adds the optional wait_for_status block to a monitor
+// resource. If it is configured, create and update wait until the current
+// status of the monitor reaches the target status.
+func WithWaitForStatus(r *schema.Resource) {
+	// Schemas are shared between resources and tests in some places, so the
+	// map is copied before it is modified.
+	s := make(map[string]*schema.Schema, len(r.Schema)+1)
+	for k, v := range r.Schema {
+		s[k] = v
+	}
+	s["wait_for_status"] = waitForStatusSchema
+	r.Schema = s
+
+	r.CreateContext = waitingForStatus(r.CreateContext)
+	r.UpdateContext = waitingForStatus(r.UpdateContext)
+}
+
+// waitingForStatus wraps f so that it waits for the status configured in the
+// wait_for_status block after f succeeded.
+func waitingForStatus[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F) F {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if diags := f(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+
+		if err := WaitForMonitorStatus(ctx, WithContext(ctx, meta.(Client)), d); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+// WaitForMonitorStatus polls the current status of the monitor of d until it
+// reaches the status configured in the wait_for_status block. It fails early
+// if the monitor reaches one of the fail_on statuses. Nothing is done if the
+// block is not configured.
+func WaitForMonitorStatus(ctx context.Context, client Client, d *schema.ResourceData) error {
+	blocks := d.Get("wait_for_status").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+	config := blocks[0].(map[string]interface{})
+
+	target := config["status"].(string)
+	timeout := time.Duration(config["timeout"].(int)) * time.Second
+	failOn := config["fail_on"].(*schema.Set).List()
+	if len(failOn) == 0 {
+		for _, name := range defaultFailOnStatuses {
+			failOn = append(failOn, name)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		status, err := client.CurrentStatus().GetContext(ctx, d.Id())
+		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				return fmt.Errorf("timed out after %s waiting for monitor %s to become %s", timeout, d.Id(), target)
+			}
+			return fmt.Errorf("unable to get the status of monitor %s: %w", d.Id(), err)
+		}
+
+		name := statusName(status.Status)
+		if name == target {
+			return nil
+		}
+		for _, failStatus := range failOn {
+			if name == failStatus {
+				return fmt.Errorf("monitor %s is %s instead of %s: %s", d.Id(), name, target, downReason(status))
+			}
+		}
+
+		log.Debugf("Monitor %s is %s, waiting for it to become %s", d.Id(), name, target)
+
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return fmt.Errorf("timed out after %s waiting for monitor %s to become %s, last status: %s", timeout, d.Id(), target, name)
+			}
+			return ctx.Err()
+		case <-time.After(waitForStatusPollInterval):
+		}
+	}
+}
+
+// downReason returns the down reason of the monitor, or those of its
+// locations if the monitor has none.
+func downReason(status *api.MonitorStatus) string {
+	if status.DownReason != "" {
+		return status.DownReason
+	}
+
+	var reasons []string
+	for _, location := range status.Locations {
+		if location.DownReason != "" {
+			reasons = append(reasons, location.LocationName+": "+location.DownReason)
+		}
+	}
+	if len(reasons) == 0 {
+		return "no reason reported"
+	}
+
+	return strings.Join(reasons, "; ")
+}
@@ -0,0 +1,111 @@
+package site24x7
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/site24x7/terraform-provider-site24x7/fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func waitForStatusTestResource(t *testing.T) *schema.Resource {
+	waitForStatusPollInterval = time.Millisecond
+	t.Cleanup(func() { waitForStatusPollInterval = 10 * time.Second })
+
+	r := &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			d.SetId("123")
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"display_name": {Type: schema.TypeString, Optional: true},
+		},
+	}
+	WithWaitForStatus(r)
+
+	return r
+}
+
+func TestWaitForStatus(t *testing.T) {
+	r := waitForStatusTestResource(t)
+
+	c := fake.NewClient()
+	c.FakeCurrentStatus.On("Get", "123").Return(&api.MonitorStatus{MonitorID: "123", Status: api.Discovery}, nil).Twice()
+	c.FakeCurrentStatus.On("Get", "123").Return(&api.MonitorStatus{MonitorID: "123", Status: api.Up}, nil).Once()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"wait_for_status": []interface{}{map[string]interface{}{}},
+	})
+
+	require.Nil(t, r.CreateContext(context.Background(), d, c))
+	c.FakeCurrentStatus.AssertNumberOfCalls(t, "Get", 3)
+}
+
+func TestWaitForStatusFailOn(t *testing.T) {
+	r := waitForStatusTestResource(t)
+
+	c := fake.NewClient()
+	c.FakeCurrentStatus.On("Get", "123").Return(&api.MonitorStatus{
+		MonitorID: "123",
+		Status:    api.Down,
+		Locations: []*api.LocationStatus{
+			{LocationName: "London - UK", DownReason: "Host not found"},
+		},
+	}, nil)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"wait_for_status": []interface{}{map[string]interface{}{}},
+	})
+
+	diags := r.CreateContext(context.Background(), d, c)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "monitor 123 is down instead of up: London - UK: Host not found", diags[0].Summary)
+
+	// The monitor was created, so Terraform taints it instead of losing it.
+	assert.Equal(t, "123", d.Id())
+
+	c = fake.NewClient()
+	c.FakeCurrentStatus.On("Get", "123").Return(&api.MonitorStatus{MonitorID: "123", Status: api.Trouble, DownReason: "Slow response"}, nil)
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"wait_for_status": []interface{}{map[string]interface{}{
+			"fail_on": []interface{}{"trouble"},
+		}},
+	})
+
+	diags = r.CreateContext(context.Background(), d, c)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "monitor 123 is trouble instead of up: Slow response", diags[0].Summary)
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	r := waitForStatusTestResource(t)
+
+	c := fake.NewClient()
+	c.FakeCurrentStatus.On("Get", "123").Return(&api.MonitorStatus{MonitorID: "123", Status: api.Discovery}, nil)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"wait_for_status": []interface{}{map[string]interface{}{
+			"timeout": 1,
+		}},
+	})
+
+	diags := r.CreateContext(context.Background(), d, c)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "timed out after 1s waiting for monitor 123 to become up, last status: discovery", diags[0].Summary)
+}
+
+func TestWaitForStatusNotConfigured(t *testing.T) {
+	r := waitForStatusTestResource(t)
+
+	c := fake.NewClient()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+
+	require.Nil(t, r.CreateContext(context.Background(), d, c))
+	c.FakeCurrentStatus.AssertNotCalled(t, "Get", "123")
+}
@@ -1 +0,0 @@
-#!/bin/bash
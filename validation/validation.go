@@ -1,15 +1,19 @@
 package validation
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"runtime"
 	"strings"
 	"testing"
 
 	"github.com/site24x7/terraform-provider-site24x7/api"
+	"github.com/site24x7/terraform-provider-site24x7/oauth"
+	"github.com/site24x7/terraform-provider-site24x7/recorder"
 	"github.com/site24x7/terraform-provider-site24x7/rest"
 	"github.com/stretchr/testify/assert"
 )
@@ -120,3 +124,50 @@ func IndentJSON(t *testing.T, buf []byte) []byte {
 
 	return buf2
 }
+
+// RecordedClient returns a rest.Client that replays the HTTP interactions of
+// the cassette api/endpoints/testdata/cassettes/<name>.json. If
+// SITE24X7_RECORD is set, the requests are sent to the API instead and the
+// cassette is rewritten when the test finishes. Credentials and URLs are then
+// read from SITE24X7_OAUTH2_CLIENT_ID, SITE24X7_OAUTH2_CLIENT_SECRET,
+// SITE24X7_OAUTH2_REFRESH_TOKEN, SITE24X7_API_BASE_URL and
+// SITE24X7_TOKEN_URL.
+func RecordedClient(t *testing.T, name string) rest.Client {
+	_, dir, _, _ := runtime.Caller(0)
+	path := strings.ReplaceAll(dir, "validation/validation.go", "api/endpoints/testdata/cassettes/"+name+".json")
+
+	clientConfig := rest.ClientConfig{
+		APIBaseURL: "https://www.site24x7.com/api",
+	}
+
+	options := recorder.Options{Mode: recorder.ModeFromEnv()}
+	if options.Mode == recorder.ModeRecord {
+		oauthConfig := oauth.NewConfig(
+			os.Getenv("SITE24X7_OAUTH2_CLIENT_ID"),
+			os.Getenv("SITE24X7_OAUTH2_CLIENT_SECRET"),
+			os.Getenv("SITE24X7_OAUTH2_REFRESH_TOKEN"),
+			"", "",
+		)
+		if tokenURL := os.Getenv("SITE24X7_TOKEN_URL"); tokenURL != "" {
+			oauthConfig.Endpoint.TokenURL = tokenURL
+		}
+		if apiBaseURL := os.Getenv("SITE24X7_API_BASE_URL"); apiBaseURL != "" {
+			clientConfig.APIBaseURL = apiBaseURL
+		}
+
+		options.Client = oauthConfig.Client(context.Background())
+	}
+
+	rec, err := recorder.New(path, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := rec.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return rest.NewClient(rec, clientConfig)
+}